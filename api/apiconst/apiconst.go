@@ -3,4 +3,5 @@ package apiconst
 const (
 	ContentTypeHeader = "Content-Type"
 	ContentTypeJSON   = "application/json"
+	AdminTokenHeader  = "X-Admin-Token"
 )
@@ -5,13 +5,19 @@ import (
 	"io"
 	"net/http"
 	"strconv"
+	"strings"
 
 	"article-service/api/controller"
 	"article-service/apperror"
 	"article-service/application"
 	v1req "article-service/dto/request/v1_req"
 	v1resp "article-service/dto/response/v1_resp"
+	"article-service/infrastructure/appctx"
 	"article-service/infrastructure/log"
+	"article-service/model"
+
+	"github.com/go-chi/chi"
+	"github.com/google/uuid"
 )
 
 type articleController struct {
@@ -45,8 +51,12 @@ func (c articleController) CreateArticle(w http.ResponseWriter, r *http.Request)
 	id, err := c.svc.CreateArticle(ctx, dto)
 	if err != nil {
 		statusCode := http.StatusInternalServerError
-		if err == apperror.ErrAuthorNotFound {
+		switch err {
+		case apperror.ErrAuthorNotFound, apperror.ErrCategoryNotFound:
 			statusCode = http.StatusUnprocessableEntity
+		case apperror.ErrArticleSlugTaken:
+			// a concurrent create took the same slug between assignSlug and the insert
+			statusCode = http.StatusConflict
 		}
 		log.Errorf(ctx, err, "[V1][ArticleController][CreateArticle] svc.CreateArticle is failed for request dto: %v ", dto)
 		controller.WriteError(ctx, w, statusCode, err)
@@ -57,27 +67,377 @@ func (c articleController) CreateArticle(w http.ResponseWriter, r *http.Request)
 	controller.WriteSuccess(ctx, w, http.StatusCreated, resp)
 }
 
+func (c articleController) GetArticle(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		log.Errorf(ctx, err, "[V1][ArticleController][GetArticle] Invalid article id")
+		controller.WriteError(ctx, w, http.StatusBadRequest, apperror.ErrInvalidArticleID)
+		return
+	}
+
+	article, err := c.svc.GetArticle(ctx, id)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if err == apperror.ErrObjectNotExists {
+			statusCode = http.StatusNotFound
+		}
+		log.Errorf(ctx, err, "[V1][ArticleController][GetArticle] svc.GetArticle is failed, id: %s", id)
+		controller.WriteError(ctx, w, statusCode, err)
+		return
+	}
+
+	if article.Status != model.ArticleStatusPublished && !appctx.IsAdmin(ctx) {
+		log.Errorf(ctx, apperror.ErrObjectNotExists, "[V1][ArticleController][GetArticle] article is not published, id: %s", id)
+		controller.WriteError(ctx, w, http.StatusNotFound, apperror.ErrObjectNotExists)
+		return
+	}
+
+	resp := new(v1resp.ArticleDTO).Convert(article)
+	controller.WriteSuccess(ctx, w, http.StatusOK, resp)
+}
+
+func (c articleController) ListRelatedArticles(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		log.Errorf(ctx, err, "[V1][ArticleController][ListRelatedArticles] Invalid article id")
+		controller.WriteError(ctx, w, http.StatusBadRequest, apperror.ErrInvalidArticleID)
+		return
+	}
+
+	limitInt, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+	dto := v1req.ListRelatedArticlesDTO{Limit: limitInt}
+
+	err = dto.Validate(ctx)
+	if err != nil {
+		log.Errorf(ctx, err, "[V1][ArticleController][ListRelatedArticles] Validation failed for request dto %v ", dto)
+		controller.WriteError(ctx, w, http.StatusBadRequest, err)
+		return
+	}
+
+	articles, err := c.svc.ListRelatedArticles(ctx, id, dto)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if err == apperror.ErrObjectNotExists {
+			statusCode = http.StatusNotFound
+		}
+		log.Errorf(ctx, err, "[V1][ArticleController][ListRelatedArticles] svc.ListRelatedArticles is failed, id: %s", id)
+		controller.WriteError(ctx, w, statusCode, err)
+		return
+	}
+
+	resp := new(v1resp.ListArticlesDTO).Convert(articles, int64(len(articles)))
+	controller.WriteSuccess(ctx, w, http.StatusOK, resp)
+}
+
+func (c articleController) GetArticleBySlug(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	slug := chi.URLParam(r, "slug")
+	article, err := c.svc.GetArticleBySlug(ctx, slug)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if err == apperror.ErrObjectNotExists {
+			statusCode = http.StatusNotFound
+		}
+		log.Errorf(ctx, err, "[V1][ArticleController][GetArticleBySlug] svc.GetArticleBySlug is failed, slug: %s", slug)
+		controller.WriteError(ctx, w, statusCode, err)
+		return
+	}
+
+	if article.Status != model.ArticleStatusPublished && !appctx.IsAdmin(ctx) {
+		log.Errorf(ctx, apperror.ErrObjectNotExists, "[V1][ArticleController][GetArticleBySlug] article is not published, slug: %s", slug)
+		controller.WriteError(ctx, w, http.StatusNotFound, apperror.ErrObjectNotExists)
+		return
+	}
+
+	if article.Slug != slug {
+		controller.WriteMovedPermanently(w, r, "/v1/articles/by-slug/"+article.Slug)
+		return
+	}
+
+	resp := new(v1resp.ArticleDTO).Convert(article)
+	controller.WriteSuccess(ctx, w, http.StatusOK, resp)
+}
+
+func (c articleController) UpdateArticle(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		log.Errorf(ctx, err, "[V1][ArticleController][UpdateArticle] Invalid article id")
+		controller.WriteError(ctx, w, http.StatusBadRequest, apperror.ErrInvalidArticleID)
+		return
+	}
+
+	reqBody, _ := io.ReadAll(r.Body)
+	dto := v1req.UpdateArticleDTO{}
+	if err := json.Unmarshal(reqBody, &dto); err != nil {
+		log.Errorf(ctx, err, "[V1][ArticleController][UpdateArticle] Failed to unmarshal request body %v into dto", reqBody)
+		controller.WriteError(ctx, w, http.StatusBadRequest, apperror.ErrUnmarshalRequestBodyFailed)
+		return
+	}
+
+	err = dto.Validate(ctx)
+	if err != nil {
+		log.Errorf(ctx, err, "[V1][ArticleController][UpdateArticle] Validation failed for request dto %v ", dto)
+		controller.WriteError(ctx, w, http.StatusBadRequest, err)
+		return
+	}
+
+	article, err := c.svc.UpdateArticle(ctx, id, dto)
+	if err != nil {
+		statusCode := updateArticleErrStatusCode(err)
+		log.Errorf(ctx, err, "[V1][ArticleController][UpdateArticle] svc.UpdateArticle is failed for request dto: %v ", dto)
+		controller.WriteError(ctx, w, statusCode, err)
+		return
+	}
+
+	resp := new(v1resp.ArticleDTO).Convert(article)
+	controller.WriteSuccess(ctx, w, http.StatusOK, resp)
+}
+
+func (c articleController) PatchArticle(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		log.Errorf(ctx, err, "[V1][ArticleController][PatchArticle] Invalid article id")
+		controller.WriteError(ctx, w, http.StatusBadRequest, apperror.ErrInvalidArticleID)
+		return
+	}
+
+	reqBody, _ := io.ReadAll(r.Body)
+	dto := v1req.PatchArticleDTO{}
+	if err := json.Unmarshal(reqBody, &dto); err != nil {
+		log.Errorf(ctx, err, "[V1][ArticleController][PatchArticle] Failed to unmarshal request body %v into dto", reqBody)
+		controller.WriteError(ctx, w, http.StatusBadRequest, apperror.ErrUnmarshalRequestBodyFailed)
+		return
+	}
+
+	err = dto.Validate(ctx)
+	if err != nil {
+		log.Errorf(ctx, err, "[V1][ArticleController][PatchArticle] Validation failed for request dto %v ", dto)
+		controller.WriteError(ctx, w, http.StatusBadRequest, err)
+		return
+	}
+
+	article, err := c.svc.PatchArticle(ctx, id, dto)
+	if err != nil {
+		statusCode := updateArticleErrStatusCode(err)
+		log.Errorf(ctx, err, "[V1][ArticleController][PatchArticle] svc.PatchArticle is failed for request dto: %v ", dto)
+		controller.WriteError(ctx, w, statusCode, err)
+		return
+	}
+
+	resp := new(v1resp.ArticleDTO).Convert(article)
+	controller.WriteSuccess(ctx, w, http.StatusOK, resp)
+}
+
+func (c articleController) DeleteArticle(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		log.Errorf(ctx, err, "[V1][ArticleController][DeleteArticle] Invalid article id")
+		controller.WriteError(ctx, w, http.StatusBadRequest, apperror.ErrInvalidArticleID)
+		return
+	}
+
+	err = c.svc.DeleteArticle(ctx, id)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if err == apperror.ErrObjectNotExists {
+			statusCode = http.StatusNotFound
+		}
+		log.Errorf(ctx, err, "[V1][ArticleController][DeleteArticle] svc.DeleteArticle is failed, id: %s", id)
+		controller.WriteError(ctx, w, statusCode, err)
+		return
+	}
+
+	controller.WriteSuccess(ctx, w, http.StatusOK, nil)
+}
+
+func (c articleController) RestoreArticle(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		log.Errorf(ctx, err, "[V1][ArticleController][RestoreArticle] Invalid article id")
+		controller.WriteError(ctx, w, http.StatusBadRequest, apperror.ErrInvalidArticleID)
+		return
+	}
+
+	article, err := c.svc.RestoreArticle(ctx, id)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		switch err {
+		case apperror.ErrObjectNotExists:
+			statusCode = http.StatusNotFound
+		case apperror.ErrArticleNotDeleted:
+			statusCode = http.StatusConflict
+		}
+		log.Errorf(ctx, err, "[V1][ArticleController][RestoreArticle] svc.RestoreArticle is failed, id: %s", id)
+		controller.WriteError(ctx, w, statusCode, err)
+		return
+	}
+
+	resp := new(v1resp.ArticleDTO).Convert(article)
+	controller.WriteSuccess(ctx, w, http.StatusOK, resp)
+}
+
+func (c articleController) PublishArticle(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		log.Errorf(ctx, err, "[V1][ArticleController][PublishArticle] Invalid article id")
+		controller.WriteError(ctx, w, http.StatusBadRequest, apperror.ErrInvalidArticleID)
+		return
+	}
+
+	article, err := c.svc.PublishArticle(ctx, id)
+	if err != nil {
+		log.Errorf(ctx, err, "[V1][ArticleController][PublishArticle] svc.PublishArticle is failed, id: %s", id)
+		controller.WriteError(ctx, w, transitionArticleErrStatusCode(err), err)
+		return
+	}
+
+	resp := new(v1resp.ArticleDTO).Convert(article)
+	controller.WriteSuccess(ctx, w, http.StatusOK, resp)
+}
+
+func (c articleController) ArchiveArticle(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		log.Errorf(ctx, err, "[V1][ArticleController][ArchiveArticle] Invalid article id")
+		controller.WriteError(ctx, w, http.StatusBadRequest, apperror.ErrInvalidArticleID)
+		return
+	}
+
+	article, err := c.svc.ArchiveArticle(ctx, id)
+	if err != nil {
+		log.Errorf(ctx, err, "[V1][ArticleController][ArchiveArticle] svc.ArchiveArticle is failed, id: %s", id)
+		controller.WriteError(ctx, w, transitionArticleErrStatusCode(err), err)
+		return
+	}
+
+	resp := new(v1resp.ArticleDTO).Convert(article)
+	controller.WriteSuccess(ctx, w, http.StatusOK, resp)
+}
+
+func (c articleController) ScheduleArticle(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		log.Errorf(ctx, err, "[V1][ArticleController][ScheduleArticle] Invalid article id")
+		controller.WriteError(ctx, w, http.StatusBadRequest, apperror.ErrInvalidArticleID)
+		return
+	}
+
+	reqBody, _ := io.ReadAll(r.Body)
+	dto := v1req.ScheduleArticleDTO{}
+	if err := json.Unmarshal(reqBody, &dto); err != nil {
+		log.Errorf(ctx, err, "[V1][ArticleController][ScheduleArticle] Failed to unmarshal request body %v into dto", reqBody)
+		controller.WriteError(ctx, w, http.StatusBadRequest, apperror.ErrUnmarshalRequestBodyFailed)
+		return
+	}
+
+	err = dto.Validate(ctx)
+	if err != nil {
+		log.Errorf(ctx, err, "[V1][ArticleController][ScheduleArticle] Validation failed for request dto %v ", dto)
+		controller.WriteError(ctx, w, http.StatusBadRequest, err)
+		return
+	}
+
+	article, err := c.svc.ScheduleArticle(ctx, id, dto)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		switch err {
+		case apperror.ErrObjectNotExists:
+			statusCode = http.StatusNotFound
+		case apperror.ErrArticleNotDraft:
+			statusCode = http.StatusConflict
+		}
+		log.Errorf(ctx, err, "[V1][ArticleController][ScheduleArticle] svc.ScheduleArticle is failed for request dto: %v ", dto)
+		controller.WriteError(ctx, w, statusCode, err)
+		return
+	}
+
+	resp := new(v1resp.ArticleDTO).Convert(article)
+	controller.WriteSuccess(ctx, w, http.StatusOK, resp)
+}
+
 func (c articleController) ListArticles(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	queryParams := r.URL.Query()
 
 	query := queryParams.Get("query")
+	fuzzy := queryParams.Get("fuzzy")
 	authorName := queryParams.Get("authorName")
+	authorIds := queryParams.Get("authorIds")
+	excludeAuthorIds := queryParams.Get("excludeAuthorIds")
+	createdFrom := queryParams.Get("createdFrom")
+	createdTo := queryParams.Get("createdTo")
+	category := queryParams.Get("category")
+	tags := queryParams.Get("tags")
+	tagsMatch := queryParams.Get("tagsMatch")
+	includeDeleted := queryParams.Get("includeDeleted")
 	sortBy := queryParams.Get("sortBy")
 	sortDirection := queryParams.Get("sortDirection")
+	facets := queryParams.Get("facets")
 	limit := queryParams.Get("limit")
 	page := queryParams.Get("page")
 
 	limitInt, _ := strconv.Atoi(limit)
 	pageInt, _ := strconv.Atoi(page)
 
+	includeDeletedBool, _ := strconv.ParseBool(includeDeleted)
+	fuzzyBool, _ := strconv.ParseBool(fuzzy)
+
+	var tagList []string
+	if tags != "" {
+		tagList = strings.Split(tags, ",")
+	}
+
+	var facetList []string
+	if facets != "" {
+		facetList = strings.Split(facets, ",")
+	}
+
+	var authorIdList []string
+	if authorIds != "" {
+		authorIdList = strings.Split(authorIds, ",")
+	}
+
+	var excludeAuthorIdList []string
+	if excludeAuthorIds != "" {
+		excludeAuthorIdList = strings.Split(excludeAuthorIds, ",")
+	}
+
 	dto := v1req.ListArticlesDTO{
-		Query:         query,
-		AuthorName:    authorName,
-		SortBy:        sortBy,
-		SortDirection: sortDirection,
-		Limit:         limitInt,
-		Page:          pageInt,
+		Query:            query,
+		Fuzzy:            fuzzyBool,
+		AuthorName:       authorName,
+		AuthorIds:        authorIdList,
+		ExcludeAuthorIds: excludeAuthorIdList,
+		CreatedFrom:      createdFrom,
+		CreatedTo:        createdTo,
+		CategoryId:       category,
+		Tags:             tagList,
+		TagsMatch:        tagsMatch,
+		IncludeDeleted:   includeDeletedBool,
+		SortBy:           sortBy,
+		SortDirection:    sortDirection,
+		Facets:           facetList,
+		Limit:            limitInt,
+		Page:             pageInt,
 	}
 
 	err := dto.Validate(ctx)
@@ -87,6 +447,12 @@ func (c articleController) ListArticles(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
+	if dto.IncludeDeleted && !appctx.IsAdmin(ctx) {
+		log.Errorf(ctx, apperror.ErrAdminOnly, "[V1][ArticleController][ListArticles] includeDeleted is requested by non-admin")
+		controller.WriteError(ctx, w, http.StatusForbidden, apperror.ErrAdminOnly)
+		return
+	}
+
 	articles, recordsCount, err := c.svc.ListArticles(ctx, dto)
 	if err != nil {
 		log.Errorf(ctx, err, "[V1][ArticleController][ListArticles] svc.ListArticles is failed")
@@ -95,5 +461,77 @@ func (c articleController) ListArticles(w http.ResponseWriter, r *http.Request)
 	}
 
 	resp := new(v1resp.ListArticlesDTO).Convert(articles, recordsCount)
+
+	if len(dto.Facets) > 0 {
+		facets, err := c.svc.ListArticleFacets(ctx, dto)
+		if err != nil {
+			log.Errorf(ctx, err, "[V1][ArticleController][ListArticles] svc.ListArticleFacets is failed")
+			controller.WriteError(ctx, w, http.StatusInternalServerError, err)
+			return
+		}
+		resp.Facets = new(v1resp.FacetsDTO).Convert(facets)
+	}
+
+	// a failed spell check only costs the suggestion, the empty result is still answered
+	if dto.Query != "" && recordsCount == 0 {
+		suggestion, err := c.svc.CorrectSearchQuery(ctx, dto)
+		if err != nil {
+			log.Errorf(ctx, err, "[V1][ArticleController][ListArticles] svc.CorrectSearchQuery is failed")
+		}
+		resp.Suggestion = suggestion
+	}
+
 	controller.WriteSuccess(ctx, w, http.StatusOK, resp)
 }
+
+func (c articleController) SuggestArticles(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	queryParams := r.URL.Query()
+
+	limitInt, _ := strconv.Atoi(queryParams.Get("limit"))
+	dto := v1req.SuggestArticlesDTO{
+		Query: queryParams.Get("q"),
+		Limit: limitInt,
+	}
+
+	err := dto.Validate(ctx)
+	if err != nil {
+		log.Errorf(ctx, err, "[V1][ArticleController][SuggestArticles] Validation failed for request dto %v ", dto)
+		controller.WriteError(ctx, w, http.StatusBadRequest, err)
+		return
+	}
+
+	suggestions, err := c.svc.SuggestArticles(ctx, dto)
+	if err != nil {
+		log.Errorf(ctx, err, "[V1][ArticleController][SuggestArticles] svc.SuggestArticles is failed")
+		controller.WriteError(ctx, w, http.StatusInternalServerError, err)
+		return
+	}
+
+	resp := new(v1resp.SuggestArticlesDTO).Convert(suggestions)
+	controller.WriteSuccess(ctx, w, http.StatusOK, resp)
+}
+
+func updateArticleErrStatusCode(err error) int {
+	switch err {
+	case apperror.ErrObjectNotExists:
+		return http.StatusNotFound
+	case apperror.ErrAuthorNotFound, apperror.ErrCategoryNotFound:
+		return http.StatusUnprocessableEntity
+	case apperror.ErrArticleSlugTaken:
+		return http.StatusConflict
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+func transitionArticleErrStatusCode(err error) int {
+	switch err {
+	case apperror.ErrObjectNotExists:
+		return http.StatusNotFound
+	case apperror.ErrInvalidStatusTransition:
+		return http.StatusConflict
+	default:
+		return http.StatusInternalServerError
+	}
+}
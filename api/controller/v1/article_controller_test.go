@@ -9,7 +9,9 @@ import (
 	"article-service/dto/response"
 	v1resp "article-service/dto/response/v1_resp"
 	"article-service/factory"
+	"article-service/infrastructure/appctx"
 	"article-service/infrastructure/elasticsearch"
+	"article-service/lib"
 	"article-service/model"
 	"article-service/utils"
 	"bytes"
@@ -19,6 +21,7 @@ import (
 	"net/http/httptest"
 	"net/url"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
@@ -186,6 +189,344 @@ func Test_ListArticles_Success(t *testing.T) {
 	assert.True(t, mockResult[0].CreatedAt.Equal(resultDTO.Articles[0].CreatedAt))
 	assert.Equal(t, mockResult[0].Author.ID, resultDTO.Articles[0].Author.ID)
 	assert.Equal(t, mockResult[0].Author.Name, resultDTO.Articles[0].Author.Name)
+	assert.Equal(t, mockResult[0].Tags, resultDTO.Articles[0].Tags)
+}
+
+func Test_ListArticles_Success_WithHighlights(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	dto := v1req.ListArticlesDTO{Query: "ibu"}
+
+	article := factory.SampleArticle1
+	article.Highlight = &model.ArticleHighlight{Title: []string{"Satu satu aku sayang <em>ibu</em>"}}
+	svc := mock_application.NewMockIArticleService(ctrl)
+	svc.EXPECT().ListArticles(gomock.Any(), dto).Return([]*model.Article{&article}, int64(1), nil)
+
+	w := httptest.NewRecorder()
+	r := lib.NewIncomingRequestBuilder(t).
+		WithQueryString("query=ibu").
+		Build()
+
+	articleController{svc}.ListArticles(w, r)
+	statusCode := w.Result().StatusCode
+
+	respBody := response.SuccessResponse{}
+	respBytes, _ := io.ReadAll(w.Body)
+	json.Unmarshal(respBytes, &respBody)
+
+	result, _ := json.Marshal(respBody.Result)
+	resultDTO := v1resp.ListArticlesDTO{}
+	json.Unmarshal(result, &resultDTO)
+
+	assert.Equal(t, http.StatusOK, statusCode)
+	assert.Equal(t, &v1resp.HighlightDTO{
+		Title: []string{"Satu satu aku sayang <em>ibu</em>"},
+		Body:  []string{},
+	}, resultDTO.Articles[0].Highlights)
+}
+
+func Test_ListArticles_Success_WithFacets(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	dto := v1req.ListArticlesDTO{Query: "ibu", Facets: []string{"author", "month"}}
+
+	author := factory.SampleAuthorChandra
+	mockFacets := model.ArticleFacets{
+		model.FacetAuthor: {{Value: author.ID.String(), Label: author.Name, Count: 1}},
+		model.FacetMonth:  {},
+	}
+	svc := mock_application.NewMockIArticleService(ctrl)
+	svc.EXPECT().ListArticles(gomock.Any(), dto).Return([]*model.Article{&factory.SampleArticle1}, int64(1), nil)
+	svc.EXPECT().ListArticleFacets(gomock.Any(), dto).Return(mockFacets, nil)
+
+	w := httptest.NewRecorder()
+	r := lib.NewIncomingRequestBuilder(t).
+		WithQueryString("query=ibu&facets=author,month").
+		Build()
+
+	articleController{svc}.ListArticles(w, r)
+	statusCode := w.Result().StatusCode
+
+	respBody := response.SuccessResponse{}
+	respBytes, _ := io.ReadAll(w.Body)
+	json.Unmarshal(respBytes, &respBody)
+
+	result, _ := json.Marshal(respBody.Result)
+	resultDTO := v1resp.ListArticlesDTO{}
+	json.Unmarshal(result, &resultDTO)
+
+	assert.Equal(t, http.StatusOK, statusCode)
+	assert.Equal(t, v1resp.FacetsDTO{
+		"author": {{Value: author.ID.String(), Label: author.Name, Count: 1}},
+		"month":  {},
+	}, resultDTO.Facets)
+}
+
+func Test_ListArticles_Success_WithSuggestion_WhenNothingFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	dto := v1req.ListArticlesDTO{Query: "sayng", Fuzzy: true}
+
+	svc := mock_application.NewMockIArticleService(ctrl)
+	svc.EXPECT().ListArticles(gomock.Any(), dto).Return([]*model.Article{}, int64(0), nil)
+	svc.EXPECT().CorrectSearchQuery(gomock.Any(), dto).Return("sayang", nil)
+
+	w := httptest.NewRecorder()
+	r := lib.NewIncomingRequestBuilder(t).
+		WithQueryString("query=sayng&fuzzy=true").
+		Build()
+
+	articleController{svc}.ListArticles(w, r)
+	statusCode := w.Result().StatusCode
+
+	respBody := response.SuccessResponse{}
+	respBytes, _ := io.ReadAll(w.Body)
+	json.Unmarshal(respBytes, &respBody)
+
+	result, _ := json.Marshal(respBody.Result)
+	resultDTO := v1resp.ListArticlesDTO{}
+	json.Unmarshal(result, &resultDTO)
+
+	assert.Equal(t, http.StatusOK, statusCode)
+	assert.Equal(t, "sayang", resultDTO.Suggestion)
+}
+
+func Test_ListArticles_Success_WithoutSuggestion_WhenCorrectSearchQueryFailed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	dto := v1req.ListArticlesDTO{Query: "sayng"}
+
+	svc := mock_application.NewMockIArticleService(ctrl)
+	svc.EXPECT().ListArticles(gomock.Any(), dto).Return([]*model.Article{}, int64(0), nil)
+	svc.EXPECT().CorrectSearchQuery(gomock.Any(), dto).Return("", apperror.ErrSearchElasticFailed)
+
+	w := httptest.NewRecorder()
+	r := lib.NewIncomingRequestBuilder(t).
+		WithQueryString("query=sayng").
+		Build()
+
+	articleController{svc}.ListArticles(w, r)
+
+	assert.Equal(t, http.StatusOK, w.Result().StatusCode)
+	assert.NotContains(t, w.Body.String(), "suggestion")
+}
+
+func Test_ListArticles_Success_WithAuthorIdsAndCreatedRange(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	chandra, phang := factory.SampleAuthorChandra.ID.String(), factory.SampleAuthorPhang.ID.String()
+	dto := v1req.ListArticlesDTO{
+		AuthorIds:        []string{chandra},
+		ExcludeAuthorIds: []string{phang},
+		CreatedFrom:      "2025-07-01T00:00:00Z",
+		CreatedTo:        "2025-07-01T00:00:00Z",
+	}
+
+	svc := mock_application.NewMockIArticleService(ctrl)
+	svc.EXPECT().ListArticles(gomock.Any(), dto).Return([]*model.Article{&factory.SampleArticle1}, int64(1), nil)
+
+	w := httptest.NewRecorder()
+	r := lib.NewIncomingRequestBuilder(t).
+		WithQueryString("authorIds=" + chandra + "&excludeAuthorIds=" + phang + "&createdFrom=2025-07-01T00:00:00Z&createdTo=2025-07-01T00:00:00Z").
+		Build()
+
+	articleController{svc}.ListArticles(w, r)
+
+	assert.Equal(t, http.StatusOK, w.Result().StatusCode)
+}
+
+func Test_ListArticles_ReturnErr_WhenCreatedToIsBeforeCreatedFrom(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	svc := mock_application.NewMockIArticleService(ctrl)
+
+	w := httptest.NewRecorder()
+	r := lib.NewIncomingRequestBuilder(t).
+		WithQueryString("createdFrom=2025-07-31T00:00:00Z&createdTo=2025-07-01T00:00:00Z").
+		Build()
+
+	articleController{svc}.ListArticles(w, r)
+	statusCode := w.Result().StatusCode
+
+	respBody := response.FailureResponse{}
+	respBytes, _ := io.ReadAll(w.Body)
+	json.Unmarshal(respBytes, &respBody)
+
+	assert.Equal(t, http.StatusBadRequest, statusCode)
+	assert.Equal(t, "createdTo should not be before createdFrom", respBody.Failure)
+}
+
+func Test_ListArticles_ReturnErr_WhenFiltersAreInvalid(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	svc := mock_application.NewMockIArticleService(ctrl)
+
+	for _, queryString := range []string{"authorIds=chandra", "excludeAuthorIds=,", "createdFrom=2025-07-01", "createdTo=yesterday"} {
+		w := httptest.NewRecorder()
+		r := lib.NewIncomingRequestBuilder(t).
+			WithQueryString(queryString).
+			Build()
+
+		articleController{svc}.ListArticles(w, r)
+
+		assert.Equal(t, http.StatusBadRequest, w.Result().StatusCode, queryString)
+	}
+}
+
+func Test_ListArticles_ReturnErr_WhenFacetIsUnknown(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	svc := mock_application.NewMockIArticleService(ctrl)
+
+	w := httptest.NewRecorder()
+	r := lib.NewIncomingRequestBuilder(t).
+		WithQueryString("facets=author,category").
+		Build()
+
+	articleController{svc}.ListArticles(w, r)
+
+	assert.Equal(t, http.StatusBadRequest, w.Result().StatusCode)
+}
+
+func Test_ListArticles_ReturnErr_WhenListArticleFacetsFailed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	dto := v1req.ListArticlesDTO{Facets: []string{"tag"}}
+
+	svc := mock_application.NewMockIArticleService(ctrl)
+	svc.EXPECT().ListArticles(gomock.Any(), dto).Return([]*model.Article{}, int64(0), nil)
+	svc.EXPECT().ListArticleFacets(gomock.Any(), dto).Return(nil, apperror.ErrGetRecordFailed)
+
+	w := httptest.NewRecorder()
+	r := lib.NewIncomingRequestBuilder(t).
+		WithQueryString("facets=tag").
+		Build()
+
+	articleController{svc}.ListArticles(w, r)
+
+	assert.Equal(t, http.StatusInternalServerError, w.Result().StatusCode)
+}
+
+func Test_SuggestArticles_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	dto := v1req.SuggestArticlesDTO{Query: "sat", Limit: 3}
+
+	article := factory.SampleArticle1
+	svc := mock_application.NewMockIArticleService(ctrl)
+	svc.EXPECT().SuggestArticles(gomock.Any(), dto).Return(model.ArticleSuggestions{
+		Titles: []model.TitleSuggestion{{ArticleID: article.ID, Title: article.Title}},
+	}, nil)
+
+	w := httptest.NewRecorder()
+	r := lib.NewIncomingRequestBuilder(t).
+		WithQueryString("q=sat&limit=3").
+		Build()
+
+	articleController{svc}.SuggestArticles(w, r)
+	statusCode := w.Result().StatusCode
+
+	respBody := response.SuccessResponse{}
+	respBytes, _ := io.ReadAll(w.Body)
+	json.Unmarshal(respBytes, &respBody)
+
+	result, _ := json.Marshal(respBody.Result)
+	resultDTO := v1resp.SuggestArticlesDTO{}
+	json.Unmarshal(result, &resultDTO)
+
+	assert.Equal(t, http.StatusOK, statusCode)
+	assert.Equal(t, v1resp.SuggestArticlesDTO{
+		Titles:  []v1resp.TitleSuggestionDTO{{ID: article.ID, Title: article.Title}},
+		Authors: []string{},
+	}, resultDTO)
+}
+
+func Test_SuggestArticles_ReturnErr_WhenQueryIsMissing(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	svc := mock_application.NewMockIArticleService(ctrl)
+
+	w := httptest.NewRecorder()
+	r := lib.NewIncomingRequestBuilder(t).
+		WithQueryString("limit=3").
+		Build()
+
+	articleController{svc}.SuggestArticles(w, r)
+
+	assert.Equal(t, http.StatusBadRequest, w.Result().StatusCode)
+}
+
+func Test_ListArticles_Success_WithTags(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	dto := v1req.ListArticlesDTO{
+		Tags:      []string{"puisi", "keluarga"},
+		TagsMatch: "all",
+	}
+
+	svc := mock_application.NewMockIArticleService(ctrl)
+	svc.EXPECT().ListArticles(gomock.Any(), dto).Return([]*model.Article{}, int64(0), nil)
+
+	w := httptest.NewRecorder()
+	r := lib.NewIncomingRequestBuilder(t).
+		WithQueryString("tags=puisi,keluarga&tagsMatch=all").
+		Build()
+
+	articleController{svc}.ListArticles(w, r)
+	statusCode := w.Result().StatusCode
+
+	assert.Equal(t, http.StatusOK, statusCode)
+}
+
+func Test_ListArticles_ReturnErr_WhenInvalidTagsMatch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	svc := mock_application.NewMockIArticleService(ctrl)
+
+	w := httptest.NewRecorder()
+	r := lib.NewIncomingRequestBuilder(t).
+		WithQueryString("tags=puisi&tagsMatch=some").
+		Build()
+
+	articleController{svc}.ListArticles(w, r)
+	statusCode := w.Result().StatusCode
+
+	respBody := response.FailureResponse{}
+	respBytes, _ := io.ReadAll(w.Body)
+	json.Unmarshal(respBytes, &respBody)
+
+	assert.Equal(t, http.StatusBadRequest, statusCode)
+	assert.Equal(t, "tagsMatch should be one of any all", respBody.Failure)
+}
+
+func Test_CreateArticle_ReturnErr_WhenSlugTakenConcurrently(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	dto := createArticleDTO
+
+	svc := mock_application.NewMockIArticleService(ctrl)
+	svc.EXPECT().CreateArticle(gomock.Any(), dto).Return(uuid.Nil, apperror.ErrArticleSlugTaken)
+
+	w := httptest.NewRecorder()
+	r := &http.Request{Header: http.Header{}}
+
+	jsonBytes, _ := json.Marshal(dto)
+	r.Body = io.NopCloser(bytes.NewBuffer([]byte(jsonBytes)))
+	r.Header.Add(apiconst.ContentTypeHeader, apiconst.ContentTypeJSON)
+
+	articleController{svc}.CreateArticle(w, r)
+
+	assert.Equal(t, http.StatusConflict, w.Result().StatusCode)
+}
+
+func Test_CreateArticle_ReturnErr_WhenTooManyTags(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	dto := createArticleDTO
+	dto.Tags = []string{"a", "b", "c", "d", "e", "f", "g", "h", "i", "j", "k"}
+
+	svc := mock_application.NewMockIArticleService(ctrl)
+
+	w := httptest.NewRecorder()
+	r := lib.NewIncomingRequestBuilder(t).
+		WithJSONMarshal(dto).
+		Build()
+
+	articleController{svc}.CreateArticle(w, r)
+	statusCode := w.Result().StatusCode
+
+	respBody := response.FailureResponse{}
+	respBytes, _ := io.ReadAll(w.Body)
+	json.Unmarshal(respBytes, &respBody)
+
+	assert.Equal(t, http.StatusBadRequest, statusCode)
+	assert.Equal(t, "tags is invalid", respBody.Failure)
 }
 
 func Test_ListArticles_ReturnErr_WhenInvalidDTO(t *testing.T) {
@@ -211,7 +552,7 @@ func Test_ListArticles_ReturnErr_WhenInvalidDTO(t *testing.T) {
 
 	assert.Equal(t, http.StatusBadRequest, statusCode)
 	assert.False(t, respBody.Success)
-	assert.Equal(t, "sortBy should be one of created_at title author_name", respBody.Failure)
+	assert.Equal(t, "sortBy should be one of relevance created_at title author_name", respBody.Failure)
 }
 
 func Test_ListArticles_ReturnErr_WhenListArticlesFailed(t *testing.T) {
@@ -240,3 +581,746 @@ func Test_ListArticles_ReturnErr_WhenListArticlesFailed(t *testing.T) {
 	assert.False(t, respBody.Success)
 	assert.Equal(t, apperror.ErrGetRecordFailed.Error(), respBody.Failure)
 }
+
+func Test_GetArticle_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	article := factory.SampleArticle1
+	svc := mock_application.NewMockIArticleService(ctrl)
+	svc.EXPECT().GetArticle(gomock.Any(), article.ID).Return(&article, nil)
+
+	w := httptest.NewRecorder()
+	r := lib.NewIncomingRequestBuilder(t).
+		WithPathParam("id", article.ID.String()).
+		Build()
+
+	articleController{svc}.GetArticle(w, r)
+	statusCode := w.Result().StatusCode
+
+	respBody := response.SuccessResponse{}
+	respBytes, _ := io.ReadAll(w.Body)
+	json.Unmarshal(respBytes, &respBody)
+
+	result, _ := json.Marshal(respBody.Result)
+	resultDTO := v1resp.ArticleDTO{}
+	json.Unmarshal(result, &resultDTO)
+
+	assert.Equal(t, http.StatusOK, statusCode)
+	assert.True(t, respBody.Success)
+	assert.Equal(t, article.ID, resultDTO.ID)
+	assert.Equal(t, article.Title, resultDTO.Title)
+	assert.Equal(t, article.Body, resultDTO.Body)
+	assert.Equal(t, article.Author.ID, resultDTO.Author.ID)
+}
+
+func Test_ListRelatedArticles_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	article := factory.SampleArticle1
+	related := factory.SampleArticle2
+	svc := mock_application.NewMockIArticleService(ctrl)
+	svc.EXPECT().ListRelatedArticles(gomock.Any(), article.ID, v1req.ListRelatedArticlesDTO{Limit: 3}).
+		Return([]*model.Article{&related}, nil)
+
+	w := httptest.NewRecorder()
+	r := lib.NewIncomingRequestBuilder(t).
+		WithPathParam("id", article.ID.String()).
+		WithQueryString("limit=3").
+		Build()
+
+	articleController{svc}.ListRelatedArticles(w, r)
+	statusCode := w.Result().StatusCode
+
+	respBody := response.SuccessResponse{}
+	respBytes, _ := io.ReadAll(w.Body)
+	json.Unmarshal(respBytes, &respBody)
+
+	result, _ := json.Marshal(respBody.Result)
+	resultDTO := v1resp.ListArticlesDTO{}
+	json.Unmarshal(result, &resultDTO)
+
+	assert.Equal(t, http.StatusOK, statusCode)
+	assert.Equal(t, int64(1), resultDTO.RecordsCount)
+	assert.Equal(t, related.ID, resultDTO.Articles[0].ID)
+}
+
+func Test_ListRelatedArticles_ReturnErr_WhenLimitIsTooHigh(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	svc := mock_application.NewMockIArticleService(ctrl)
+
+	w := httptest.NewRecorder()
+	r := lib.NewIncomingRequestBuilder(t).
+		WithPathParam("id", factory.SampleArticle1.ID.String()).
+		WithQueryString("limit=21").
+		Build()
+
+	articleController{svc}.ListRelatedArticles(w, r)
+
+	assert.Equal(t, http.StatusBadRequest, w.Result().StatusCode)
+}
+
+func Test_ListRelatedArticles_ReturnErr_WhenArticleNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	articleID := utils.GenerateUUID()
+	svc := mock_application.NewMockIArticleService(ctrl)
+	svc.EXPECT().ListRelatedArticles(gomock.Any(), articleID, v1req.ListRelatedArticlesDTO{}).Return(nil, apperror.ErrObjectNotExists)
+
+	w := httptest.NewRecorder()
+	r := lib.NewIncomingRequestBuilder(t).
+		WithPathParam("id", articleID.String()).
+		WithQueryString("").
+		Build()
+
+	articleController{svc}.ListRelatedArticles(w, r)
+
+	assert.Equal(t, http.StatusNotFound, w.Result().StatusCode)
+}
+
+func Test_GetArticle_ReturnErr_WhenInvalidID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	svc := mock_application.NewMockIArticleService(ctrl)
+
+	w := httptest.NewRecorder()
+	r := lib.NewIncomingRequestBuilder(t).
+		WithPathParam("id", "invalid-uuid").
+		Build()
+
+	articleController{svc}.GetArticle(w, r)
+	statusCode := w.Result().StatusCode
+
+	respBody := response.FailureResponse{}
+	respBytes, _ := io.ReadAll(w.Body)
+	json.Unmarshal(respBytes, &respBody)
+
+	assert.Equal(t, http.StatusBadRequest, statusCode)
+	assert.False(t, respBody.Success)
+	assert.Equal(t, apperror.ErrInvalidArticleID.Error(), respBody.Failure)
+}
+
+func Test_GetArticle_ReturnErr_WhenArticleNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	articleID := utils.GenerateUUID()
+	svc := mock_application.NewMockIArticleService(ctrl)
+	svc.EXPECT().GetArticle(gomock.Any(), articleID).Return(nil, apperror.ErrObjectNotExists)
+
+	w := httptest.NewRecorder()
+	r := lib.NewIncomingRequestBuilder(t).
+		WithPathParam("id", articleID.String()).
+		Build()
+
+	articleController{svc}.GetArticle(w, r)
+	statusCode := w.Result().StatusCode
+
+	respBody := response.FailureResponse{}
+	respBytes, _ := io.ReadAll(w.Body)
+	json.Unmarshal(respBytes, &respBody)
+
+	assert.Equal(t, http.StatusNotFound, statusCode)
+	assert.False(t, respBody.Success)
+	assert.Equal(t, apperror.ErrObjectNotExists.Error(), respBody.Failure)
+}
+
+func Test_GetArticle_ReturnErr_WhenNotPublished(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	article := factory.SampleArticle1
+	article.Status = model.ArticleStatusDraft
+	svc := mock_application.NewMockIArticleService(ctrl)
+	svc.EXPECT().GetArticle(gomock.Any(), article.ID).Return(&article, nil)
+
+	w := httptest.NewRecorder()
+	r := lib.NewIncomingRequestBuilder(t).
+		WithPathParam("id", article.ID.String()).
+		Build()
+
+	articleController{svc}.GetArticle(w, r)
+
+	assert.Equal(t, http.StatusNotFound, w.Result().StatusCode)
+}
+
+func Test_GetArticle_Success_WhenNotPublishedAndAdmin(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	article := factory.SampleArticle1
+	article.Status = model.ArticleStatusDraft
+	svc := mock_application.NewMockIArticleService(ctrl)
+	svc.EXPECT().GetArticle(gomock.Any(), article.ID).Return(&article, nil)
+
+	w := httptest.NewRecorder()
+	r := lib.NewIncomingRequestBuilder(t).
+		WithPathParam("id", article.ID.String()).
+		Build()
+	r = r.WithContext(appctx.WithIsAdmin(r.Context(), true))
+
+	articleController{svc}.GetArticle(w, r)
+
+	assert.Equal(t, http.StatusOK, w.Result().StatusCode)
+}
+
+func Test_GetArticleBySlug_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	article := factory.SampleArticle1
+	svc := mock_application.NewMockIArticleService(ctrl)
+	svc.EXPECT().GetArticleBySlug(gomock.Any(), article.Slug).Return(&article, nil)
+
+	w := httptest.NewRecorder()
+	r := lib.NewIncomingRequestBuilder(t).
+		WithPathParam("slug", article.Slug).
+		Build()
+
+	articleController{svc}.GetArticleBySlug(w, r)
+	statusCode := w.Result().StatusCode
+
+	respBody := response.SuccessResponse{}
+	respBytes, _ := io.ReadAll(w.Body)
+	json.Unmarshal(respBytes, &respBody)
+
+	result, _ := json.Marshal(respBody.Result)
+	resultDTO := v1resp.ArticleDTO{}
+	json.Unmarshal(result, &resultDTO)
+
+	assert.Equal(t, http.StatusOK, statusCode)
+	assert.True(t, respBody.Success)
+	assert.Equal(t, article.ID, resultDTO.ID)
+	assert.Equal(t, article.Slug, resultDTO.Slug)
+}
+
+func Test_GetArticleBySlug_RedirectToCurrentSlug_WhenSlugIsOld(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	article := factory.SampleArticle1
+	svc := mock_application.NewMockIArticleService(ctrl)
+	svc.EXPECT().GetArticleBySlug(gomock.Any(), "old-slug").Return(&article, nil)
+
+	w := httptest.NewRecorder()
+	r := lib.NewIncomingRequestBuilder(t).
+		WithPathParam("slug", "old-slug").
+		WithQueryString("").
+		Build()
+
+	articleController{svc}.GetArticleBySlug(w, r)
+
+	assert.Equal(t, http.StatusMovedPermanently, w.Result().StatusCode)
+	assert.Equal(t, "/v1/articles/by-slug/"+article.Slug, w.Result().Header.Get("Location"))
+}
+
+func Test_GetArticleBySlug_ReturnErr_WhenOldSlugOfUnpublishedArticle(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	article := factory.SampleArticle1
+	article.Status = model.ArticleStatusArchived
+	svc := mock_application.NewMockIArticleService(ctrl)
+	svc.EXPECT().GetArticleBySlug(gomock.Any(), "old-slug").Return(&article, nil)
+
+	w := httptest.NewRecorder()
+	r := lib.NewIncomingRequestBuilder(t).
+		WithPathParam("slug", "old-slug").
+		WithQueryString("").
+		Build()
+
+	articleController{svc}.GetArticleBySlug(w, r)
+
+	assert.Equal(t, http.StatusNotFound, w.Result().StatusCode)
+	assert.Empty(t, w.Result().Header.Get("Location"))
+}
+
+func Test_GetArticleBySlug_ReturnErr_WhenSlugNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	svc := mock_application.NewMockIArticleService(ctrl)
+	svc.EXPECT().GetArticleBySlug(gomock.Any(), "missing").Return(nil, apperror.ErrObjectNotExists)
+
+	w := httptest.NewRecorder()
+	r := lib.NewIncomingRequestBuilder(t).
+		WithPathParam("slug", "missing").
+		Build()
+
+	articleController{svc}.GetArticleBySlug(w, r)
+
+	assert.Equal(t, http.StatusNotFound, w.Result().StatusCode)
+}
+
+func Test_UpdateArticle_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	article := factory.SampleArticle1
+	dto := v1req.UpdateArticleDTO{
+		Title:    article.Title,
+		Body:     article.Body,
+		AuthorId: article.Author.ID.String(),
+	}
+	svc := mock_application.NewMockIArticleService(ctrl)
+	svc.EXPECT().UpdateArticle(gomock.Any(), article.ID, dto).Return(&article, nil)
+
+	w := httptest.NewRecorder()
+	r := lib.NewIncomingRequestBuilder(t).
+		WithPathParam("id", article.ID.String()).
+		WithJSONMarshal(dto).
+		Build()
+
+	articleController{svc}.UpdateArticle(w, r)
+	statusCode := w.Result().StatusCode
+
+	respBody := response.SuccessResponse{}
+	respBytes, _ := io.ReadAll(w.Body)
+	json.Unmarshal(respBytes, &respBody)
+
+	result, _ := json.Marshal(respBody.Result)
+	resultDTO := v1resp.ArticleDTO{}
+	json.Unmarshal(result, &resultDTO)
+
+	assert.Equal(t, http.StatusOK, statusCode)
+	assert.True(t, respBody.Success)
+	assert.Equal(t, article.ID, resultDTO.ID)
+	assert.True(t, article.UpdatedAt.Equal(resultDTO.UpdatedAt))
+}
+
+func Test_UpdateArticle_ReturnErr_WhenInvalidJson(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	svc := mock_application.NewMockIArticleService(ctrl)
+
+	w := httptest.NewRecorder()
+	r := lib.NewIncomingRequestBuilder(t).
+		WithPathParam("id", factory.SampleArticle1.ID.String()).
+		WithJSON("{").
+		Build()
+
+	articleController{svc}.UpdateArticle(w, r)
+	statusCode := w.Result().StatusCode
+
+	respBody := response.FailureResponse{}
+	respBytes, _ := io.ReadAll(w.Body)
+	json.Unmarshal(respBytes, &respBody)
+
+	assert.Equal(t, http.StatusBadRequest, statusCode)
+	assert.False(t, respBody.Success)
+	assert.Equal(t, apperror.ErrUnmarshalRequestBodyFailed.Error(), respBody.Failure)
+}
+
+func Test_UpdateArticle_ReturnErr_WhenInvalidDTO(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	svc := mock_application.NewMockIArticleService(ctrl)
+
+	w := httptest.NewRecorder()
+	r := lib.NewIncomingRequestBuilder(t).
+		WithPathParam("id", factory.SampleArticle1.ID.String()).
+		WithJSONMarshal(v1req.UpdateArticleDTO{}).
+		Build()
+
+	articleController{svc}.UpdateArticle(w, r)
+	statusCode := w.Result().StatusCode
+
+	respBody := response.FailureResponse{}
+	respBytes, _ := io.ReadAll(w.Body)
+	json.Unmarshal(respBytes, &respBody)
+
+	assert.Equal(t, http.StatusBadRequest, statusCode)
+	assert.False(t, respBody.Success)
+	assert.Equal(t, "title is required, body is required, authorId is required", respBody.Failure)
+}
+
+func Test_UpdateArticle_ReturnErr_WhenArticleNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	articleID := utils.GenerateUUID()
+	dto := v1req.UpdateArticleDTO{
+		Title:    "Article title",
+		Body:     "Article body",
+		AuthorId: factory.SampleAuthorChandra.ID.String(),
+	}
+	svc := mock_application.NewMockIArticleService(ctrl)
+	svc.EXPECT().UpdateArticle(gomock.Any(), articleID, dto).Return(nil, apperror.ErrObjectNotExists)
+
+	w := httptest.NewRecorder()
+	r := lib.NewIncomingRequestBuilder(t).
+		WithPathParam("id", articleID.String()).
+		WithJSONMarshal(dto).
+		Build()
+
+	articleController{svc}.UpdateArticle(w, r)
+	statusCode := w.Result().StatusCode
+
+	respBody := response.FailureResponse{}
+	respBytes, _ := io.ReadAll(w.Body)
+	json.Unmarshal(respBytes, &respBody)
+
+	assert.Equal(t, http.StatusNotFound, statusCode)
+	assert.False(t, respBody.Success)
+	assert.Equal(t, apperror.ErrObjectNotExists.Error(), respBody.Failure)
+}
+
+func Test_PatchArticle_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	article := factory.SampleArticle1
+	title := article.Title
+	dto := v1req.PatchArticleDTO{Title: &title}
+	svc := mock_application.NewMockIArticleService(ctrl)
+	svc.EXPECT().PatchArticle(gomock.Any(), article.ID, dto).Return(&article, nil)
+
+	w := httptest.NewRecorder()
+	r := lib.NewIncomingRequestBuilder(t).
+		WithPathParam("id", article.ID.String()).
+		WithJSON(`{"title": "Satu satu aku sayang ibu"}`).
+		Build()
+
+	articleController{svc}.PatchArticle(w, r)
+	statusCode := w.Result().StatusCode
+
+	respBody := response.SuccessResponse{}
+	respBytes, _ := io.ReadAll(w.Body)
+	json.Unmarshal(respBytes, &respBody)
+
+	assert.Equal(t, http.StatusOK, statusCode)
+	assert.True(t, respBody.Success)
+}
+
+func Test_PatchArticle_ReturnErr_WhenAuthorNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	article := factory.SampleArticle1
+	authorID := utils.GenerateUUID().String()
+	dto := v1req.PatchArticleDTO{AuthorId: &authorID}
+	svc := mock_application.NewMockIArticleService(ctrl)
+	svc.EXPECT().PatchArticle(gomock.Any(), article.ID, dto).Return(nil, apperror.ErrAuthorNotFound)
+
+	w := httptest.NewRecorder()
+	r := lib.NewIncomingRequestBuilder(t).
+		WithPathParam("id", article.ID.String()).
+		WithJSONMarshal(dto).
+		Build()
+
+	articleController{svc}.PatchArticle(w, r)
+	statusCode := w.Result().StatusCode
+
+	respBody := response.FailureResponse{}
+	respBytes, _ := io.ReadAll(w.Body)
+	json.Unmarshal(respBytes, &respBody)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, statusCode)
+	assert.False(t, respBody.Success)
+	assert.Equal(t, apperror.ErrAuthorNotFound.Error(), respBody.Failure)
+}
+
+func Test_ListArticles_ReturnErr_WhenIncludeDeletedByNonAdmin(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	svc := mock_application.NewMockIArticleService(ctrl)
+
+	w := httptest.NewRecorder()
+	r := lib.NewIncomingRequestBuilder(t).
+		WithQueryString("includeDeleted=true").
+		Build()
+
+	articleController{svc}.ListArticles(w, r)
+	statusCode := w.Result().StatusCode
+
+	respBody := response.FailureResponse{}
+	respBytes, _ := io.ReadAll(w.Body)
+	json.Unmarshal(respBytes, &respBody)
+
+	assert.Equal(t, http.StatusForbidden, statusCode)
+	assert.False(t, respBody.Success)
+	assert.Equal(t, apperror.ErrAdminOnly.Error(), respBody.Failure)
+}
+
+func Test_ListArticles_Success_WhenIncludeDeletedByAdmin(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	dto := v1req.ListArticlesDTO{IncludeDeleted: true}
+
+	svc := mock_application.NewMockIArticleService(ctrl)
+	svc.EXPECT().ListArticles(gomock.Any(), dto).Return([]*model.Article{}, int64(0), nil)
+
+	w := httptest.NewRecorder()
+	r := lib.NewIncomingRequestBuilder(t).
+		WithQueryString("includeDeleted=true").
+		Build()
+	r = r.WithContext(appctx.WithIsAdmin(r.Context(), true))
+
+	articleController{svc}.ListArticles(w, r)
+	statusCode := w.Result().StatusCode
+
+	assert.Equal(t, http.StatusOK, statusCode)
+}
+
+func Test_DeleteArticle_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	article := factory.SampleArticle1
+	svc := mock_application.NewMockIArticleService(ctrl)
+	svc.EXPECT().DeleteArticle(gomock.Any(), article.ID).Return(nil)
+
+	w := httptest.NewRecorder()
+	r := lib.NewIncomingRequestBuilder(t).
+		WithPathParam("id", article.ID.String()).
+		Build()
+
+	articleController{svc}.DeleteArticle(w, r)
+	statusCode := w.Result().StatusCode
+
+	respBody := response.SuccessResponse{}
+	respBytes, _ := io.ReadAll(w.Body)
+	json.Unmarshal(respBytes, &respBody)
+
+	assert.Equal(t, http.StatusOK, statusCode)
+	assert.True(t, respBody.Success)
+}
+
+func Test_DeleteArticle_ReturnErr_WhenArticleNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	articleID := utils.GenerateUUID()
+	svc := mock_application.NewMockIArticleService(ctrl)
+	svc.EXPECT().DeleteArticle(gomock.Any(), articleID).Return(apperror.ErrObjectNotExists)
+
+	w := httptest.NewRecorder()
+	r := lib.NewIncomingRequestBuilder(t).
+		WithPathParam("id", articleID.String()).
+		Build()
+
+	articleController{svc}.DeleteArticle(w, r)
+	statusCode := w.Result().StatusCode
+
+	respBody := response.FailureResponse{}
+	respBytes, _ := io.ReadAll(w.Body)
+	json.Unmarshal(respBytes, &respBody)
+
+	assert.Equal(t, http.StatusNotFound, statusCode)
+	assert.False(t, respBody.Success)
+	assert.Equal(t, apperror.ErrObjectNotExists.Error(), respBody.Failure)
+}
+
+func Test_RestoreArticle_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	article := factory.SampleArticle1
+	svc := mock_application.NewMockIArticleService(ctrl)
+	svc.EXPECT().RestoreArticle(gomock.Any(), article.ID).Return(&article, nil)
+
+	w := httptest.NewRecorder()
+	r := lib.NewIncomingRequestBuilder(t).
+		WithPathParam("id", article.ID.String()).
+		Build()
+
+	articleController{svc}.RestoreArticle(w, r)
+	statusCode := w.Result().StatusCode
+
+	respBody := response.SuccessResponse{}
+	respBytes, _ := io.ReadAll(w.Body)
+	json.Unmarshal(respBytes, &respBody)
+
+	result, _ := json.Marshal(respBody.Result)
+	resultDTO := v1resp.ArticleDTO{}
+	json.Unmarshal(result, &resultDTO)
+
+	assert.Equal(t, http.StatusOK, statusCode)
+	assert.True(t, respBody.Success)
+	assert.Equal(t, article.ID, resultDTO.ID)
+	assert.Nil(t, resultDTO.DeletedAt)
+}
+
+func Test_RestoreArticle_ReturnErr_WhenArticleNotDeleted(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	article := factory.SampleArticle1
+	svc := mock_application.NewMockIArticleService(ctrl)
+	svc.EXPECT().RestoreArticle(gomock.Any(), article.ID).Return(nil, apperror.ErrArticleNotDeleted)
+
+	w := httptest.NewRecorder()
+	r := lib.NewIncomingRequestBuilder(t).
+		WithPathParam("id", article.ID.String()).
+		Build()
+
+	articleController{svc}.RestoreArticle(w, r)
+	statusCode := w.Result().StatusCode
+
+	respBody := response.FailureResponse{}
+	respBytes, _ := io.ReadAll(w.Body)
+	json.Unmarshal(respBytes, &respBody)
+
+	assert.Equal(t, http.StatusConflict, statusCode)
+	assert.False(t, respBody.Success)
+	assert.Equal(t, apperror.ErrArticleNotDeleted.Error(), respBody.Failure)
+}
+
+func Test_PublishArticle_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	article := factory.SampleArticle1
+	svc := mock_application.NewMockIArticleService(ctrl)
+	svc.EXPECT().PublishArticle(gomock.Any(), article.ID).Return(&article, nil)
+
+	w := httptest.NewRecorder()
+	r := lib.NewIncomingRequestBuilder(t).
+		WithPathParam("id", article.ID.String()).
+		Build()
+
+	articleController{svc}.PublishArticle(w, r)
+	statusCode := w.Result().StatusCode
+
+	respBody := response.SuccessResponse{}
+	respBytes, _ := io.ReadAll(w.Body)
+	json.Unmarshal(respBytes, &respBody)
+
+	result, _ := json.Marshal(respBody.Result)
+	resultDTO := v1resp.ArticleDTO{}
+	json.Unmarshal(result, &resultDTO)
+
+	assert.Equal(t, http.StatusOK, statusCode)
+	assert.True(t, respBody.Success)
+	assert.Equal(t, string(model.ArticleStatusPublished), resultDTO.Status)
+	assert.NotNil(t, resultDTO.PublishedAt)
+}
+
+func Test_PublishArticle_ReturnErr_WhenTransitionNotAllowed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	article := factory.SampleArticle1
+	svc := mock_application.NewMockIArticleService(ctrl)
+	svc.EXPECT().PublishArticle(gomock.Any(), article.ID).Return(nil, apperror.ErrInvalidStatusTransition)
+
+	w := httptest.NewRecorder()
+	r := lib.NewIncomingRequestBuilder(t).
+		WithPathParam("id", article.ID.String()).
+		Build()
+
+	articleController{svc}.PublishArticle(w, r)
+	statusCode := w.Result().StatusCode
+
+	respBody := response.FailureResponse{}
+	respBytes, _ := io.ReadAll(w.Body)
+	json.Unmarshal(respBytes, &respBody)
+
+	assert.Equal(t, http.StatusConflict, statusCode)
+	assert.False(t, respBody.Success)
+	assert.Equal(t, apperror.ErrInvalidStatusTransition.Error(), respBody.Failure)
+}
+
+func Test_ArchiveArticle_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	article := factory.SampleArticle1
+	article.Status = model.ArticleStatusArchived
+	svc := mock_application.NewMockIArticleService(ctrl)
+	svc.EXPECT().ArchiveArticle(gomock.Any(), article.ID).Return(&article, nil)
+
+	w := httptest.NewRecorder()
+	r := lib.NewIncomingRequestBuilder(t).
+		WithPathParam("id", article.ID.String()).
+		Build()
+
+	articleController{svc}.ArchiveArticle(w, r)
+	statusCode := w.Result().StatusCode
+
+	respBody := response.SuccessResponse{}
+	respBytes, _ := io.ReadAll(w.Body)
+	json.Unmarshal(respBytes, &respBody)
+
+	result, _ := json.Marshal(respBody.Result)
+	resultDTO := v1resp.ArticleDTO{}
+	json.Unmarshal(result, &resultDTO)
+
+	assert.Equal(t, http.StatusOK, statusCode)
+	assert.Equal(t, string(model.ArticleStatusArchived), resultDTO.Status)
+}
+
+func Test_ArchiveArticle_ReturnErr_WhenArticleNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	article := factory.SampleArticle1
+	svc := mock_application.NewMockIArticleService(ctrl)
+	svc.EXPECT().ArchiveArticle(gomock.Any(), article.ID).Return(nil, apperror.ErrObjectNotExists)
+
+	w := httptest.NewRecorder()
+	r := lib.NewIncomingRequestBuilder(t).
+		WithPathParam("id", article.ID.String()).
+		Build()
+
+	articleController{svc}.ArchiveArticle(w, r)
+	statusCode := w.Result().StatusCode
+
+	assert.Equal(t, http.StatusNotFound, statusCode)
+}
+
+func Test_ScheduleArticle_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	publishAt := time.Now().Add(time.Hour).Truncate(time.Second)
+	article := factory.SampleArticle1
+	article.Status = model.ArticleStatusDraft
+	article.PublishAt = &publishAt
+	dto := v1req.ScheduleArticleDTO{PublishAt: publishAt}
+
+	svc := mock_application.NewMockIArticleService(ctrl)
+	svc.EXPECT().ScheduleArticle(gomock.Any(), article.ID, gomock.Any()).Return(&article, nil)
+
+	w := httptest.NewRecorder()
+	r := lib.NewIncomingRequestBuilder(t).
+		WithPathParam("id", article.ID.String()).
+		WithJSONMarshal(dto).
+		Build()
+
+	articleController{svc}.ScheduleArticle(w, r)
+	statusCode := w.Result().StatusCode
+
+	respBody := response.SuccessResponse{}
+	respBytes, _ := io.ReadAll(w.Body)
+	json.Unmarshal(respBytes, &respBody)
+
+	result, _ := json.Marshal(respBody.Result)
+	resultDTO := v1resp.ArticleDTO{}
+	json.Unmarshal(result, &resultDTO)
+
+	assert.Equal(t, http.StatusOK, statusCode)
+	assert.True(t, publishAt.Equal(*resultDTO.PublishAt))
+}
+
+func Test_ScheduleArticle_ReturnErr_WhenPublishAtInThePast(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	article := factory.SampleArticle1
+	dto := v1req.ScheduleArticleDTO{PublishAt: time.Now().Add(-time.Hour)}
+	svc := mock_application.NewMockIArticleService(ctrl)
+
+	w := httptest.NewRecorder()
+	r := lib.NewIncomingRequestBuilder(t).
+		WithPathParam("id", article.ID.String()).
+		WithJSONMarshal(dto).
+		Build()
+
+	articleController{svc}.ScheduleArticle(w, r)
+	statusCode := w.Result().StatusCode
+
+	respBody := response.FailureResponse{}
+	respBytes, _ := io.ReadAll(w.Body)
+	json.Unmarshal(respBytes, &respBody)
+
+	assert.Equal(t, http.StatusBadRequest, statusCode)
+	assert.Equal(t, "publishAt is invalid", respBody.Failure)
+}
+
+func Test_ScheduleArticle_ReturnErr_WhenArticleIsNotDraft(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	article := factory.SampleArticle1
+	dto := v1req.ScheduleArticleDTO{PublishAt: time.Now().Add(time.Hour)}
+	svc := mock_application.NewMockIArticleService(ctrl)
+	svc.EXPECT().ScheduleArticle(gomock.Any(), article.ID, gomock.Any()).Return(nil, apperror.ErrArticleNotDraft)
+
+	w := httptest.NewRecorder()
+	r := lib.NewIncomingRequestBuilder(t).
+		WithPathParam("id", article.ID.String()).
+		WithJSONMarshal(dto).
+		Build()
+
+	articleController{svc}.ScheduleArticle(w, r)
+	statusCode := w.Result().StatusCode
+
+	assert.Equal(t, http.StatusConflict, statusCode)
+}
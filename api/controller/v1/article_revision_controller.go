@@ -0,0 +1,157 @@
+package v1
+
+import (
+	"net/http"
+	"strconv"
+
+	"article-service/api/controller"
+	"article-service/apperror"
+	"article-service/application"
+	v1req "article-service/dto/request/v1_req"
+	v1resp "article-service/dto/response/v1_resp"
+	"article-service/infrastructure/log"
+
+	"github.com/go-chi/chi"
+	"github.com/google/uuid"
+)
+
+type articleRevisionController struct {
+	svc application.IArticleRevisionService
+}
+
+func InitArticleRevisionController() *articleRevisionController {
+	return &articleRevisionController{
+		svc: application.GetArticleRevisionService(),
+	}
+}
+
+func (c articleRevisionController) ListRevisions(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		log.Errorf(ctx, err, "[V1][ArticleRevisionController][ListRevisions] Invalid article id")
+		controller.WriteError(ctx, w, http.StatusBadRequest, apperror.ErrInvalidArticleID)
+		return
+	}
+
+	revisions, err := c.svc.ListRevisions(ctx, id)
+	if err != nil {
+		log.Errorf(ctx, err, "[V1][ArticleRevisionController][ListRevisions] svc.ListRevisions is failed, id: %s", id)
+		controller.WriteError(ctx, w, revisionErrStatusCode(err), err)
+		return
+	}
+
+	resp := new(v1resp.ListArticleRevisionsDTO).Convert(revisions)
+	controller.WriteSuccess(ctx, w, http.StatusOK, resp)
+}
+
+func (c articleRevisionController) GetRevision(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		log.Errorf(ctx, err, "[V1][ArticleRevisionController][GetRevision] Invalid article id")
+		controller.WriteError(ctx, w, http.StatusBadRequest, apperror.ErrInvalidArticleID)
+		return
+	}
+
+	revisionNumber, err := parseRevision(chi.URLParam(r, "n"))
+	if err != nil {
+		log.Errorf(ctx, err, "[V1][ArticleRevisionController][GetRevision] Invalid revision number")
+		controller.WriteError(ctx, w, http.StatusBadRequest, err)
+		return
+	}
+
+	revision, err := c.svc.GetRevision(ctx, id, revisionNumber)
+	if err != nil {
+		log.Errorf(ctx, err, "[V1][ArticleRevisionController][GetRevision] svc.GetRevision is failed, id: %s, revision: %d", id, revisionNumber)
+		controller.WriteError(ctx, w, revisionErrStatusCode(err), err)
+		return
+	}
+
+	resp := new(v1resp.ArticleRevisionDTO).Convert(revision)
+	controller.WriteSuccess(ctx, w, http.StatusOK, resp)
+}
+
+func (c articleRevisionController) DiffRevisions(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	queryParams := r.URL.Query()
+
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		log.Errorf(ctx, err, "[V1][ArticleRevisionController][DiffRevisions] Invalid article id")
+		controller.WriteError(ctx, w, http.StatusBadRequest, apperror.ErrInvalidArticleID)
+		return
+	}
+
+	from, _ := strconv.Atoi(queryParams.Get("from"))
+	to, _ := strconv.Atoi(queryParams.Get("to"))
+
+	dto := v1req.DiffArticleRevisionsDTO{
+		From: from,
+		To:   to,
+	}
+
+	err = dto.Validate(ctx)
+	if err != nil {
+		log.Errorf(ctx, err, "[V1][ArticleRevisionController][DiffRevisions] Validation failed for request dto %v ", dto)
+		controller.WriteError(ctx, w, http.StatusBadRequest, err)
+		return
+	}
+
+	diff, err := c.svc.DiffRevisions(ctx, id, dto.From, dto.To)
+	if err != nil {
+		log.Errorf(ctx, err, "[V1][ArticleRevisionController][DiffRevisions] svc.DiffRevisions is failed for request dto: %v ", dto)
+		controller.WriteError(ctx, w, revisionErrStatusCode(err), err)
+		return
+	}
+
+	resp := new(v1resp.ArticleRevisionDiffDTO).Convert(diff)
+	controller.WriteSuccess(ctx, w, http.StatusOK, resp)
+}
+
+func (c articleRevisionController) RestoreRevision(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		log.Errorf(ctx, err, "[V1][ArticleRevisionController][RestoreRevision] Invalid article id")
+		controller.WriteError(ctx, w, http.StatusBadRequest, apperror.ErrInvalidArticleID)
+		return
+	}
+
+	revisionNumber, err := parseRevision(chi.URLParam(r, "n"))
+	if err != nil {
+		log.Errorf(ctx, err, "[V1][ArticleRevisionController][RestoreRevision] Invalid revision number")
+		controller.WriteError(ctx, w, http.StatusBadRequest, err)
+		return
+	}
+
+	article, err := c.svc.RestoreRevision(ctx, id, revisionNumber)
+	if err != nil {
+		log.Errorf(ctx, err, "[V1][ArticleRevisionController][RestoreRevision] svc.RestoreRevision is failed, id: %s, revision: %d", id, revisionNumber)
+		controller.WriteError(ctx, w, updateArticleErrStatusCode(err), err)
+		return
+	}
+
+	resp := new(v1resp.ArticleDTO).Convert(article)
+	controller.WriteSuccess(ctx, w, http.StatusOK, resp)
+}
+
+func parseRevision(param string) (int, error) {
+	revision, err := strconv.Atoi(param)
+	if err != nil || revision < 1 {
+		return 0, apperror.ErrInvalidRevision
+	}
+	return revision, nil
+}
+
+func revisionErrStatusCode(err error) int {
+	switch err {
+	case apperror.ErrObjectNotExists:
+		return http.StatusNotFound
+	default:
+		return http.StatusInternalServerError
+	}
+}
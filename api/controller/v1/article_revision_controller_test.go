@@ -0,0 +1,238 @@
+package v1
+
+import (
+	"article-service/apperror"
+	"article-service/application/mock_application"
+	"article-service/dto/response"
+	v1resp "article-service/dto/response/v1_resp"
+	"article-service/factory"
+	"article-service/lib"
+	"article-service/model"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ListRevisions_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	article := factory.SampleArticle1
+	revision := factory.SampleArticle1Revision1
+	svc := mock_application.NewMockIArticleRevisionService(ctrl)
+	svc.EXPECT().ListRevisions(gomock.Any(), article.ID).Return([]*model.ArticleRevision{&revision}, nil)
+
+	w := httptest.NewRecorder()
+	r := lib.NewIncomingRequestBuilder(t).
+		WithPathParam("id", article.ID.String()).
+		Build()
+
+	articleRevisionController{svc}.ListRevisions(w, r)
+	statusCode := w.Result().StatusCode
+
+	respBody := response.SuccessResponse{}
+	respBytes, _ := io.ReadAll(w.Body)
+	json.Unmarshal(respBytes, &respBody)
+
+	result, _ := json.Marshal(respBody.Result)
+	resultDTO := v1resp.ListArticleRevisionsDTO{}
+	json.Unmarshal(result, &resultDTO)
+
+	assert.Equal(t, http.StatusOK, statusCode)
+	assert.True(t, respBody.Success)
+	assert.Len(t, resultDTO.Revisions, 1)
+	assert.Equal(t, revision.Revision, resultDTO.Revisions[0].Revision)
+}
+
+func Test_ListRevisions_ReturnErr_WhenArticleNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	article := factory.SampleArticle1
+	svc := mock_application.NewMockIArticleRevisionService(ctrl)
+	svc.EXPECT().ListRevisions(gomock.Any(), article.ID).Return(nil, apperror.ErrObjectNotExists)
+
+	w := httptest.NewRecorder()
+	r := lib.NewIncomingRequestBuilder(t).
+		WithPathParam("id", article.ID.String()).
+		Build()
+
+	articleRevisionController{svc}.ListRevisions(w, r)
+	statusCode := w.Result().StatusCode
+
+	respBody := response.FailureResponse{}
+	respBytes, _ := io.ReadAll(w.Body)
+	json.Unmarshal(respBytes, &respBody)
+
+	assert.Equal(t, http.StatusNotFound, statusCode)
+	assert.False(t, respBody.Success)
+	assert.Equal(t, apperror.ErrObjectNotExists.Error(), respBody.Failure)
+}
+
+func Test_GetRevision_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	article := factory.SampleArticle1
+	revision := factory.SampleArticle1Revision1
+	svc := mock_application.NewMockIArticleRevisionService(ctrl)
+	svc.EXPECT().GetRevision(gomock.Any(), article.ID, 1).Return(&revision, nil)
+
+	w := httptest.NewRecorder()
+	r := lib.NewIncomingRequestBuilder(t).
+		WithPathParam("id", article.ID.String()).
+		WithPathParam("n", "1").
+		Build()
+
+	articleRevisionController{svc}.GetRevision(w, r)
+	statusCode := w.Result().StatusCode
+
+	respBody := response.SuccessResponse{}
+	respBytes, _ := io.ReadAll(w.Body)
+	json.Unmarshal(respBytes, &respBody)
+
+	result, _ := json.Marshal(respBody.Result)
+	resultDTO := v1resp.ArticleRevisionDTO{}
+	json.Unmarshal(result, &resultDTO)
+
+	assert.Equal(t, http.StatusOK, statusCode)
+	assert.True(t, respBody.Success)
+	assert.Equal(t, revision.Title, resultDTO.Title)
+	assert.Equal(t, revision.AuthorID, resultDTO.AuthorID)
+}
+
+func Test_GetRevision_ReturnErr_WhenInvalidRevision(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	article := factory.SampleArticle1
+	svc := mock_application.NewMockIArticleRevisionService(ctrl)
+
+	w := httptest.NewRecorder()
+	r := lib.NewIncomingRequestBuilder(t).
+		WithPathParam("id", article.ID.String()).
+		WithPathParam("n", "0").
+		Build()
+
+	articleRevisionController{svc}.GetRevision(w, r)
+	statusCode := w.Result().StatusCode
+
+	respBody := response.FailureResponse{}
+	respBytes, _ := io.ReadAll(w.Body)
+	json.Unmarshal(respBytes, &respBody)
+
+	assert.Equal(t, http.StatusBadRequest, statusCode)
+	assert.False(t, respBody.Success)
+	assert.Equal(t, apperror.ErrInvalidRevision.Error(), respBody.Failure)
+}
+
+func Test_DiffRevisions_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	article := factory.SampleArticle1
+	diff := model.ArticleRevisionDiff{
+		From:  1,
+		To:    2,
+		Title: []model.DiffLine{{Op: model.DiffOpEqual, Text: article.Title}},
+		Body: []model.DiffLine{
+			{Op: model.DiffOpDelete, Text: "old body"},
+			{Op: model.DiffOpInsert, Text: "new body"},
+		},
+	}
+	svc := mock_application.NewMockIArticleRevisionService(ctrl)
+	svc.EXPECT().DiffRevisions(gomock.Any(), article.ID, 1, 2).Return(&diff, nil)
+
+	w := httptest.NewRecorder()
+	r := lib.NewIncomingRequestBuilder(t).
+		WithPathParam("id", article.ID.String()).
+		WithQueryString("from=1&to=2").
+		Build()
+
+	articleRevisionController{svc}.DiffRevisions(w, r)
+	statusCode := w.Result().StatusCode
+
+	respBody := response.SuccessResponse{}
+	respBytes, _ := io.ReadAll(w.Body)
+	json.Unmarshal(respBytes, &respBody)
+
+	result, _ := json.Marshal(respBody.Result)
+	resultDTO := v1resp.ArticleRevisionDiffDTO{}
+	json.Unmarshal(result, &resultDTO)
+
+	assert.Equal(t, http.StatusOK, statusCode)
+	assert.True(t, respBody.Success)
+	assert.Len(t, resultDTO.Body, 2)
+	assert.Equal(t, string(model.DiffOpInsert), resultDTO.Body[1].Op)
+}
+
+func Test_DiffRevisions_ReturnErr_WhenMissingRevision(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	article := factory.SampleArticle1
+	svc := mock_application.NewMockIArticleRevisionService(ctrl)
+
+	w := httptest.NewRecorder()
+	r := lib.NewIncomingRequestBuilder(t).
+		WithPathParam("id", article.ID.String()).
+		WithQueryString("from=1").
+		Build()
+
+	articleRevisionController{svc}.DiffRevisions(w, r)
+	statusCode := w.Result().StatusCode
+
+	respBody := response.FailureResponse{}
+	respBytes, _ := io.ReadAll(w.Body)
+	json.Unmarshal(respBytes, &respBody)
+
+	assert.Equal(t, http.StatusBadRequest, statusCode)
+	assert.False(t, respBody.Success)
+}
+
+func Test_RestoreRevision_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	article := factory.SampleArticle1
+	svc := mock_application.NewMockIArticleRevisionService(ctrl)
+	svc.EXPECT().RestoreRevision(gomock.Any(), article.ID, 1).Return(&article, nil)
+
+	w := httptest.NewRecorder()
+	r := lib.NewIncomingRequestBuilder(t).
+		WithPathParam("id", article.ID.String()).
+		WithPathParam("n", "1").
+		Build()
+
+	articleRevisionController{svc}.RestoreRevision(w, r)
+	statusCode := w.Result().StatusCode
+
+	respBody := response.SuccessResponse{}
+	respBytes, _ := io.ReadAll(w.Body)
+	json.Unmarshal(respBytes, &respBody)
+
+	result, _ := json.Marshal(respBody.Result)
+	resultDTO := v1resp.ArticleDTO{}
+	json.Unmarshal(result, &resultDTO)
+
+	assert.Equal(t, http.StatusOK, statusCode)
+	assert.True(t, respBody.Success)
+	assert.Equal(t, article.ID, resultDTO.ID)
+}
+
+func Test_RestoreRevision_ReturnErr_WhenRevisionNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	article := factory.SampleArticle1
+	svc := mock_application.NewMockIArticleRevisionService(ctrl)
+	svc.EXPECT().RestoreRevision(gomock.Any(), article.ID, 9).Return(nil, apperror.ErrObjectNotExists)
+
+	w := httptest.NewRecorder()
+	r := lib.NewIncomingRequestBuilder(t).
+		WithPathParam("id", article.ID.String()).
+		WithPathParam("n", "9").
+		Build()
+
+	articleRevisionController{svc}.RestoreRevision(w, r)
+	statusCode := w.Result().StatusCode
+
+	assert.Equal(t, http.StatusNotFound, statusCode)
+}
@@ -0,0 +1,226 @@
+package v1
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+
+	"article-service/api/controller"
+	"article-service/apperror"
+	"article-service/application"
+	v1req "article-service/dto/request/v1_req"
+	v1resp "article-service/dto/response/v1_resp"
+	"article-service/infrastructure/log"
+
+	"github.com/go-chi/chi"
+	"github.com/google/uuid"
+)
+
+type authorController struct {
+	svc application.IAuthorService
+}
+
+func InitAuthorController() *authorController {
+	return &authorController{
+		svc: application.GetAuthorService(),
+	}
+}
+
+func (c authorController) CreateAuthor(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	reqBody, _ := io.ReadAll(r.Body)
+	dto := v1req.CreateAuthorDTO{}
+	if err := json.Unmarshal(reqBody, &dto); err != nil {
+		log.Errorf(ctx, err, "[V1][AuthorController][CreateAuthor] Failed to unmarshal request body %v into dto", reqBody)
+		controller.WriteError(ctx, w, http.StatusBadRequest, apperror.ErrUnmarshalRequestBodyFailed)
+		return
+	}
+
+	err := dto.Validate(ctx)
+	if err != nil {
+		log.Errorf(ctx, err, "[V1][AuthorController][CreateAuthor] Validation failed for request dto %v ", dto)
+		controller.WriteError(ctx, w, http.StatusBadRequest, err)
+		return
+	}
+
+	id, err := c.svc.CreateAuthor(ctx, dto)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if err == apperror.ErrAuthorHandleTaken {
+			statusCode = http.StatusConflict
+		}
+		log.Errorf(ctx, err, "[V1][AuthorController][CreateAuthor] svc.CreateAuthor is failed for request dto: %v ", dto)
+		controller.WriteError(ctx, w, statusCode, err)
+		return
+	}
+
+	resp := v1resp.CreateAuthorDTO{ID: id}
+	controller.WriteSuccess(ctx, w, http.StatusCreated, resp)
+}
+
+func (c authorController) GetAuthor(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		log.Errorf(ctx, err, "[V1][AuthorController][GetAuthor] Invalid author id")
+		controller.WriteError(ctx, w, http.StatusBadRequest, apperror.ErrInvalidAuthorID)
+		return
+	}
+
+	author, err := c.svc.GetAuthor(ctx, id)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if err == apperror.ErrObjectNotExists {
+			statusCode = http.StatusNotFound
+		}
+		log.Errorf(ctx, err, "[V1][AuthorController][GetAuthor] svc.GetAuthor is failed, id: %s", id)
+		controller.WriteError(ctx, w, statusCode, err)
+		return
+	}
+
+	resp := new(v1resp.AuthorDTO).Convert(author)
+	controller.WriteSuccess(ctx, w, http.StatusOK, resp)
+}
+
+func (c authorController) ListAuthors(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	queryParams := r.URL.Query()
+
+	name := queryParams.Get("name")
+	limit := queryParams.Get("limit")
+	page := queryParams.Get("page")
+
+	limitInt, _ := strconv.Atoi(limit)
+	pageInt, _ := strconv.Atoi(page)
+
+	dto := v1req.ListAuthorsDTO{
+		Name:  name,
+		Limit: limitInt,
+		Page:  pageInt,
+	}
+
+	authors, recordsCount, err := c.svc.ListAuthors(ctx, dto)
+	if err != nil {
+		log.Errorf(ctx, err, "[V1][AuthorController][ListAuthors] svc.ListAuthors is failed")
+		controller.WriteError(ctx, w, http.StatusInternalServerError, err)
+		return
+	}
+
+	resp := new(v1resp.ListAuthorsDTO).Convert(authors, recordsCount)
+	controller.WriteSuccess(ctx, w, http.StatusOK, resp)
+}
+
+func (c authorController) UpdateAuthor(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		log.Errorf(ctx, err, "[V1][AuthorController][UpdateAuthor] Invalid author id")
+		controller.WriteError(ctx, w, http.StatusBadRequest, apperror.ErrInvalidAuthorID)
+		return
+	}
+
+	reqBody, _ := io.ReadAll(r.Body)
+	dto := v1req.UpdateAuthorDTO{}
+	if err := json.Unmarshal(reqBody, &dto); err != nil {
+		log.Errorf(ctx, err, "[V1][AuthorController][UpdateAuthor] Failed to unmarshal request body %v into dto", reqBody)
+		controller.WriteError(ctx, w, http.StatusBadRequest, apperror.ErrUnmarshalRequestBodyFailed)
+		return
+	}
+
+	err = dto.Validate(ctx)
+	if err != nil {
+		log.Errorf(ctx, err, "[V1][AuthorController][UpdateAuthor] Validation failed for request dto %v ", dto)
+		controller.WriteError(ctx, w, http.StatusBadRequest, err)
+		return
+	}
+
+	author, err := c.svc.UpdateAuthor(ctx, id, dto)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		switch err {
+		case apperror.ErrObjectNotExists:
+			statusCode = http.StatusNotFound
+		case apperror.ErrAuthorHandleTaken:
+			statusCode = http.StatusConflict
+		}
+		log.Errorf(ctx, err, "[V1][AuthorController][UpdateAuthor] svc.UpdateAuthor is failed for request dto: %v ", dto)
+		controller.WriteError(ctx, w, statusCode, err)
+		return
+	}
+
+	resp := new(v1resp.AuthorDTO).Convert(author)
+	controller.WriteSuccess(ctx, w, http.StatusOK, resp)
+}
+
+func (c authorController) DeleteAuthor(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		log.Errorf(ctx, err, "[V1][AuthorController][DeleteAuthor] Invalid author id")
+		controller.WriteError(ctx, w, http.StatusBadRequest, apperror.ErrInvalidAuthorID)
+		return
+	}
+
+	err = c.svc.DeleteAuthor(ctx, id)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		switch err {
+		case apperror.ErrObjectNotExists:
+			statusCode = http.StatusNotFound
+		case apperror.ErrAuthorHasArticles:
+			statusCode = http.StatusConflict
+		}
+		log.Errorf(ctx, err, "[V1][AuthorController][DeleteAuthor] svc.DeleteAuthor is failed, id: %s", id)
+		controller.WriteError(ctx, w, statusCode, err)
+		return
+	}
+
+	controller.WriteSuccess(ctx, w, http.StatusOK, nil)
+}
+
+func (c authorController) ListAuthorArticles(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	queryParams := r.URL.Query()
+
+	sortBy := queryParams.Get("sortBy")
+	sortDirection := queryParams.Get("sortDirection")
+	limit := queryParams.Get("limit")
+	page := queryParams.Get("page")
+
+	limitInt, _ := strconv.Atoi(limit)
+	pageInt, _ := strconv.Atoi(page)
+
+	dto := v1req.ListAuthorArticlesDTO{
+		Handle:        chi.URLParam(r, "handle"),
+		SortBy:        sortBy,
+		SortDirection: sortDirection,
+		Limit:         limitInt,
+		Page:          pageInt,
+	}
+
+	err := dto.Validate(ctx)
+	if err != nil {
+		log.Errorf(ctx, err, "[V1][AuthorController][ListAuthorArticles] Validation failed for request dto %v ", dto)
+		controller.WriteError(ctx, w, http.StatusBadRequest, err)
+		return
+	}
+
+	articles, recordsCount, err := c.svc.ListAuthorArticles(ctx, dto)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if err == apperror.ErrObjectNotExists {
+			statusCode = http.StatusNotFound
+		}
+		log.Errorf(ctx, err, "[V1][AuthorController][ListAuthorArticles] svc.ListAuthorArticles is failed, handle: %s", dto.Handle)
+		controller.WriteError(ctx, w, statusCode, err)
+		return
+	}
+
+	resp := new(v1resp.ListArticlesDTO).Convert(articles, recordsCount)
+	controller.WriteSuccess(ctx, w, http.StatusOK, resp)
+}
@@ -0,0 +1,348 @@
+package v1
+
+import (
+	"article-service/apperror"
+	"article-service/application"
+	"article-service/application/mock_application"
+	v1req "article-service/dto/request/v1_req"
+	"article-service/dto/response"
+	v1resp "article-service/dto/response/v1_resp"
+	"article-service/factory"
+	"article-service/lib"
+	"article-service/model"
+	"article-service/utils"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_InitAuthorController(t *testing.T) {
+	application.InitAuthorService()
+
+	authorController := InitAuthorController()
+	assert.NotNil(t, authorController.svc)
+}
+
+func Test_CreateAuthor_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	dto := v1req.CreateAuthorDTO{Name: "New Author", Handle: "new-author"}
+
+	authorID := utils.GenerateUUID()
+	svc := mock_application.NewMockIAuthorService(ctrl)
+	svc.EXPECT().CreateAuthor(gomock.Any(), dto).Return(authorID, nil)
+
+	w := httptest.NewRecorder()
+	r := lib.NewIncomingRequestBuilder(t).WithJSONMarshal(dto).Build()
+
+	authorController{svc}.CreateAuthor(w, r)
+	statusCode := w.Result().StatusCode
+
+	respBody := response.SuccessResponse{}
+	respBytes, _ := io.ReadAll(w.Body)
+	json.Unmarshal(respBytes, &respBody)
+
+	result, _ := json.Marshal(respBody.Result)
+	resultDTO := v1resp.CreateAuthorDTO{}
+	json.Unmarshal(result, &resultDTO)
+
+	assert.Equal(t, http.StatusCreated, statusCode)
+	assert.True(t, respBody.Success)
+	assert.Equal(t, authorID, resultDTO.ID)
+}
+
+func Test_CreateAuthor_ReturnErr_WhenInvalidDTO(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	svc := mock_application.NewMockIAuthorService(ctrl)
+
+	w := httptest.NewRecorder()
+	r := lib.NewIncomingRequestBuilder(t).WithJSONMarshal(v1req.CreateAuthorDTO{}).Build()
+
+	authorController{svc}.CreateAuthor(w, r)
+	statusCode := w.Result().StatusCode
+
+	respBody := response.FailureResponse{}
+	respBytes, _ := io.ReadAll(w.Body)
+	json.Unmarshal(respBytes, &respBody)
+
+	assert.Equal(t, http.StatusBadRequest, statusCode)
+	assert.False(t, respBody.Success)
+	assert.Equal(t, "name is required, handle is required", respBody.Failure)
+}
+
+func Test_CreateAuthor_ReturnErr_WhenInvalidHandle(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	svc := mock_application.NewMockIAuthorService(ctrl)
+
+	dto := v1req.CreateAuthorDTO{Name: "New Author", Handle: "New Author"}
+	w := httptest.NewRecorder()
+	r := lib.NewIncomingRequestBuilder(t).WithJSONMarshal(dto).Build()
+
+	authorController{svc}.CreateAuthor(w, r)
+	statusCode := w.Result().StatusCode
+
+	respBody := response.FailureResponse{}
+	respBytes, _ := io.ReadAll(w.Body)
+	json.Unmarshal(respBytes, &respBody)
+
+	assert.Equal(t, http.StatusBadRequest, statusCode)
+	assert.Equal(t, "handle is invalid", respBody.Failure)
+}
+
+func Test_CreateAuthor_ReturnErr_WhenHandleTaken(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	dto := v1req.CreateAuthorDTO{Name: "Chandra", Handle: "chandra"}
+
+	svc := mock_application.NewMockIAuthorService(ctrl)
+	svc.EXPECT().CreateAuthor(gomock.Any(), dto).Return(uuid.Nil, apperror.ErrAuthorHandleTaken)
+
+	w := httptest.NewRecorder()
+	r := lib.NewIncomingRequestBuilder(t).WithJSONMarshal(dto).Build()
+
+	authorController{svc}.CreateAuthor(w, r)
+	statusCode := w.Result().StatusCode
+
+	assert.Equal(t, http.StatusConflict, statusCode)
+}
+
+func Test_GetAuthor_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	author := factory.SampleAuthorChandra
+	svc := mock_application.NewMockIAuthorService(ctrl)
+	svc.EXPECT().GetAuthor(gomock.Any(), author.ID).Return(&author, nil)
+
+	w := httptest.NewRecorder()
+	r := lib.NewIncomingRequestBuilder(t).WithPathParam("id", author.ID.String()).Build()
+
+	authorController{svc}.GetAuthor(w, r)
+	statusCode := w.Result().StatusCode
+
+	respBody := response.SuccessResponse{}
+	respBytes, _ := io.ReadAll(w.Body)
+	json.Unmarshal(respBytes, &respBody)
+
+	result, _ := json.Marshal(respBody.Result)
+	resultDTO := v1resp.AuthorDTO{}
+	json.Unmarshal(result, &resultDTO)
+
+	assert.Equal(t, http.StatusOK, statusCode)
+	assert.True(t, respBody.Success)
+	assert.Equal(t, author.ID, resultDTO.ID)
+	assert.Equal(t, author.Name, resultDTO.Name)
+}
+
+func Test_GetAuthor_ReturnErr_WhenInvalidID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	svc := mock_application.NewMockIAuthorService(ctrl)
+
+	w := httptest.NewRecorder()
+	r := lib.NewIncomingRequestBuilder(t).WithPathParam("id", "invalid-uuid").Build()
+
+	authorController{svc}.GetAuthor(w, r)
+	statusCode := w.Result().StatusCode
+
+	respBody := response.FailureResponse{}
+	respBytes, _ := io.ReadAll(w.Body)
+	json.Unmarshal(respBytes, &respBody)
+
+	assert.Equal(t, http.StatusBadRequest, statusCode)
+	assert.False(t, respBody.Success)
+	assert.Equal(t, apperror.ErrInvalidAuthorID.Error(), respBody.Failure)
+}
+
+func Test_GetAuthor_ReturnErr_WhenAuthorNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	authorID := utils.GenerateUUID()
+	svc := mock_application.NewMockIAuthorService(ctrl)
+	svc.EXPECT().GetAuthor(gomock.Any(), authorID).Return(nil, apperror.ErrObjectNotExists)
+
+	w := httptest.NewRecorder()
+	r := lib.NewIncomingRequestBuilder(t).WithPathParam("id", authorID.String()).Build()
+
+	authorController{svc}.GetAuthor(w, r)
+	statusCode := w.Result().StatusCode
+
+	assert.Equal(t, http.StatusNotFound, statusCode)
+}
+
+func Test_ListAuthors_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	dto := v1req.ListAuthorsDTO{Name: "chan", Limit: 5, Page: 1}
+
+	mockResult := []*model.Author{&factory.SampleAuthorChandra}
+	svc := mock_application.NewMockIAuthorService(ctrl)
+	svc.EXPECT().ListAuthors(gomock.Any(), dto).Return(mockResult, int64(1), nil)
+
+	w := httptest.NewRecorder()
+	r := lib.NewIncomingRequestBuilder(t).WithQueryString("name=chan&limit=5&page=1").Build()
+
+	authorController{svc}.ListAuthors(w, r)
+	statusCode := w.Result().StatusCode
+
+	respBody := response.SuccessResponse{}
+	respBytes, _ := io.ReadAll(w.Body)
+	json.Unmarshal(respBytes, &respBody)
+
+	result, _ := json.Marshal(respBody.Result)
+	resultDTO := v1resp.ListAuthorsDTO{}
+	json.Unmarshal(result, &resultDTO)
+
+	assert.Equal(t, http.StatusOK, statusCode)
+	assert.True(t, respBody.Success)
+	assert.Equal(t, int64(1), resultDTO.RecordsCount)
+	assert.Equal(t, 1, len(resultDTO.Authors))
+	assert.Equal(t, mockResult[0].ID, resultDTO.Authors[0].ID)
+}
+
+func Test_UpdateAuthor_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	author := factory.SampleAuthorChandra
+	dto := v1req.UpdateAuthorDTO{Name: &author.Name}
+	svc := mock_application.NewMockIAuthorService(ctrl)
+	svc.EXPECT().UpdateAuthor(gomock.Any(), author.ID, dto).Return(&author, nil)
+
+	w := httptest.NewRecorder()
+	r := lib.NewIncomingRequestBuilder(t).
+		WithPathParam("id", author.ID.String()).
+		WithJSONMarshal(dto).
+		Build()
+
+	authorController{svc}.UpdateAuthor(w, r)
+	statusCode := w.Result().StatusCode
+
+	assert.Equal(t, http.StatusOK, statusCode)
+}
+
+func Test_UpdateAuthor_ReturnErr_WhenAuthorNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	authorID := utils.GenerateUUID()
+	name := "New Name"
+	dto := v1req.UpdateAuthorDTO{Name: &name}
+	svc := mock_application.NewMockIAuthorService(ctrl)
+	svc.EXPECT().UpdateAuthor(gomock.Any(), authorID, dto).Return(nil, apperror.ErrObjectNotExists)
+
+	w := httptest.NewRecorder()
+	r := lib.NewIncomingRequestBuilder(t).
+		WithPathParam("id", authorID.String()).
+		WithJSONMarshal(dto).
+		Build()
+
+	authorController{svc}.UpdateAuthor(w, r)
+	statusCode := w.Result().StatusCode
+
+	assert.Equal(t, http.StatusNotFound, statusCode)
+}
+
+func Test_DeleteAuthor_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	authorID := utils.GenerateUUID()
+	svc := mock_application.NewMockIAuthorService(ctrl)
+	svc.EXPECT().DeleteAuthor(gomock.Any(), authorID).Return(nil)
+
+	w := httptest.NewRecorder()
+	r := lib.NewIncomingRequestBuilder(t).WithPathParam("id", authorID.String()).Build()
+
+	authorController{svc}.DeleteAuthor(w, r)
+	statusCode := w.Result().StatusCode
+
+	assert.Equal(t, http.StatusOK, statusCode)
+}
+
+func Test_DeleteAuthor_ReturnErr_WhenAuthorHasArticles(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	authorID := factory.SampleAuthorChandra.ID
+	svc := mock_application.NewMockIAuthorService(ctrl)
+	svc.EXPECT().DeleteAuthor(gomock.Any(), authorID).Return(apperror.ErrAuthorHasArticles)
+
+	w := httptest.NewRecorder()
+	r := lib.NewIncomingRequestBuilder(t).WithPathParam("id", authorID.String()).Build()
+
+	authorController{svc}.DeleteAuthor(w, r)
+	statusCode := w.Result().StatusCode
+
+	respBody := response.FailureResponse{}
+	respBytes, _ := io.ReadAll(w.Body)
+	json.Unmarshal(respBytes, &respBody)
+
+	assert.Equal(t, http.StatusConflict, statusCode)
+	assert.False(t, respBody.Success)
+	assert.Equal(t, apperror.ErrAuthorHasArticles.Error(), respBody.Failure)
+}
+
+func Test_DeleteAuthor_ReturnErr_WhenInvalidID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	svc := mock_application.NewMockIAuthorService(ctrl)
+
+	w := httptest.NewRecorder()
+	r := lib.NewIncomingRequestBuilder(t).WithPathParam("id", uuid.Nil.String()+"x").Build()
+
+	authorController{svc}.DeleteAuthor(w, r)
+	statusCode := w.Result().StatusCode
+
+	assert.Equal(t, http.StatusBadRequest, statusCode)
+}
+
+func Test_ListAuthorArticles_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	dto := v1req.ListAuthorArticlesDTO{Handle: "chandra", SortBy: "title", SortDirection: "asc", Limit: 5, Page: 1}
+
+	mockResult := []*model.Article{&factory.SampleArticle1}
+	svc := mock_application.NewMockIAuthorService(ctrl)
+	svc.EXPECT().ListAuthorArticles(gomock.Any(), dto).Return(mockResult, int64(1), nil)
+
+	w := httptest.NewRecorder()
+	r := lib.NewIncomingRequestBuilder(t).
+		WithPathParam("handle", "chandra").
+		WithQueryString("sortBy=title&sortDirection=asc&limit=5&page=1").
+		Build()
+
+	authorController{svc}.ListAuthorArticles(w, r)
+	statusCode := w.Result().StatusCode
+
+	respBody := response.SuccessResponse{}
+	respBytes, _ := io.ReadAll(w.Body)
+	json.Unmarshal(respBytes, &respBody)
+
+	result, _ := json.Marshal(respBody.Result)
+	resultDTO := v1resp.ListArticlesDTO{}
+	json.Unmarshal(result, &resultDTO)
+
+	assert.Equal(t, http.StatusOK, statusCode)
+	assert.Equal(t, int64(1), resultDTO.RecordsCount)
+	assert.Equal(t, mockResult[0].ID, resultDTO.Articles[0].ID)
+	assert.Equal(t, "chandra", resultDTO.Articles[0].Author.Handle)
+}
+
+func Test_ListAuthorArticles_ReturnErr_WhenAuthorNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	svc := mock_application.NewMockIAuthorService(ctrl)
+	svc.EXPECT().ListAuthorArticles(gomock.Any(), v1req.ListAuthorArticlesDTO{Handle: "unknown"}).
+		Return(nil, int64(0), apperror.ErrObjectNotExists)
+
+	w := httptest.NewRecorder()
+	r := lib.NewIncomingRequestBuilder(t).
+		WithPathParam("handle", "unknown").
+		WithQueryString("").
+		Build()
+
+	authorController{svc}.ListAuthorArticles(w, r)
+	statusCode := w.Result().StatusCode
+
+	assert.Equal(t, http.StatusNotFound, statusCode)
+}
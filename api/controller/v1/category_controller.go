@@ -0,0 +1,160 @@
+package v1
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"article-service/api/controller"
+	"article-service/apperror"
+	"article-service/application"
+	v1req "article-service/dto/request/v1_req"
+	v1resp "article-service/dto/response/v1_resp"
+	"article-service/infrastructure/log"
+
+	"github.com/go-chi/chi"
+	"github.com/google/uuid"
+)
+
+type categoryController struct {
+	svc application.ICategoryService
+}
+
+func InitCategoryController() *categoryController {
+	return &categoryController{
+		svc: application.GetCategoryService(),
+	}
+}
+
+func (c categoryController) CreateCategory(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	reqBody, _ := io.ReadAll(r.Body)
+	dto := v1req.CreateCategoryDTO{}
+	if err := json.Unmarshal(reqBody, &dto); err != nil {
+		log.Errorf(ctx, err, "[V1][CategoryController][CreateCategory] Failed to unmarshal request body %v into dto", reqBody)
+		controller.WriteError(ctx, w, http.StatusBadRequest, apperror.ErrUnmarshalRequestBodyFailed)
+		return
+	}
+
+	err := dto.Validate(ctx)
+	if err != nil {
+		log.Errorf(ctx, err, "[V1][CategoryController][CreateCategory] Validation failed for request dto %v ", dto)
+		controller.WriteError(ctx, w, http.StatusBadRequest, err)
+		return
+	}
+
+	id, err := c.svc.CreateCategory(ctx, dto)
+	if err != nil {
+		log.Errorf(ctx, err, "[V1][CategoryController][CreateCategory] svc.CreateCategory is failed for request dto: %v ", dto)
+		controller.WriteError(ctx, w, categoryErrStatusCode(err), err)
+		return
+	}
+
+	resp := v1resp.CreateCategoryDTO{ID: id}
+	controller.WriteSuccess(ctx, w, http.StatusCreated, resp)
+}
+
+func (c categoryController) GetCategory(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		log.Errorf(ctx, err, "[V1][CategoryController][GetCategory] Invalid category id")
+		controller.WriteError(ctx, w, http.StatusBadRequest, apperror.ErrInvalidCategoryID)
+		return
+	}
+
+	category, err := c.svc.GetCategory(ctx, id)
+	if err != nil {
+		log.Errorf(ctx, err, "[V1][CategoryController][GetCategory] svc.GetCategory is failed, id: %s", id)
+		controller.WriteError(ctx, w, categoryErrStatusCode(err), err)
+		return
+	}
+
+	resp := new(v1resp.CategoryDTO).Convert(category)
+	controller.WriteSuccess(ctx, w, http.StatusOK, resp)
+}
+
+func (c categoryController) ListCategories(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	categories, err := c.svc.ListCategories(ctx)
+	if err != nil {
+		log.Errorf(ctx, err, "[V1][CategoryController][ListCategories] svc.ListCategories is failed")
+		controller.WriteError(ctx, w, http.StatusInternalServerError, err)
+		return
+	}
+
+	resp := new(v1resp.ListCategoriesDTO).Convert(categories)
+	controller.WriteSuccess(ctx, w, http.StatusOK, resp)
+}
+
+func (c categoryController) UpdateCategory(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		log.Errorf(ctx, err, "[V1][CategoryController][UpdateCategory] Invalid category id")
+		controller.WriteError(ctx, w, http.StatusBadRequest, apperror.ErrInvalidCategoryID)
+		return
+	}
+
+	reqBody, _ := io.ReadAll(r.Body)
+	dto := v1req.UpdateCategoryDTO{}
+	if err := json.Unmarshal(reqBody, &dto); err != nil {
+		log.Errorf(ctx, err, "[V1][CategoryController][UpdateCategory] Failed to unmarshal request body %v into dto", reqBody)
+		controller.WriteError(ctx, w, http.StatusBadRequest, apperror.ErrUnmarshalRequestBodyFailed)
+		return
+	}
+
+	err = dto.Validate(ctx)
+	if err != nil {
+		log.Errorf(ctx, err, "[V1][CategoryController][UpdateCategory] Validation failed for request dto %v ", dto)
+		controller.WriteError(ctx, w, http.StatusBadRequest, err)
+		return
+	}
+
+	category, err := c.svc.UpdateCategory(ctx, id, dto)
+	if err != nil {
+		log.Errorf(ctx, err, "[V1][CategoryController][UpdateCategory] svc.UpdateCategory is failed for request dto: %v ", dto)
+		controller.WriteError(ctx, w, categoryErrStatusCode(err), err)
+		return
+	}
+
+	resp := new(v1resp.CategoryDTO).Convert(category)
+	controller.WriteSuccess(ctx, w, http.StatusOK, resp)
+}
+
+func (c categoryController) DeleteCategory(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		log.Errorf(ctx, err, "[V1][CategoryController][DeleteCategory] Invalid category id")
+		controller.WriteError(ctx, w, http.StatusBadRequest, apperror.ErrInvalidCategoryID)
+		return
+	}
+
+	err = c.svc.DeleteCategory(ctx, id)
+	if err != nil {
+		log.Errorf(ctx, err, "[V1][CategoryController][DeleteCategory] svc.DeleteCategory is failed, id: %s", id)
+		controller.WriteError(ctx, w, categoryErrStatusCode(err), err)
+		return
+	}
+
+	controller.WriteSuccess(ctx, w, http.StatusOK, nil)
+}
+
+func categoryErrStatusCode(err error) int {
+	switch err {
+	case apperror.ErrObjectNotExists:
+		return http.StatusNotFound
+	case apperror.ErrCategoryParentNotFound:
+		return http.StatusUnprocessableEntity
+	case apperror.ErrCategoryNameTaken, apperror.ErrCategoryInUse, apperror.ErrCategoryCycle:
+		return http.StatusConflict
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,235 @@
+package v1
+
+import (
+	"article-service/apperror"
+	"article-service/application"
+	"article-service/application/mock_application"
+	v1req "article-service/dto/request/v1_req"
+	"article-service/dto/response"
+	v1resp "article-service/dto/response/v1_resp"
+	"article-service/factory"
+	"article-service/lib"
+	"article-service/model"
+	"article-service/utils"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_InitCategoryController(t *testing.T) {
+	application.InitCategoryService()
+
+	categoryController := InitCategoryController()
+	assert.NotNil(t, categoryController.svc)
+}
+
+func Test_CreateCategory_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	dto := v1req.CreateCategoryDTO{Name: "Local", ParentId: factory.SampleCategoryNews.ID.String()}
+
+	categoryID := utils.GenerateUUID()
+	svc := mock_application.NewMockICategoryService(ctrl)
+	svc.EXPECT().CreateCategory(gomock.Any(), dto).Return(categoryID, nil)
+
+	w := httptest.NewRecorder()
+	r := lib.NewIncomingRequestBuilder(t).WithJSONMarshal(dto).Build()
+
+	categoryController{svc}.CreateCategory(w, r)
+	statusCode := w.Result().StatusCode
+
+	respBody := response.SuccessResponse{}
+	respBytes, _ := io.ReadAll(w.Body)
+	json.Unmarshal(respBytes, &respBody)
+
+	result, _ := json.Marshal(respBody.Result)
+	resultDTO := v1resp.CreateCategoryDTO{}
+	json.Unmarshal(result, &resultDTO)
+
+	assert.Equal(t, http.StatusCreated, statusCode)
+	assert.True(t, respBody.Success)
+	assert.Equal(t, categoryID, resultDTO.ID)
+}
+
+func Test_CreateCategory_ReturnErr_WhenInvalidDTO(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	dto := v1req.CreateCategoryDTO{ParentId: "not-a-uuid"}
+
+	svc := mock_application.NewMockICategoryService(ctrl)
+
+	w := httptest.NewRecorder()
+	r := lib.NewIncomingRequestBuilder(t).WithJSONMarshal(dto).Build()
+
+	categoryController{svc}.CreateCategory(w, r)
+	statusCode := w.Result().StatusCode
+
+	respBody := response.FailureResponse{}
+	respBytes, _ := io.ReadAll(w.Body)
+	json.Unmarshal(respBytes, &respBody)
+
+	assert.Equal(t, http.StatusBadRequest, statusCode)
+	assert.Equal(t, "name is required, parentId is invalid", respBody.Failure)
+}
+
+func Test_CreateCategory_ReturnErr_WhenNameTaken(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	dto := v1req.CreateCategoryDTO{Name: "News"}
+
+	svc := mock_application.NewMockICategoryService(ctrl)
+	svc.EXPECT().CreateCategory(gomock.Any(), dto).Return(utils.GenerateUUID(), apperror.ErrCategoryNameTaken)
+
+	w := httptest.NewRecorder()
+	r := lib.NewIncomingRequestBuilder(t).WithJSONMarshal(dto).Build()
+
+	categoryController{svc}.CreateCategory(w, r)
+	statusCode := w.Result().StatusCode
+
+	assert.Equal(t, http.StatusConflict, statusCode)
+}
+
+func Test_GetCategory_ReturnErr_WhenNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	category := factory.SampleCategoryNews
+	svc := mock_application.NewMockICategoryService(ctrl)
+	svc.EXPECT().GetCategory(gomock.Any(), category.ID).Return(nil, apperror.ErrObjectNotExists)
+
+	w := httptest.NewRecorder()
+	r := lib.NewIncomingRequestBuilder(t).
+		WithPathParam("id", category.ID.String()).
+		Build()
+
+	categoryController{svc}.GetCategory(w, r)
+	statusCode := w.Result().StatusCode
+
+	assert.Equal(t, http.StatusNotFound, statusCode)
+}
+
+func Test_ListCategories_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	news := factory.SampleCategoryNews
+	local := factory.SampleCategoryLocal
+	svc := mock_application.NewMockICategoryService(ctrl)
+	svc.EXPECT().ListCategories(gomock.Any()).Return([]*model.Category{&local, &news}, nil)
+
+	w := httptest.NewRecorder()
+	r := lib.NewIncomingRequestBuilder(t).Build()
+
+	categoryController{svc}.ListCategories(w, r)
+	statusCode := w.Result().StatusCode
+
+	respBody := response.SuccessResponse{}
+	respBytes, _ := io.ReadAll(w.Body)
+	json.Unmarshal(respBytes, &respBody)
+
+	result, _ := json.Marshal(respBody.Result)
+	resultDTO := v1resp.ListCategoriesDTO{}
+	json.Unmarshal(result, &resultDTO)
+
+	assert.Equal(t, http.StatusOK, statusCode)
+	assert.True(t, respBody.Success)
+	assert.Len(t, resultDTO.Categories, 1)
+	assert.Equal(t, news.ID, resultDTO.Categories[0].ID)
+	assert.Len(t, resultDTO.Categories[0].Children, 1)
+	assert.Equal(t, local.ID, resultDTO.Categories[0].Children[0].ID)
+}
+
+func Test_UpdateCategory_ReturnErr_WhenCycle(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	category := factory.SampleCategoryNews
+	parentID := factory.SampleCategoryLocal.ID.String()
+	dto := v1req.UpdateCategoryDTO{ParentId: &parentID}
+
+	svc := mock_application.NewMockICategoryService(ctrl)
+	svc.EXPECT().UpdateCategory(gomock.Any(), category.ID, dto).Return(nil, apperror.ErrCategoryCycle)
+
+	w := httptest.NewRecorder()
+	r := lib.NewIncomingRequestBuilder(t).
+		WithPathParam("id", category.ID.String()).
+		WithJSONMarshal(dto).
+		Build()
+
+	categoryController{svc}.UpdateCategory(w, r)
+	statusCode := w.Result().StatusCode
+
+	respBody := response.FailureResponse{}
+	respBytes, _ := io.ReadAll(w.Body)
+	json.Unmarshal(respBytes, &respBody)
+
+	assert.Equal(t, http.StatusConflict, statusCode)
+	assert.Equal(t, apperror.ErrCategoryCycle.Error(), respBody.Failure)
+}
+
+func Test_UpdateCategory_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	category := factory.SampleCategoryLocal
+	name := "Regional"
+	dto := v1req.UpdateCategoryDTO{Name: &name}
+	updated := category
+	updated.Name = name
+
+	svc := mock_application.NewMockICategoryService(ctrl)
+	svc.EXPECT().UpdateCategory(gomock.Any(), category.ID, dto).Return(&updated, nil)
+
+	w := httptest.NewRecorder()
+	r := lib.NewIncomingRequestBuilder(t).
+		WithPathParam("id", category.ID.String()).
+		WithJSONMarshal(dto).
+		Build()
+
+	categoryController{svc}.UpdateCategory(w, r)
+	statusCode := w.Result().StatusCode
+
+	respBody := response.SuccessResponse{}
+	respBytes, _ := io.ReadAll(w.Body)
+	json.Unmarshal(respBytes, &respBody)
+
+	result, _ := json.Marshal(respBody.Result)
+	resultDTO := v1resp.CategoryDTO{}
+	json.Unmarshal(result, &resultDTO)
+
+	assert.Equal(t, http.StatusOK, statusCode)
+	assert.Equal(t, name, resultDTO.Name)
+	assert.Equal(t, category.ParentID, resultDTO.ParentID)
+}
+
+func Test_DeleteCategory_ReturnErr_WhenInUse(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	category := factory.SampleCategoryNews
+	svc := mock_application.NewMockICategoryService(ctrl)
+	svc.EXPECT().DeleteCategory(gomock.Any(), category.ID).Return(apperror.ErrCategoryInUse)
+
+	w := httptest.NewRecorder()
+	r := lib.NewIncomingRequestBuilder(t).
+		WithPathParam("id", category.ID.String()).
+		Build()
+
+	categoryController{svc}.DeleteCategory(w, r)
+	statusCode := w.Result().StatusCode
+
+	assert.Equal(t, http.StatusConflict, statusCode)
+}
+
+func Test_DeleteCategory_ReturnErr_WhenInvalidID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	svc := mock_application.NewMockICategoryService(ctrl)
+
+	w := httptest.NewRecorder()
+	r := lib.NewIncomingRequestBuilder(t).
+		WithPathParam("id", "invalid").
+		Build()
+
+	categoryController{svc}.DeleteCategory(w, r)
+	statusCode := w.Result().StatusCode
+
+	assert.Equal(t, http.StatusBadRequest, statusCode)
+}
@@ -0,0 +1,34 @@
+package v1
+
+import (
+	"net/http"
+
+	"article-service/api/controller"
+	"article-service/application"
+	v1resp "article-service/dto/response/v1_resp"
+	"article-service/infrastructure/log"
+)
+
+type tagController struct {
+	svc application.ITagService
+}
+
+func InitTagController() *tagController {
+	return &tagController{
+		svc: application.GetTagService(),
+	}
+}
+
+func (c tagController) ListTags(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	tags, err := c.svc.ListTags(ctx)
+	if err != nil {
+		log.Errorf(ctx, err, "[V1][TagController][ListTags] svc.ListTags is failed")
+		controller.WriteError(ctx, w, http.StatusInternalServerError, err)
+		return
+	}
+
+	resp := new(v1resp.ListTagsDTO).Convert(tags)
+	controller.WriteSuccess(ctx, w, http.StatusOK, resp)
+}
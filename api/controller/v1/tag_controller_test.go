@@ -0,0 +1,65 @@
+package v1
+
+import (
+	"article-service/apperror"
+	"article-service/application/mock_application"
+	"article-service/dto/response"
+	v1resp "article-service/dto/response/v1_resp"
+	"article-service/lib"
+	"article-service/model"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ListTags_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	tags := []*model.Tag{{Name: "keluarga", ArticlesCount: 2}, {Name: "puisi", ArticlesCount: 1}}
+	svc := mock_application.NewMockITagService(ctrl)
+	svc.EXPECT().ListTags(gomock.Any()).Return(tags, nil)
+
+	w := httptest.NewRecorder()
+	r := lib.NewIncomingRequestBuilder(t).Build()
+
+	tagController{svc}.ListTags(w, r)
+	statusCode := w.Result().StatusCode
+
+	respBody := response.SuccessResponse{}
+	respBytes, _ := io.ReadAll(w.Body)
+	json.Unmarshal(respBytes, &respBody)
+
+	result, _ := json.Marshal(respBody.Result)
+	resultDTO := v1resp.ListTagsDTO{}
+	json.Unmarshal(result, &resultDTO)
+
+	assert.Equal(t, http.StatusOK, statusCode)
+	assert.True(t, respBody.Success)
+	assert.Equal(t, []v1resp.TagDTO{{Name: "keluarga", ArticlesCount: 2}, {Name: "puisi", ArticlesCount: 1}}, resultDTO.Tags)
+}
+
+func Test_ListTags_ReturnErr_WhenSvcFailed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	svc := mock_application.NewMockITagService(ctrl)
+	svc.EXPECT().ListTags(gomock.Any()).Return(nil, apperror.ErrGetRecordFailed)
+
+	w := httptest.NewRecorder()
+	r := lib.NewIncomingRequestBuilder(t).Build()
+
+	tagController{svc}.ListTags(w, r)
+	statusCode := w.Result().StatusCode
+
+	respBody := response.FailureResponse{}
+	respBytes, _ := io.ReadAll(w.Body)
+	json.Unmarshal(respBytes, &respBody)
+
+	assert.Equal(t, http.StatusInternalServerError, statusCode)
+	assert.False(t, respBody.Success)
+	assert.Equal(t, apperror.ErrGetRecordFailed.Error(), respBody.Failure)
+}
@@ -35,3 +35,7 @@ func writeJSON(w http.ResponseWriter, statusCode int, response interface{}) erro
 	w.WriteHeader(statusCode)
 	return json.NewEncoder(w).Encode(response)
 }
+
+func WriteMovedPermanently(w http.ResponseWriter, r *http.Request, location string) {
+	http.Redirect(w, r, location, http.StatusMovedPermanently)
+}
@@ -0,0 +1,23 @@
+package middleware
+
+import (
+	"crypto/subtle"
+	"net/http"
+
+	"article-service/api/apiconst"
+	"article-service/infrastructure/appctx"
+)
+
+// an empty configured token disables admin access
+func SetAdmin(adminToken string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			token := r.Header.Get(apiconst.AdminTokenHeader)
+			isAdmin := adminToken != "" &&
+				subtle.ConstantTimeCompare([]byte(token), []byte(adminToken)) == 1
+
+			ctx := appctx.WithIsAdmin(r.Context(), isAdmin)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
@@ -1,36 +1,102 @@
 package api
 
 import (
+	"context"
+	"errors"
 	"net/http"
+	"time"
 
 	v1 "article-service/api/controller/v1"
 	"article-service/api/middleware"
 	"article-service/configloader"
+	"article-service/infrastructure/log"
 
 	"github.com/go-chi/chi"
 	chiMiddleware "github.com/go-chi/chi/v5/middleware"
 )
 
-func newRouter() *chi.Mux {
+const shutdownTimeout = 10 * time.Second
+
+func newRouter(cfg configloader.AppConfig) *chi.Mux {
 	r := chi.NewRouter()
 	r.Use(chiMiddleware.Recoverer)
 	r.Use(middleware.SetRequestID)
+	r.Use(middleware.SetAdmin(cfg.AdminToken))
 	r.Use(middleware.LogRequest)
 
 	return r
 }
 
-func InitRoutes(cfg configloader.AppConfig) {
-	r := newRouter()
+func InitRoutes(ctx context.Context, cfg configloader.AppConfig) error {
+	r := newRouter(cfg)
 
 	r.Route("/v1", func(r chi.Router) {
 		articleController := v1.InitArticleController()
+		authorController := v1.InitAuthorController()
+		articleRevisionController := v1.InitArticleRevisionController()
+		tagController := v1.InitTagController()
+		categoryController := v1.InitCategoryController()
 
 		r.Route("/articles", func(r chi.Router) {
 			r.Get("/", articleController.ListArticles)
 			r.Post("/", articleController.CreateArticle)
+			r.Get("/suggest", articleController.SuggestArticles)
+			r.Get("/{id}", articleController.GetArticle)
+			r.Get("/by-slug/{slug}", articleController.GetArticleBySlug)
+			r.Put("/{id}", articleController.UpdateArticle)
+			r.Patch("/{id}", articleController.PatchArticle)
+			r.Delete("/{id}", articleController.DeleteArticle)
+			r.Post("/{id}/restore", articleController.RestoreArticle)
+			r.Post("/{id}/publish", articleController.PublishArticle)
+			r.Post("/{id}/archive", articleController.ArchiveArticle)
+			r.Post("/{id}/schedule", articleController.ScheduleArticle)
+			r.Get("/{id}/related", articleController.ListRelatedArticles)
+			r.Get("/{id}/revisions", articleRevisionController.ListRevisions)
+			r.Get("/{id}/revisions/diff", articleRevisionController.DiffRevisions)
+			r.Get("/{id}/revisions/{n}", articleRevisionController.GetRevision)
+			r.Post("/{id}/revisions/{n}/restore", articleRevisionController.RestoreRevision)
+		})
+
+		r.Route("/authors", func(r chi.Router) {
+			r.Get("/", authorController.ListAuthors)
+			r.Post("/", authorController.CreateAuthor)
+			r.Get("/{id}", authorController.GetAuthor)
+			r.Patch("/{id}", authorController.UpdateAuthor)
+			r.Delete("/{id}", authorController.DeleteAuthor)
+			r.Get("/{handle}/articles", authorController.ListAuthorArticles)
+		})
+
+		r.Route("/tags", func(r chi.Router) {
+			r.Get("/", tagController.ListTags)
+		})
+
+		r.Route("/categories", func(r chi.Router) {
+			r.Get("/", categoryController.ListCategories)
+			r.Post("/", categoryController.CreateCategory)
+			r.Get("/{id}", categoryController.GetCategory)
+			r.Patch("/{id}", categoryController.UpdateCategory)
+			r.Delete("/{id}", categoryController.DeleteCategory)
 		})
 	})
 
-	http.ListenAndServe(cfg.Port, r)
+	server := &http.Server{Addr: cfg.Port, Handler: r}
+
+	shutdownDone := make(chan struct{})
+	go func() {
+		defer close(shutdownDone)
+		<-ctx.Done()
+
+		shutdownCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), shutdownTimeout)
+		defer cancel()
+		if err := server.Shutdown(shutdownCtx); err != nil {
+			log.Errorf(ctx, err, "[Api] failed to shut down server gracefully")
+		}
+	}()
+
+	if err := server.ListenAndServe(); !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+
+	<-shutdownDone
+	return nil
 }
@@ -2,14 +2,22 @@ package app
 
 import (
 	"context"
+	"errors"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
 	"time"
 
 	"article-service/api"
+	"article-service/apperror"
 	"article-service/application"
 	"article-service/configloader"
 	"article-service/db/db_client"
 	"article-service/infrastructure/elasticsearch"
 	"article-service/infrastructure/log"
+	"article-service/scheduler"
+	"article-service/search"
 )
 
 type Application struct {
@@ -22,20 +30,57 @@ func NewApplication() Application {
 
 func (a Application) InitApplication(configFilePath string) {
 	time.Local = time.UTC
-	ctx := context.Background()
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
 	log.Infof(ctx, "[App] Application is starting up")
 
-	if err := configloader.LoadConfigFromFile(configFilePath); err != nil {
-		log.Errorf(ctx, err, "[App] failed to load config, path: %s", configFilePath)
-		panic(err)
+	config := a.loadConfig(ctx, configFilePath)
+
+	a.initDB(ctx, config.DbConfig)
+	a.initSearch(ctx, config, false)
+	a.initServices()
+
+	var wg sync.WaitGroup
+	a.initScheduler(ctx, &wg, config.SchedulerConfig)
+
+	if err := a.initRoutes(ctx, config.AppConfig); err != nil {
+		log.Errorf(ctx, err, "[App] failed to serve api, port: %s", config.AppConfig.Port)
+		stop()
 	}
 
-	config := configloader.GetRootConfig()
+	// wait for the background workers to finish their current work before exiting
+	wg.Wait()
+	log.Infof(ctx, "[App] Application is shut down")
+}
+
+func (a Application) RunReindex(configFilePath string, batchSize int) {
+	time.Local = time.UTC
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	log.Infof(ctx, "[App] Reindex is starting up")
+
+	config := a.loadConfig(ctx, configFilePath)
 
 	a.initDB(ctx, config.DbConfig)
-	a.initElasticSearch(ctx, config.ElasticConfig)
+	a.initSearch(ctx, config, true)
 	a.initServices()
-	a.initRoutes(config.AppConfig)
+
+	job, err := application.GetReindexService().Reindex(ctx, batchSize)
+	if err != nil {
+		log.Errorf(ctx, err, "[App] failed to reindex articles")
+		os.Exit(1)
+	}
+
+	log.Infof(ctx, "[App] Reindexed %d articles into %s", job.IndexedCount, job.IndexName)
+}
+
+func (a Application) loadConfig(ctx context.Context, configFilePath string) configloader.RootConfig {
+	if err := configloader.LoadConfigFromFile(configFilePath); err != nil {
+		log.Errorf(ctx, err, "[App] failed to load config, path: %s", configFilePath)
+		panic(err)
+	}
+
+	return configloader.GetRootConfig()
 }
 
 func (a Application) initDB(ctx context.Context, cfg configloader.DbConfig) {
@@ -43,14 +88,49 @@ func (a Application) initDB(ctx context.Context, cfg configloader.DbConfig) {
 	db_client.RunMigrations(ctx, cfg)
 }
 
-func (a Application) initElasticSearch(ctx context.Context, cfg configloader.ElasticConfig) {
+func (a Application) initSearch(ctx context.Context, config configloader.RootConfig, reindexing bool) {
+	if err := search.InitArticleSearch(ctx, config.SearchConfig, config.ElasticConfig.Highlight); err != nil {
+		log.Errorf(ctx, err, "[App] failed to set up the search backend, backend: %s", config.SearchConfig.Backend)
+		panic(err)
+	}
+
+	if search.Backend() == search.BackendElasticsearch {
+		a.initElasticSearch(ctx, config.ElasticConfig, reindexing)
+	}
+}
+
+func (a Application) initElasticSearch(ctx context.Context, cfg configloader.ElasticConfig, reindexing bool) {
 	elasticsearch.InitElasticSearch(ctx, cfg)
+	err := search.EnsureArticleIndex(ctx)
+	// an outdated index only stops the server, reindexing is what moves the alias off it
+	if reindexing && errors.Is(err, apperror.ErrElasticIndexOutdated) {
+		return
+	}
+	if err != nil {
+		log.Errorf(ctx, err, "[App] failed to set up the article index")
+		panic(err)
+	}
 }
 
 func (a Application) initServices() {
 	application.InitServices()
 }
 
-func (a Application) initRoutes(cfg configloader.AppConfig) {
-	api.InitRoutes(cfg)
+func (a Application) initScheduler(ctx context.Context, wg *sync.WaitGroup, cfg configloader.SchedulerConfig) {
+	publisher := scheduler.NewArticlePublisher(cfg)
+	relay := scheduler.NewOutboxRelay(cfg)
+
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		publisher.Run(ctx)
+	}()
+	go func() {
+		defer wg.Done()
+		relay.Run(ctx)
+	}()
+}
+
+func (a Application) initRoutes(ctx context.Context, cfg configloader.AppConfig) error {
+	return api.InitRoutes(ctx, cfg)
 }
@@ -7,18 +7,49 @@ var (
 	ErrObjectNotExists         = errors.New("object does not exist")
 	ErrCreateRecordFailed      = errors.New("create record failed")
 	ErrGetRecordFailed         = errors.New("get record failed")
+	ErrUpdateRecordFailed      = errors.New("update record failed")
+	ErrDeleteRecordFailed      = errors.New("delete record failed")
 	ErrScanRecordFailed        = errors.New("scan record failed")
 	ErrStartTransactionFailed  = errors.New("start transaction failed")
 	ErrCommitTransactionFailed = errors.New("commit transaction failed")
 	ErrNoAffectedRows          = errors.New("no affected rows")
 
 	// ElasticSearch
-	ErrIndexElasticFailed  = errors.New("index to elastic failed")
-	ErrSearchElasticFailed = errors.New("search on elastic failed")
+	ErrIndexElasticFailed   = errors.New("index to elastic failed")
+	ErrDeleteElasticFailed  = errors.New("delete from elastic failed")
+	ErrSearchElasticFailed  = errors.New("search on elastic failed")
+	ErrSetupElasticFailed   = errors.New("setup of elastic index failed")
+	ErrElasticIndexOutdated = errors.New("elastic index is outdated, run reindex")
+
+	// Search
+	ErrUnknownSearchBackend = errors.New("unknown search backend")
 
 	// Controller
 	ErrUnmarshalRequestBodyFailed = errors.New("unmarshal request body failed")
+	ErrAdminOnly                  = errors.New("only admin is allowed to perform this request")
+
+	// Article
+	ErrInvalidArticleID        = errors.New("invalid article id")
+	ErrArticleNotDeleted       = errors.New("article is not deleted")
+	ErrInvalidStatusTransition = errors.New("article status transition is not allowed")
+	ErrArticleNotDraft         = errors.New("only draft articles can be scheduled")
+	ErrInvalidRevision         = errors.New("invalid revision number")
+	ErrArticleSlugTaken        = errors.New("article slug is already taken")
 
 	// Author
-	ErrAuthorNotFound = errors.New("author not found")
+	ErrAuthorNotFound    = errors.New("author not found")
+	ErrInvalidAuthorID   = errors.New("invalid author id")
+	ErrAuthorHasArticles = errors.New("author still has articles")
+	ErrAuthorHandleTaken = errors.New("author handle is already taken")
+
+	// Category
+	ErrCategoryNotFound       = errors.New("category not found")
+	ErrInvalidCategoryID      = errors.New("invalid category id")
+	ErrCategoryNameTaken      = errors.New("category name is already taken by a sibling")
+	ErrCategoryInUse          = errors.New("category still has subcategories or articles")
+	ErrCategoryParentNotFound = errors.New("parent category not found")
+	ErrCategoryCycle          = errors.New("category cannot be moved under itself or its descendants")
+
+	// Outbox
+	ErrUnknownOutboxEvent = errors.New("unknown outbox event type")
 )
@@ -22,6 +22,10 @@ func translateError(err validator.FieldError) string {
 		result = name + " is required"
 	case "oneof":
 		result = name + " should be one of " + err.Param()
+	case "gtefield":
+		param := []rune(err.Param())
+		param[0] = unicode.ToLower(param[0])
+		result = name + " should not be before " + string(param)
 	default:
 		result = name + " is invalid"
 	}
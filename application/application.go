@@ -2,4 +2,10 @@ package application
 
 func InitServices() {
 	InitArticleService()
+	InitAuthorService()
+	InitArticleRevisionService()
+	InitTagService()
+	InitCategoryService()
+	InitReindexService()
+	InitOutboxService()
 }
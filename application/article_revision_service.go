@@ -0,0 +1,118 @@
+package application
+
+import (
+	"context"
+
+	"article-service/db/repository"
+	v1req "article-service/dto/request/v1_req"
+	"article-service/infrastructure/log"
+	"article-service/model"
+	"article-service/utils"
+
+	"github.com/google/uuid"
+)
+
+//go:generate mockgen -source=article_revision_service.go -destination=./mock_application/article_revision_service_mock.go
+type IArticleRevisionService interface {
+	ListRevisions(ctx context.Context, articleID uuid.UUID) ([]*model.ArticleRevision, error)
+	GetRevision(ctx context.Context, articleID uuid.UUID, revision int) (*model.ArticleRevision, error)
+	DiffRevisions(ctx context.Context, articleID uuid.UUID, from, to int) (*model.ArticleRevisionDiff, error)
+	RestoreRevision(ctx context.Context, articleID uuid.UUID, revision int) (*model.Article, error)
+}
+
+type ArticleRevisionSvc struct {
+	articleRevisionRepo repository.IArticleRevisionRepository
+	articleSvc          IArticleService
+}
+
+var articleRevisionSvcSingleton IArticleRevisionService
+
+// InitArticleRevisionService must be called after InitArticleService
+func InitArticleRevisionService() {
+	articleRevisionSvcSingleton = ArticleRevisionSvc{
+		repository.GetArticleRevisionRepository(),
+		GetArticleService(),
+	}
+}
+
+func GetArticleRevisionService() IArticleRevisionService {
+	return articleRevisionSvcSingleton
+}
+
+func (svc ArticleRevisionSvc) ListRevisions(ctx context.Context, articleID uuid.UUID) ([]*model.ArticleRevision, error) {
+	_, err := svc.articleSvc.GetArticle(ctx, articleID)
+	if err != nil {
+		log.Errorf(ctx, err, "[ArticleRevisionSvc][ListRevisions] articleSvc.GetArticle is failed, articleId: %s", articleID)
+		return nil, err
+	}
+
+	revisions, err := svc.articleRevisionRepo.List(ctx, articleID)
+	if err != nil {
+		log.Errorf(ctx, err, "[ArticleRevisionSvc][ListRevisions] articleRevisionRepo.List is failed, articleId: %s", articleID)
+		return nil, err
+	}
+
+	return revisions, nil
+}
+
+func (svc ArticleRevisionSvc) GetRevision(ctx context.Context, articleID uuid.UUID, revision int) (*model.ArticleRevision, error) {
+	_, err := svc.articleSvc.GetArticle(ctx, articleID)
+	if err != nil {
+		log.Errorf(ctx, err, "[ArticleRevisionSvc][GetRevision] articleSvc.GetArticle is failed, articleId: %s", articleID)
+		return nil, err
+	}
+
+	articleRevision, err := svc.articleRevisionRepo.Get(ctx, articleID, revision)
+	if err != nil {
+		log.Errorf(ctx, err, "[ArticleRevisionSvc][GetRevision] articleRevisionRepo.Get is failed, articleId: %s, revision: %d", articleID, revision)
+		return nil, err
+	}
+
+	return articleRevision, nil
+}
+
+func (svc ArticleRevisionSvc) DiffRevisions(ctx context.Context, articleID uuid.UUID, from, to int) (*model.ArticleRevisionDiff, error) {
+	fromRevision, err := svc.GetRevision(ctx, articleID, from)
+	if err != nil {
+		log.Errorf(ctx, err, "[ArticleRevisionSvc][DiffRevisions] GetRevision is failed, articleId: %s, revision: %d", articleID, from)
+		return nil, err
+	}
+
+	toRevision, err := svc.articleRevisionRepo.Get(ctx, articleID, to)
+	if err != nil {
+		log.Errorf(ctx, err, "[ArticleRevisionSvc][DiffRevisions] articleRevisionRepo.Get is failed, articleId: %s, revision: %d", articleID, to)
+		return nil, err
+	}
+
+	diff := model.ArticleRevisionDiff{
+		From:  from,
+		To:    to,
+		Title: utils.DiffLines(fromRevision.Title, toRevision.Title),
+		Body:  utils.DiffLines(fromRevision.Body, toRevision.Body),
+	}
+
+	return &diff, nil
+}
+
+func (svc ArticleRevisionSvc) RestoreRevision(ctx context.Context, articleID uuid.UUID, revision int) (*model.Article, error) {
+	articleRevision, err := svc.GetRevision(ctx, articleID, revision)
+	if err != nil {
+		log.Errorf(ctx, err, "[ArticleRevisionSvc][RestoreRevision] GetRevision is failed, articleId: %s, revision: %d", articleID, revision)
+		return nil, err
+	}
+
+	// a revision only holds the content, the tags and category of the article are kept
+	authorID := articleRevision.AuthorID.String()
+	dto := v1req.PatchArticleDTO{
+		Title:    &articleRevision.Title,
+		Body:     &articleRevision.Body,
+		AuthorId: &authorID,
+	}
+	article, err := svc.articleSvc.PatchArticle(ctx, articleID, dto)
+	if err != nil {
+		log.Errorf(ctx, err, "[ArticleRevisionSvc][RestoreRevision] articleSvc.PatchArticle is failed, articleId: %s, revision: %d", articleID, revision)
+		return nil, err
+	}
+
+	return article, nil
+}
@@ -0,0 +1,122 @@
+package application
+
+import (
+	"article-service/apperror"
+	"article-service/application/mock_application"
+	"article-service/db/repository/mock_repository"
+	v1req "article-service/dto/request/v1_req"
+	"article-service/factory"
+	"article-service/model"
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_GetArticleRevisionService(t *testing.T) {
+	svc := GetArticleRevisionService()
+	assert.Nil(t, svc)
+
+	InitArticleRevisionService()
+
+	svc = GetArticleRevisionService()
+	assert.NotNil(t, svc)
+}
+
+func Test_ListRevisions_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	article := factory.SampleArticle1
+	mockRevisions := []*model.ArticleRevision{&factory.SampleArticle1Revision1}
+
+	articleSvc := mock_application.NewMockIArticleService(ctrl)
+	articleSvc.EXPECT().GetArticle(gomock.Any(), article.ID).Return(&article, nil)
+	articleRevisionRepo := mock_repository.NewMockIArticleRevisionRepository(ctrl)
+	articleRevisionRepo.EXPECT().List(gomock.Any(), article.ID).Return(mockRevisions, nil)
+
+	svc := ArticleRevisionSvc{articleRevisionRepo: articleRevisionRepo, articleSvc: articleSvc}
+	revisions, err := svc.ListRevisions(context.Background(), article.ID)
+	assert.Nil(t, err)
+	assert.Equal(t, mockRevisions, revisions)
+}
+
+func Test_ListRevisions_ReturnErr_WhenArticleNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	article := factory.SampleArticle1
+
+	articleSvc := mock_application.NewMockIArticleService(ctrl)
+	articleSvc.EXPECT().GetArticle(gomock.Any(), article.ID).Return(nil, apperror.ErrObjectNotExists)
+	articleRevisionRepo := mock_repository.NewMockIArticleRevisionRepository(ctrl)
+
+	svc := ArticleRevisionSvc{articleRevisionRepo: articleRevisionRepo, articleSvc: articleSvc}
+	revisions, err := svc.ListRevisions(context.Background(), article.ID)
+	assert.Nil(t, revisions)
+	assert.Equal(t, apperror.ErrObjectNotExists, err)
+}
+
+func Test_GetRevision_ReturnErr_WhenRevisionNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	article := factory.SampleArticle1
+
+	articleSvc := mock_application.NewMockIArticleService(ctrl)
+	articleSvc.EXPECT().GetArticle(gomock.Any(), article.ID).Return(&article, nil)
+	articleRevisionRepo := mock_repository.NewMockIArticleRevisionRepository(ctrl)
+	articleRevisionRepo.EXPECT().Get(gomock.Any(), article.ID, 9).Return(nil, apperror.ErrObjectNotExists)
+
+	svc := ArticleRevisionSvc{articleRevisionRepo: articleRevisionRepo, articleSvc: articleSvc}
+	revision, err := svc.GetRevision(context.Background(), article.ID, 9)
+	assert.Nil(t, revision)
+	assert.Equal(t, apperror.ErrObjectNotExists, err)
+}
+
+func Test_DiffRevisions_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	article := factory.SampleArticle1
+	revision1 := factory.SampleArticle1Revision1
+	revision2 := factory.SampleArticle1Revision1
+	revision2.Revision = 2
+	revision2.Body = revision1.Body + "\nTiga tiga sayang adik kakak"
+
+	articleSvc := mock_application.NewMockIArticleService(ctrl)
+	articleSvc.EXPECT().GetArticle(gomock.Any(), article.ID).Return(&article, nil)
+	articleRevisionRepo := mock_repository.NewMockIArticleRevisionRepository(ctrl)
+	articleRevisionRepo.EXPECT().Get(gomock.Any(), article.ID, 1).Return(&revision1, nil)
+	articleRevisionRepo.EXPECT().Get(gomock.Any(), article.ID, 2).Return(&revision2, nil)
+
+	svc := ArticleRevisionSvc{articleRevisionRepo: articleRevisionRepo, articleSvc: articleSvc}
+	diff, err := svc.DiffRevisions(context.Background(), article.ID, 1, 2)
+	assert.Nil(t, err)
+	assert.Equal(t, []model.DiffLine{{Op: model.DiffOpEqual, Text: revision1.Title}}, diff.Title)
+	assert.Equal(t, []model.DiffLine{
+		{Op: model.DiffOpEqual, Text: revision1.Body},
+		{Op: model.DiffOpInsert, Text: "Tiga tiga sayang adik kakak"},
+	}, diff.Body)
+}
+
+func Test_RestoreRevision_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	article := factory.SampleArticle1
+	revision := factory.SampleArticle1Revision1
+	authorID := revision.AuthorID.String()
+	expectedDTO := v1req.PatchArticleDTO{
+		Title:    &revision.Title,
+		Body:     &revision.Body,
+		AuthorId: &authorID,
+	}
+
+	articleSvc := mock_application.NewMockIArticleService(ctrl)
+	articleSvc.EXPECT().GetArticle(gomock.Any(), article.ID).Return(&article, nil)
+	articleSvc.EXPECT().PatchArticle(gomock.Any(), article.ID, expectedDTO).Return(&article, nil)
+	articleRevisionRepo := mock_repository.NewMockIArticleRevisionRepository(ctrl)
+	articleRevisionRepo.EXPECT().Get(gomock.Any(), article.ID, 1).Return(&revision, nil)
+
+	svc := ArticleRevisionSvc{articleRevisionRepo: articleRevisionRepo, articleSvc: articleSvc}
+	result, err := svc.RestoreRevision(context.Background(), article.ID, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, &article, result)
+}
@@ -2,6 +2,7 @@ package application
 
 import (
 	"context"
+	"time"
 
 	"article-service/apperror"
 	"article-service/db/db_client"
@@ -18,13 +19,42 @@ import (
 //go:generate mockgen -source=article_service.go -destination=./mock_application/article_service_mock.go
 type IArticleService interface {
 	CreateArticle(ctx context.Context, dto v1req.CreateArticleDTO) (uuid.UUID, error)
+	GetArticle(ctx context.Context, id uuid.UUID) (*model.Article, error)
+	GetArticleBySlug(ctx context.Context, slug string) (*model.Article, error)
+	UpdateArticle(ctx context.Context, id uuid.UUID, dto v1req.UpdateArticleDTO) (*model.Article, error)
+	PatchArticle(ctx context.Context, id uuid.UUID, dto v1req.PatchArticleDTO) (*model.Article, error)
+	DeleteArticle(ctx context.Context, id uuid.UUID) error
+	RestoreArticle(ctx context.Context, id uuid.UUID) (*model.Article, error)
+	PublishArticle(ctx context.Context, id uuid.UUID) (*model.Article, error)
+	ArchiveArticle(ctx context.Context, id uuid.UUID) (*model.Article, error)
+	ScheduleArticle(ctx context.Context, id uuid.UUID, dto v1req.ScheduleArticleDTO) (*model.Article, error)
+	PublishDueArticles(ctx context.Context, limit int) (int, error)
 	ListArticles(ctx context.Context, dto v1req.ListArticlesDTO) ([]*model.Article, int64, error)
+	ListArticleFacets(ctx context.Context, dto v1req.ListArticlesDTO) (model.ArticleFacets, error)
+	SuggestArticles(ctx context.Context, dto v1req.SuggestArticlesDTO) (model.ArticleSuggestions, error)
+	CorrectSearchQuery(ctx context.Context, dto v1req.ListArticlesDTO) (string, error)
+	ListRelatedArticles(ctx context.Context, id uuid.UUID, dto v1req.ListRelatedArticlesDTO) ([]*model.Article, error)
 }
 
+const sortByRelevance = "relevance"
+
+const defaultSuggestLimit = 5
+
+const defaultRelatedLimit = 5
+
+const suggestTimeout = 50 * time.Millisecond
+
+const defaultArticleSlug = "article"
+
 type ArticleSvc struct {
-	articleRepo   repository.IArticleRepository
-	authorRepo    repository.IAuthorRepository
-	articleSearch search.IArticleSearch
+	articleRepo         repository.IArticleRepository
+	articleSlugRepo     repository.IArticleSlugRepository
+	authorRepo          repository.IAuthorRepository
+	categoryRepo        repository.ICategoryRepository
+	articleRevisionRepo repository.IArticleRevisionRepository
+	tagRepo             repository.ITagRepository
+	outboxEventRepo     repository.IOutboxEventRepository
+	articleSearch       search.IArticleSearch
 }
 
 var articleSvcSingleton IArticleService
@@ -32,7 +62,12 @@ var articleSvcSingleton IArticleService
 func InitArticleService() {
 	articleSvcSingleton = ArticleSvc{
 		repository.GetArticleRepository(),
+		repository.GetArticleSlugRepository(),
 		repository.GetAuthorRepository(),
+		repository.GetCategoryRepository(),
+		repository.GetArticleRevisionRepository(),
+		repository.GetTagRepository(),
+		repository.GetOutboxEventRepository(),
 		search.GetArticleSearch(),
 	}
 }
@@ -64,7 +99,23 @@ func (svc ArticleSvc) CreateArticle(ctx context.Context, dto v1req.CreateArticle
 		ID:     utils.GenerateUUID(),
 		Title:  dto.Title,
 		Body:   dto.Body,
+		Status: model.ArticleStatusDraft,
 		Author: *author,
+		Tags:   model.NormalizeTags(dto.Tags),
+	}
+
+	if dto.CategoryId != "" {
+		article.Category, err = svc.getCategory(ctx, dto.CategoryId)
+		if err != nil {
+			log.Errorf(ctx, err, "[ArticleSvc][CreateArticle] getCategory is failed, id: %s", dto.CategoryId)
+			return uuid.Nil, err
+		}
+	}
+
+	err = svc.assignSlug(ctx, &article)
+	if err != nil {
+		log.Errorf(ctx, err, "[ArticleSvc][CreateArticle] assignSlug is failed, article: %v", article)
+		return uuid.Nil, err
 	}
 
 	err = svc.articleRepo.Create(ctx, &article)
@@ -73,9 +124,23 @@ func (svc ArticleSvc) CreateArticle(ctx context.Context, dto v1req.CreateArticle
 		return uuid.Nil, err
 	}
 
-	err = svc.articleSearch.Index(ctx, article)
+	err = svc.articleSlugRepo.Create(ctx, article.ID, article.Slug)
 	if err != nil {
-		log.Errorf(ctx, err, "[ArticleSvc][CreateArticle] articleSearch.Index is failed, article: %v", article)
+		log.Errorf(ctx, err, "[ArticleSvc][CreateArticle] articleSlugRepo.Create is failed, article: %v", article)
+		return uuid.Nil, err
+	}
+
+	if len(article.Tags) > 0 {
+		err = svc.tagRepo.SetArticleTags(ctx, article.ID, article.Tags)
+		if err != nil {
+			log.Errorf(ctx, err, "[ArticleSvc][CreateArticle] tagRepo.SetArticleTags is failed, article: %v", article)
+			return uuid.Nil, err
+		}
+	}
+
+	err = svc.createRevision(ctx, article)
+	if err != nil {
+		log.Errorf(ctx, err, "[ArticleSvc][CreateArticle] createRevision is failed, article: %v", article)
 		return uuid.Nil, err
 	}
 
@@ -87,23 +152,465 @@ func (svc ArticleSvc) CreateArticle(ctx context.Context, dto v1req.CreateArticle
 	return article.ID, nil
 }
 
+func (svc ArticleSvc) GetArticle(ctx context.Context, id uuid.UUID) (*model.Article, error) {
+	article, err := svc.articleRepo.Get(ctx, id)
+	if err != nil {
+		log.Errorf(ctx, err, "[ArticleSvc][GetArticle] articleRepo.Get is failed, id: %s", id)
+		return nil, err
+	}
+
+	if article.DeletedAt != nil {
+		log.Errorf(ctx, apperror.ErrObjectNotExists, "[ArticleSvc][GetArticle] article is deleted, id: %s", id)
+		return nil, apperror.ErrObjectNotExists
+	}
+
+	return article, nil
+}
+
+// GetArticleBySlug also resolves old slugs, the returned article.Slug is then the current one
+func (svc ArticleSvc) GetArticleBySlug(ctx context.Context, slug string) (*model.Article, error) {
+	id, err := svc.articleSlugRepo.GetArticleID(ctx, slug)
+	if err != nil {
+		log.Errorf(ctx, err, "[ArticleSvc][GetArticleBySlug] articleSlugRepo.GetArticleID is failed, slug: %s", slug)
+		return nil, err
+	}
+
+	return svc.GetArticle(ctx, id)
+}
+
+func (svc ArticleSvc) UpdateArticle(ctx context.Context, id uuid.UUID, dto v1req.UpdateArticleDTO) (*model.Article, error) {
+	// PUT replaces the article, only PATCH keeps the category and tags it leaves out
+	tags := dto.Tags
+	if tags == nil {
+		tags = []string{}
+	}
+	return svc.updateArticle(ctx, id, &dto.Title, &dto.Body, &dto.AuthorId, &dto.CategoryId, tags)
+}
+
+func (svc ArticleSvc) PatchArticle(ctx context.Context, id uuid.UUID, dto v1req.PatchArticleDTO) (*model.Article, error) {
+	return svc.updateArticle(ctx, id, dto.Title, dto.Body, dto.AuthorId, dto.CategoryId, dto.Tags)
+}
+
+func (svc ArticleSvc) updateArticle(ctx context.Context, id uuid.UUID, title, body, authorId, categoryId *string, tags []string) (*model.Article, error) {
+	ctx, txn, err := db_client.StartTransactionCtx(ctx)
+	if err != nil {
+		log.Errorf(ctx, err, "[ArticleSvc][UpdateArticle] failed to start transaction")
+		return nil, apperror.ErrStartTransactionFailed
+	}
+	defer txn.Rollback(ctx)
+
+	article, err := svc.articleRepo.GetForUpdate(ctx, id)
+	if err != nil {
+		log.Errorf(ctx, err, "[ArticleSvc][UpdateArticle] articleRepo.GetForUpdate is failed, id: %s", id)
+		return nil, err
+	}
+
+	if article.DeletedAt != nil {
+		log.Errorf(ctx, apperror.ErrObjectNotExists, "[ArticleSvc][UpdateArticle] article is deleted, id: %s", id)
+		return nil, apperror.ErrObjectNotExists
+	}
+
+	// only a change of words moves the article to a new slug, not punctuation or casing
+	slugChanged := false
+	if title != nil {
+		slugChanged = utils.Slugify(*title) != utils.Slugify(article.Title)
+		article.Title = *title
+	}
+	if body != nil {
+		article.Body = *body
+	}
+	if authorId != nil && *authorId != article.Author.ID.String() {
+		authorID, _ := uuid.Parse(*authorId)
+		author, err := svc.authorRepo.Get(ctx, authorID)
+		if err != nil {
+			if err == apperror.ErrObjectNotExists {
+				log.Errorf(ctx, err, "[ArticleSvc][UpdateArticle] author not found, id: %s", *authorId)
+				return nil, apperror.ErrAuthorNotFound
+			}
+			log.Errorf(ctx, err, "[ArticleSvc][UpdateArticle] authorRepo.Get is failed, id: %s", *authorId)
+			return nil, err
+		}
+		article.Author = *author
+	}
+	if categoryId != nil {
+		article.Category = nil
+		if *categoryId != "" {
+			article.Category, err = svc.getCategory(ctx, *categoryId)
+			if err != nil {
+				log.Errorf(ctx, err, "[ArticleSvc][UpdateArticle] getCategory is failed, id: %s", *categoryId)
+				return nil, err
+			}
+		}
+	}
+
+	if slugChanged {
+		err = svc.assignSlug(ctx, article)
+		if err != nil {
+			log.Errorf(ctx, err, "[ArticleSvc][UpdateArticle] assignSlug is failed, article: %v", article)
+			return nil, err
+		}
+	}
+
+	err = svc.articleRepo.Update(ctx, article)
+	if err != nil {
+		log.Errorf(ctx, err, "[ArticleSvc][UpdateArticle] articleRepo.Update is failed, article: %v", article)
+		return nil, err
+	}
+
+	if slugChanged {
+		err = svc.articleSlugRepo.Create(ctx, article.ID, article.Slug)
+		if err != nil {
+			log.Errorf(ctx, err, "[ArticleSvc][UpdateArticle] articleSlugRepo.Create is failed, article: %v", article)
+			return nil, err
+		}
+	}
+
+	if tags != nil {
+		article.Tags = model.NormalizeTags(tags)
+		err = svc.tagRepo.SetArticleTags(ctx, article.ID, article.Tags)
+		if err != nil {
+			log.Errorf(ctx, err, "[ArticleSvc][UpdateArticle] tagRepo.SetArticleTags is failed, article: %v", article)
+			return nil, err
+		}
+	}
+
+	err = svc.createRevision(ctx, *article)
+	if err != nil {
+		log.Errorf(ctx, err, "[ArticleSvc][UpdateArticle] createRevision is failed, article: %v", article)
+		return nil, err
+	}
+
+	err = svc.enqueueSearchSync(ctx, article.ID)
+	if err != nil {
+		log.Errorf(ctx, err, "[ArticleSvc][UpdateArticle] enqueueSearchSync is failed, article: %v", article)
+		return nil, err
+	}
+
+	if err = txn.Commit(ctx); err != nil {
+		log.Errorf(ctx, err, "[ArticleSvc][UpdateArticle] txn.Commit is failed!")
+		return nil, apperror.ErrCommitTransactionFailed
+	}
+
+	return article, nil
+}
+
+func (svc ArticleSvc) DeleteArticle(ctx context.Context, id uuid.UUID) error {
+	ctx, txn, err := db_client.StartTransactionCtx(ctx)
+	if err != nil {
+		log.Errorf(ctx, err, "[ArticleSvc][DeleteArticle] failed to start transaction")
+		return apperror.ErrStartTransactionFailed
+	}
+	defer txn.Rollback(ctx)
+
+	article, err := svc.articleRepo.Get(ctx, id)
+	if err != nil {
+		log.Errorf(ctx, err, "[ArticleSvc][DeleteArticle] articleRepo.Get is failed, id: %s", id)
+		return err
+	}
+
+	if article.DeletedAt != nil {
+		log.Errorf(ctx, apperror.ErrObjectNotExists, "[ArticleSvc][DeleteArticle] article is already deleted, id: %s", id)
+		return apperror.ErrObjectNotExists
+	}
+
+	err = svc.articleRepo.Delete(ctx, id)
+	if err != nil {
+		log.Errorf(ctx, err, "[ArticleSvc][DeleteArticle] articleRepo.Delete is failed, id: %s", id)
+		return err
+	}
+
+	err = svc.enqueueSearchSync(ctx, id)
+	if err != nil {
+		log.Errorf(ctx, err, "[ArticleSvc][DeleteArticle] enqueueSearchSync is failed, id: %s", id)
+		return err
+	}
+
+	if err = txn.Commit(ctx); err != nil {
+		log.Errorf(ctx, err, "[ArticleSvc][DeleteArticle] txn.Commit is failed!")
+		return apperror.ErrCommitTransactionFailed
+	}
+
+	return nil
+}
+
+func (svc ArticleSvc) RestoreArticle(ctx context.Context, id uuid.UUID) (*model.Article, error) {
+	ctx, txn, err := db_client.StartTransactionCtx(ctx)
+	if err != nil {
+		log.Errorf(ctx, err, "[ArticleSvc][RestoreArticle] failed to start transaction")
+		return nil, apperror.ErrStartTransactionFailed
+	}
+	defer txn.Rollback(ctx)
+
+	article, err := svc.articleRepo.Get(ctx, id)
+	if err != nil {
+		log.Errorf(ctx, err, "[ArticleSvc][RestoreArticle] articleRepo.Get is failed, id: %s", id)
+		return nil, err
+	}
+
+	if article.DeletedAt == nil {
+		log.Errorf(ctx, apperror.ErrArticleNotDeleted, "[ArticleSvc][RestoreArticle] article is not deleted, id: %s", id)
+		return nil, apperror.ErrArticleNotDeleted
+	}
+
+	err = svc.articleRepo.Restore(ctx, id)
+	if err != nil {
+		log.Errorf(ctx, err, "[ArticleSvc][RestoreArticle] articleRepo.Restore is failed, id: %s", id)
+		return nil, err
+	}
+	article.DeletedAt = nil
+
+	err = svc.enqueueSearchSync(ctx, article.ID)
+	if err != nil {
+		log.Errorf(ctx, err, "[ArticleSvc][RestoreArticle] enqueueSearchSync is failed, article: %v", article)
+		return nil, err
+	}
+
+	if err = txn.Commit(ctx); err != nil {
+		log.Errorf(ctx, err, "[ArticleSvc][RestoreArticle] txn.Commit is failed!")
+		return nil, apperror.ErrCommitTransactionFailed
+	}
+
+	return article, nil
+}
+
+func (svc ArticleSvc) PublishArticle(ctx context.Context, id uuid.UUID) (*model.Article, error) {
+	return svc.transitionArticle(ctx, id, model.ArticleStatusPublished)
+}
+
+func (svc ArticleSvc) ArchiveArticle(ctx context.Context, id uuid.UUID) (*model.Article, error) {
+	return svc.transitionArticle(ctx, id, model.ArticleStatusArchived)
+}
+
+func (svc ArticleSvc) transitionArticle(ctx context.Context, id uuid.UUID, status model.ArticleStatus) (*model.Article, error) {
+	ctx, txn, err := db_client.StartTransactionCtx(ctx)
+	if err != nil {
+		log.Errorf(ctx, err, "[ArticleSvc][TransitionArticle] failed to start transaction")
+		return nil, apperror.ErrStartTransactionFailed
+	}
+	defer txn.Rollback(ctx)
+
+	article, err := svc.articleRepo.Get(ctx, id)
+	if err != nil {
+		log.Errorf(ctx, err, "[ArticleSvc][TransitionArticle] articleRepo.Get is failed, id: %s", id)
+		return nil, err
+	}
+
+	if article.DeletedAt != nil {
+		log.Errorf(ctx, apperror.ErrObjectNotExists, "[ArticleSvc][TransitionArticle] article is deleted, id: %s", id)
+		return nil, apperror.ErrObjectNotExists
+	}
+
+	if !article.Status.CanTransitionTo(status) {
+		log.Errorf(ctx, apperror.ErrInvalidStatusTransition, "[ArticleSvc][TransitionArticle] cannot move article from %s to %s, id: %s", article.Status, status, id)
+		return nil, apperror.ErrInvalidStatusTransition
+	}
+
+	article.Status = status
+	article.PublishAt = nil
+	if status == model.ArticleStatusPublished && article.PublishedAt == nil {
+		now := time.Now()
+		article.PublishedAt = &now
+	}
+
+	err = svc.articleRepo.UpdateStatus(ctx, article)
+	if err != nil {
+		log.Errorf(ctx, err, "[ArticleSvc][TransitionArticle] articleRepo.UpdateStatus is failed, article: %v", article)
+		return nil, err
+	}
+
+	err = svc.enqueueSearchSync(ctx, article.ID)
+	if err != nil {
+		log.Errorf(ctx, err, "[ArticleSvc][TransitionArticle] enqueueSearchSync is failed, article: %v", article)
+		return nil, err
+	}
+
+	if err = txn.Commit(ctx); err != nil {
+		log.Errorf(ctx, err, "[ArticleSvc][TransitionArticle] txn.Commit is failed!")
+		return nil, apperror.ErrCommitTransactionFailed
+	}
+
+	return article, nil
+}
+
+func (svc ArticleSvc) ScheduleArticle(ctx context.Context, id uuid.UUID, dto v1req.ScheduleArticleDTO) (*model.Article, error) {
+	ctx, txn, err := db_client.StartTransactionCtx(ctx)
+	if err != nil {
+		log.Errorf(ctx, err, "[ArticleSvc][ScheduleArticle] failed to start transaction")
+		return nil, apperror.ErrStartTransactionFailed
+	}
+	defer txn.Rollback(ctx)
+
+	article, err := svc.articleRepo.Get(ctx, id)
+	if err != nil {
+		log.Errorf(ctx, err, "[ArticleSvc][ScheduleArticle] articleRepo.Get is failed, id: %s", id)
+		return nil, err
+	}
+
+	if article.DeletedAt != nil {
+		log.Errorf(ctx, apperror.ErrObjectNotExists, "[ArticleSvc][ScheduleArticle] article is deleted, id: %s", id)
+		return nil, apperror.ErrObjectNotExists
+	}
+
+	if article.Status != model.ArticleStatusDraft {
+		log.Errorf(ctx, apperror.ErrArticleNotDraft, "[ArticleSvc][ScheduleArticle] article is %s, id: %s", article.Status, id)
+		return nil, apperror.ErrArticleNotDraft
+	}
+
+	article.PublishAt = &dto.PublishAt
+	err = svc.articleRepo.UpdateStatus(ctx, article)
+	if err != nil {
+		log.Errorf(ctx, err, "[ArticleSvc][ScheduleArticle] articleRepo.UpdateStatus is failed, article: %v", article)
+		return nil, err
+	}
+
+	if err = txn.Commit(ctx); err != nil {
+		log.Errorf(ctx, err, "[ArticleSvc][ScheduleArticle] txn.Commit is failed!")
+		return nil, apperror.ErrCommitTransactionFailed
+	}
+
+	return article, nil
+}
+
+func (svc ArticleSvc) PublishDueArticles(ctx context.Context, limit int) (int, error) {
+	ctx, txn, err := db_client.StartTransactionCtx(ctx)
+	if err != nil {
+		log.Errorf(ctx, err, "[ArticleSvc][PublishDueArticles] failed to start transaction")
+		return 0, apperror.ErrStartTransactionFailed
+	}
+	defer txn.Rollback(ctx)
+
+	articles, err := svc.articleRepo.ListDueForPublishing(ctx, time.Now(), limit)
+	if err != nil {
+		log.Errorf(ctx, err, "[ArticleSvc][PublishDueArticles] articleRepo.ListDueForPublishing is failed")
+		return 0, err
+	}
+
+	for _, article := range articles {
+		article.Status = model.ArticleStatusPublished
+		article.PublishedAt = article.PublishAt
+		article.PublishAt = nil
+
+		err = svc.articleRepo.UpdateStatus(ctx, article)
+		if err != nil {
+			log.Errorf(ctx, err, "[ArticleSvc][PublishDueArticles] articleRepo.UpdateStatus is failed, article: %v", article)
+			return 0, err
+		}
+
+		err = svc.enqueueSearchSync(ctx, article.ID)
+		if err != nil {
+			log.Errorf(ctx, err, "[ArticleSvc][PublishDueArticles] enqueueSearchSync is failed, article: %v", article)
+			return 0, err
+		}
+	}
+
+	if err = txn.Commit(ctx); err != nil {
+		log.Errorf(ctx, err, "[ArticleSvc][PublishDueArticles] txn.Commit is failed!")
+		return 0, apperror.ErrCommitTransactionFailed
+	}
+
+	return len(articles), nil
+}
+
+// assignSlug suffixes the slug to keep it apart from every slug other articles have ever used
+func (svc ArticleSvc) assignSlug(ctx context.Context, article *model.Article) error {
+	base := utils.Slugify(article.Title)
+	if base == "" {
+		base = defaultArticleSlug
+	}
+
+	taken, err := svc.articleSlugRepo.ListTaken(ctx, base, article.ID)
+	if err != nil {
+		return err
+	}
+
+	article.Slug = utils.UniqueSlug(base, taken)
+	return nil
+}
+
+func (svc ArticleSvc) getCategory(ctx context.Context, id string) (*model.Category, error) {
+	categoryID, _ := uuid.Parse(id)
+	category, err := svc.categoryRepo.Get(ctx, categoryID)
+	if err == apperror.ErrObjectNotExists {
+		return nil, apperror.ErrCategoryNotFound
+	}
+	return category, err
+}
+
+func (svc ArticleSvc) createRevision(ctx context.Context, article model.Article) error {
+	revision := model.ArticleRevision{
+		ID:        utils.GenerateUUID(),
+		ArticleID: article.ID,
+		Title:     article.Title,
+		Body:      article.Body,
+		AuthorID:  article.Author.ID,
+	}
+
+	return svc.articleRevisionRepo.Create(ctx, &revision)
+}
+
+// enqueueSearchSync commits the outbox event together with the change, the outbox relay delivers it afterwards
+func (svc ArticleSvc) enqueueSearchSync(ctx context.Context, articleID uuid.UUID) error {
+	event := newArticleChangedEvent(articleID)
+	return svc.outboxEventRepo.Create(ctx, &event)
+}
+
 func (svc ArticleSvc) ListArticles(ctx context.Context, dto v1req.ListArticlesDTO) ([]*model.Article, int64, error) {
 	limit := utils.SetLimit(dto.Limit)
+	offset := utils.SetOffset(dto.Page, limit)
 
-	filter := repository.ArticleFilter{
-		AuthorName:    dto.AuthorName,
-		SortBy:        dto.SortBy,
-		SortDirection: dto.SortDirection,
-		Limit:         limit,
-		Offset:        utils.SetOffset(dto.Page, limit),
+	filter := listArticlesFilter(dto)
+	filter.SortBy = dto.SortBy
+	filter.SortDirection = dto.SortDirection
+	filter.Limit = limit
+	filter.Offset = offset
+
+	if dto.SortBy == sortByRelevance {
+		filter.SortBy = ""
+		filter.SortDirection = ""
 	}
+
 	if dto.Query != "" {
-		ids, err := svc.articleSearch.Search(ctx, dto.Query)
+		searchFilter, err := svc.searchFilter(ctx, filter)
+		if err != nil {
+			log.Errorf(ctx, err, "[ArticleSvc][ListArticles] searchFilter is failed, filter: %v", filter)
+			return nil, 0, err
+		}
+
+		params := search.SearchParams{
+			Query:         dto.Query,
+			Filter:        searchFilter,
+			Fuzzy:         dto.Fuzzy,
+			SortBy:        filter.SortBy,
+			SortDirection: filter.SortDirection,
+			Highlight:     true,
+			Limit:         limit,
+			Offset:        offset,
+		}
+		result, err := svc.articleSearch.Search(ctx, params)
 		if err != nil {
 			log.Errorf(ctx, err, "[ArticleSvc][ListArticles] articleSearch.Search is failed, query: %s", dto.Query)
 			return nil, 0, err
 		}
-		filter.Ids = ids
+
+		if len(result.IDs) == 0 {
+			return []*model.Article{}, result.Total, nil
+		}
+
+		// only hits unpublished or deleted since they were indexed are left to drop, and they are not counted
+		hits := repository.ArticleFilter{
+			Ids:            result.IDs,
+			Status:         filter.Status,
+			IncludeDeleted: filter.IncludeDeleted,
+			Limit:          limit,
+		}
+		articles, err := svc.articleRepo.List(ctx, hits)
+		if err != nil {
+			log.Errorf(ctx, err, "[ArticleSvc][ListArticles] articleRepo.List is failed")
+			return nil, 0, err
+		}
+
+		setHighlights(articles, result.Highlights)
+		return articles, result.Total - int64(len(result.IDs)-len(articles)), nil
 	}
 
 	articles, err := svc.articleRepo.List(ctx, filter)
@@ -120,3 +627,167 @@ func (svc ArticleSvc) ListArticles(ctx context.Context, dto v1req.ListArticlesDT
 
 	return articles, recordsCount, nil
 }
+
+func (svc ArticleSvc) ListArticleFacets(ctx context.Context, dto v1req.ListArticlesDTO) (model.ArticleFacets, error) {
+	filter := listArticlesFilter(dto)
+	if dto.Query != "" {
+		searchFilter, err := svc.searchFilter(ctx, filter)
+		if err != nil {
+			log.Errorf(ctx, err, "[ArticleSvc][ListArticleFacets] searchFilter is failed, filter: %v", filter)
+			return nil, err
+		}
+
+		params := search.SearchParams{
+			Query:  dto.Query,
+			Filter: searchFilter,
+			Fuzzy:  dto.Fuzzy,
+			Facets: dto.Facets,
+		}
+		result, err := svc.articleSearch.Search(ctx, params)
+		if err != nil {
+			log.Errorf(ctx, err, "[ArticleSvc][ListArticleFacets] articleSearch.Search is failed, query: %s", dto.Query)
+			return nil, err
+		}
+
+		return result.Facets, nil
+	}
+
+	facets, err := svc.articleRepo.CountFacets(ctx, filter, dto.Facets)
+	if err != nil {
+		log.Errorf(ctx, err, "[ArticleSvc][ListArticleFacets] articleRepo.CountFacets is failed")
+		return nil, err
+	}
+
+	return facets, nil
+}
+
+func (svc ArticleSvc) SuggestArticles(ctx context.Context, dto v1req.SuggestArticlesDTO) (model.ArticleSuggestions, error) {
+	limit := dto.Limit
+	if limit <= 0 {
+		limit = defaultSuggestLimit
+	}
+
+	suggestCtx, cancel := context.WithTimeout(ctx, suggestTimeout)
+	defer cancel()
+
+	suggestions, err := svc.articleSearch.Suggest(suggestCtx, dto.Query, limit)
+	if err != nil {
+		if suggestCtx.Err() == context.DeadlineExceeded {
+			log.Infof(ctx, "[ArticleSvc][SuggestArticles] articleSearch.Suggest timed out, query: %s", dto.Query)
+			return model.ArticleSuggestions{Titles: []model.TitleSuggestion{}, Authors: []string{}}, nil
+		}
+		log.Errorf(ctx, err, "[ArticleSvc][SuggestArticles] articleSearch.Suggest is failed, query: %s", dto.Query)
+		return model.ArticleSuggestions{}, err
+	}
+
+	return suggestions, nil
+}
+
+func (svc ArticleSvc) CorrectSearchQuery(ctx context.Context, dto v1req.ListArticlesDTO) (string, error) {
+	filter := listArticlesFilter(dto)
+	searchFilter, err := svc.searchFilter(ctx, filter)
+	if err != nil {
+		log.Errorf(ctx, err, "[ArticleSvc][CorrectSearchQuery] searchFilter is failed, filter: %v", filter)
+		return "", err
+	}
+
+	params := search.SearchParams{
+		Query:  dto.Query,
+		Filter: searchFilter,
+		Fuzzy:  dto.Fuzzy,
+	}
+	corrected, err := svc.articleSearch.CorrectQuery(ctx, params)
+	if err != nil {
+		log.Errorf(ctx, err, "[ArticleSvc][CorrectSearchQuery] articleSearch.CorrectQuery is failed, query: %s", dto.Query)
+		return "", err
+	}
+
+	return corrected, nil
+}
+
+func (svc ArticleSvc) ListRelatedArticles(ctx context.Context, id uuid.UUID, dto v1req.ListRelatedArticlesDTO) ([]*model.Article, error) {
+	article, err := svc.GetArticle(ctx, id)
+	if err != nil {
+		log.Errorf(ctx, err, "[ArticleSvc][ListRelatedArticles] GetArticle is failed, id: %s", id)
+		return nil, err
+	}
+
+	limit := dto.Limit
+	if limit <= 0 {
+		limit = defaultRelatedLimit
+	}
+
+	ids, err := svc.articleSearch.Related(ctx, *article, limit)
+	if err != nil {
+		log.Errorf(ctx, err, "[ArticleSvc][ListRelatedArticles] articleSearch.Related is failed, id: %s", id)
+		return nil, err
+	}
+	if len(ids) == 0 {
+		return []*model.Article{}, nil
+	}
+
+	filter := repository.ArticleFilter{
+		Ids:    ids,
+		Status: model.ArticleStatusPublished,
+		Limit:  len(ids),
+	}
+	articles, err := svc.articleRepo.List(ctx, filter)
+	if err != nil {
+		log.Errorf(ctx, err, "[ArticleSvc][ListRelatedArticles] articleRepo.List is failed, id: %s", id)
+		return nil, err
+	}
+
+	return articles, nil
+}
+
+func listArticlesFilter(dto v1req.ListArticlesDTO) repository.ArticleFilter {
+	categoryID, _ := uuid.Parse(dto.CategoryId)
+	createdFrom, _ := time.Parse(time.RFC3339, dto.CreatedFrom)
+	createdTo, _ := time.Parse(time.RFC3339, dto.CreatedTo)
+
+	return repository.ArticleFilter{
+		Status:           model.ArticleStatusPublished,
+		AuthorIDs:        utils.ParseUUIDs(dto.AuthorIds),
+		ExcludeAuthorIDs: utils.ParseUUIDs(dto.ExcludeAuthorIds),
+		CreatedFrom:      createdFrom,
+		CreatedTo:        createdTo,
+		AuthorName:       dto.AuthorName,
+		CategoryID:       categoryID,
+		Tags:             model.NormalizeTags(dto.Tags),
+		MatchAllTags:     dto.TagsMatch == "all",
+		IncludeDeleted:   dto.IncludeDeleted,
+	}
+}
+
+func (svc ArticleSvc) searchFilter(ctx context.Context, filter repository.ArticleFilter) (search.SearchFilter, error) {
+	searchFilter := search.SearchFilter{
+		CreatedFrom:      filter.CreatedFrom,
+		CreatedTo:        filter.CreatedTo,
+		AuthorIDs:        filter.AuthorIDs,
+		ExcludeAuthorIDs: filter.ExcludeAuthorIDs,
+		AuthorName:       filter.AuthorName,
+		Tags:             filter.Tags,
+		MatchAllTags:     filter.MatchAllTags,
+	}
+
+	if filter.CategoryID != uuid.Nil {
+		ids, err := svc.categoryRepo.ListSubtreeIDs(ctx, filter.CategoryID)
+		if err != nil {
+			return search.SearchFilter{}, err
+		}
+		// an unknown category has no subtree, its id alone keeps every article out
+		if len(ids) == 0 {
+			ids = []uuid.UUID{filter.CategoryID}
+		}
+		searchFilter.CategoryIDs = ids
+	}
+
+	return searchFilter, nil
+}
+
+func setHighlights(articles []*model.Article, highlights map[uuid.UUID]model.ArticleHighlight) {
+	for _, article := range articles {
+		highlight := highlights[article.ID]
+		article.Highlight = &highlight
+	}
+}
@@ -9,9 +9,12 @@ import (
 	"article-service/factory"
 	"article-service/infrastructure/elasticsearch"
 	"article-service/model"
+	"article-service/search"
 	"article-service/search/mock_search"
+	"article-service/utils"
 	"context"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
@@ -43,50 +46,241 @@ func Test_CreateArticle_Success(t *testing.T) {
 	articleRepo := mock_repository.NewMockIArticleRepository(ctrl)
 	authorRepo := mock_repository.NewMockIAuthorRepository(ctrl)
 	articleSearch := mock_search.NewMockIArticleSearch(ctrl)
+	articleRevisionRepo := mock_repository.NewMockIArticleRevisionRepository(ctrl)
+	articleRevisionRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
 
 	authorRepo.EXPECT().Get(gomock.Any(), author.ID).Return(&author, nil)
-	articleRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
-	articleSearch.EXPECT().Index(gomock.Any(), gomock.Any()).Return(nil)
+	articleSlugRepo := mock_repository.NewMockIArticleSlugRepository(ctrl)
+	articleSlugRepo.EXPECT().ListTaken(gomock.Any(), "new-title", gomock.Any()).Return(nil, nil)
+	articleSlugRepo.EXPECT().Create(gomock.Any(), gomock.Any(), "new-title").Return(nil)
+	articleRepo.EXPECT().Create(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, article *model.Article) error {
+			assert.Equal(t, model.ArticleStatusDraft, article.Status)
+			assert.Nil(t, article.PublishedAt)
+			return nil
+		})
 
 	svc := ArticleSvc{
-		articleRepo:   articleRepo,
-		authorRepo:    authorRepo,
-		articleSearch: articleSearch,
+		articleRepo:         articleRepo,
+		articleSlugRepo:     articleSlugRepo,
+		authorRepo:          authorRepo,
+		articleRevisionRepo: articleRevisionRepo,
+		articleSearch:       articleSearch,
 	}
 	id, err := svc.CreateArticle(context.Background(), dto)
 	assert.NotEqual(t, uuid.Nil, id)
 	assert.Nil(t, err)
 }
 
-func Test_CreateArticle_ReturnErr_WhenStartTransactionFailed(t *testing.T) {
+func Test_CreateArticle_Success_WithSuffixedSlug_WhenSlugTaken(t *testing.T) {
 	ctrl := gomock.NewController(t)
-	db_client.InitDatabaseMockExpectingTxn(ctrl, false, true)
+	db_client.InitDatabaseMockExpectingTxn(ctrl, true, true)
+
+	author := factory.SampleAuthorChandra
+	dto := v1req.CreateArticleDTO{
+		Title:    "Café Crème",
+		Body:     "New Body",
+		AuthorId: author.ID.String(),
+	}
+
+	articleRepo := mock_repository.NewMockIArticleRepository(ctrl)
+	authorRepo := mock_repository.NewMockIAuthorRepository(ctrl)
+	articleRevisionRepo := mock_repository.NewMockIArticleRevisionRepository(ctrl)
+	articleRevisionRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+	articleSlugRepo := mock_repository.NewMockIArticleSlugRepository(ctrl)
+	articleSlugRepo.EXPECT().ListTaken(gomock.Any(), "cafe-creme", gomock.Any()).Return([]string{"cafe-creme"}, nil)
+	articleSlugRepo.EXPECT().Create(gomock.Any(), gomock.Any(), "cafe-creme-2").Return(nil)
+
+	authorRepo.EXPECT().Get(gomock.Any(), author.ID).Return(&author, nil)
+	articleRepo.EXPECT().Create(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, article *model.Article) error {
+			assert.Equal(t, "cafe-creme-2", article.Slug)
+			return nil
+		})
+
+	svc := ArticleSvc{
+		articleRepo:         articleRepo,
+		articleSlugRepo:     articleSlugRepo,
+		authorRepo:          authorRepo,
+		articleRevisionRepo: articleRevisionRepo,
+	}
+	id, err := svc.CreateArticle(context.Background(), dto)
+	assert.NotEqual(t, uuid.Nil, id)
+	assert.Nil(t, err)
+}
+
+func Test_CreateArticle_ReturnErr_WhenListTakenSlugsFailed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	db_client.InitDatabaseMockExpectingTxn(ctrl, true, nil)
+
+	author := factory.SampleAuthorChandra
+	dto := v1req.CreateArticleDTO{
+		Title:    "New Title",
+		Body:     "New Body",
+		AuthorId: author.ID.String(),
+	}
+
+	authorRepo := mock_repository.NewMockIAuthorRepository(ctrl)
+	articleSlugRepo := mock_repository.NewMockIArticleSlugRepository(ctrl)
+	articleSlugRepo.EXPECT().ListTaken(gomock.Any(), "new-title", gomock.Any()).Return(nil, apperror.ErrGetRecordFailed)
+
+	authorRepo.EXPECT().Get(gomock.Any(), author.ID).Return(&author, nil)
+
+	svc := ArticleSvc{
+		articleSlugRepo: articleSlugRepo,
+		authorRepo:      authorRepo,
+	}
+	id, err := svc.CreateArticle(context.Background(), dto)
+	assert.Equal(t, uuid.Nil, id)
+	assert.Equal(t, apperror.ErrGetRecordFailed, err)
+}
+
+func Test_CreateArticle_Success_WithTags(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	db_client.InitDatabaseMockExpectingTxn(ctrl, true, true)
 
 	author := factory.SampleAuthorChandra
 	dto := v1req.CreateArticleDTO{
 		Title:    "New Title",
 		Body:     "New Body",
 		AuthorId: author.ID.String(),
+		Tags:     []string{" Puisi", "keluarga", "puisi ", ""},
 	}
 
 	articleRepo := mock_repository.NewMockIArticleRepository(ctrl)
 	authorRepo := mock_repository.NewMockIAuthorRepository(ctrl)
 	articleSearch := mock_search.NewMockIArticleSearch(ctrl)
+	articleRevisionRepo := mock_repository.NewMockIArticleRevisionRepository(ctrl)
+	articleRevisionRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+	tagRepo := mock_repository.NewMockITagRepository(ctrl)
+	tagRepo.EXPECT().SetArticleTags(gomock.Any(), gomock.Any(), []string{"puisi", "keluarga"}).Return(nil)
+
+	authorRepo.EXPECT().Get(gomock.Any(), author.ID).Return(&author, nil)
+	articleSlugRepo := mock_repository.NewMockIArticleSlugRepository(ctrl)
+	articleSlugRepo.EXPECT().ListTaken(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+	articleSlugRepo.EXPECT().Create(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+	articleRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
 
 	svc := ArticleSvc{
-		articleRepo:   articleRepo,
-		authorRepo:    authorRepo,
-		articleSearch: articleSearch,
+		articleRepo:         articleRepo,
+		articleSlugRepo:     articleSlugRepo,
+		authorRepo:          authorRepo,
+		articleRevisionRepo: articleRevisionRepo,
+		tagRepo:             tagRepo,
+		articleSearch:       articleSearch,
+	}
+	id, err := svc.CreateArticle(context.Background(), dto)
+	assert.NotEqual(t, uuid.Nil, id)
+	assert.Nil(t, err)
+}
+
+func Test_CreateArticle_ReturnErr_WhenSetArticleTagsFailed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	db_client.InitDatabaseMockExpectingTxn(ctrl, true, nil)
+
+	author := factory.SampleAuthorChandra
+	dto := v1req.CreateArticleDTO{
+		Title:    "New Title",
+		Body:     "New Body",
+		AuthorId: author.ID.String(),
+		Tags:     []string{"puisi"},
+	}
+
+	articleRepo := mock_repository.NewMockIArticleRepository(ctrl)
+	authorRepo := mock_repository.NewMockIAuthorRepository(ctrl)
+	tagRepo := mock_repository.NewMockITagRepository(ctrl)
+	tagRepo.EXPECT().SetArticleTags(gomock.Any(), gomock.Any(), []string{"puisi"}).Return(apperror.ErrCreateRecordFailed)
+
+	authorRepo.EXPECT().Get(gomock.Any(), author.ID).Return(&author, nil)
+	articleSlugRepo := mock_repository.NewMockIArticleSlugRepository(ctrl)
+	articleSlugRepo.EXPECT().ListTaken(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+	articleSlugRepo.EXPECT().Create(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+	articleRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+
+	svc := ArticleSvc{
+		articleRepo:     articleRepo,
+		articleSlugRepo: articleSlugRepo,
+		authorRepo:      authorRepo,
+		tagRepo:         tagRepo,
 	}
 	id, err := svc.CreateArticle(context.Background(), dto)
 	assert.Equal(t, uuid.Nil, id)
-	assert.Equal(t, apperror.ErrStartTransactionFailed, err)
+	assert.Equal(t, apperror.ErrCreateRecordFailed, err)
 }
 
-func Test_CreateArticle_ReturnErr_WhenAuthorNotFound(t *testing.T) {
+func Test_CreateArticle_Success_WithCategory(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	db_client.InitDatabaseMockExpectingTxn(ctrl, true, true)
+
+	author := factory.SampleAuthorChandra
+	category := factory.SampleCategoryLocal
+	dto := v1req.CreateArticleDTO{
+		Title:      "New Title",
+		Body:       "New Body",
+		AuthorId:   author.ID.String(),
+		CategoryId: category.ID.String(),
+	}
+
+	articleRepo := mock_repository.NewMockIArticleRepository(ctrl)
+	authorRepo := mock_repository.NewMockIAuthorRepository(ctrl)
+	categoryRepo := mock_repository.NewMockICategoryRepository(ctrl)
+	articleRevisionRepo := mock_repository.NewMockIArticleRevisionRepository(ctrl)
+	articleRevisionRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+
+	authorRepo.EXPECT().Get(gomock.Any(), author.ID).Return(&author, nil)
+	categoryRepo.EXPECT().Get(gomock.Any(), category.ID).Return(&category, nil)
+	articleSlugRepo := mock_repository.NewMockIArticleSlugRepository(ctrl)
+	articleSlugRepo.EXPECT().ListTaken(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+	articleSlugRepo.EXPECT().Create(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+	articleRepo.EXPECT().Create(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, article *model.Article) error {
+			assert.Equal(t, &category, article.Category)
+			return nil
+		})
+
+	svc := ArticleSvc{
+		articleRepo:         articleRepo,
+		articleSlugRepo:     articleSlugRepo,
+		authorRepo:          authorRepo,
+		categoryRepo:        categoryRepo,
+		articleRevisionRepo: articleRevisionRepo,
+	}
+	id, err := svc.CreateArticle(context.Background(), dto)
+	assert.NotEqual(t, uuid.Nil, id)
+	assert.Nil(t, err)
+}
+
+func Test_CreateArticle_ReturnErr_WhenCategoryNotFound(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	db_client.InitDatabaseMockExpectingTxn(ctrl, true, nil)
 
+	author := factory.SampleAuthorChandra
+	categoryID := utils.GenerateUUID()
+	dto := v1req.CreateArticleDTO{
+		Title:      "New Title",
+		Body:       "New Body",
+		AuthorId:   author.ID.String(),
+		CategoryId: categoryID.String(),
+	}
+
+	authorRepo := mock_repository.NewMockIAuthorRepository(ctrl)
+	categoryRepo := mock_repository.NewMockICategoryRepository(ctrl)
+	authorRepo.EXPECT().Get(gomock.Any(), author.ID).Return(&author, nil)
+	categoryRepo.EXPECT().Get(gomock.Any(), categoryID).Return(nil, apperror.ErrObjectNotExists)
+
+	svc := ArticleSvc{
+		authorRepo:   authorRepo,
+		categoryRepo: categoryRepo,
+	}
+	id, err := svc.CreateArticle(context.Background(), dto)
+	assert.Equal(t, uuid.Nil, id)
+	assert.Equal(t, apperror.ErrCategoryNotFound, err)
+}
+
+func Test_CreateArticle_ReturnErr_WhenStartTransactionFailed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	db_client.InitDatabaseMockExpectingTxn(ctrl, false, true)
+
 	author := factory.SampleAuthorChandra
 	dto := v1req.CreateArticleDTO{
 		Title:    "New Title",
@@ -98,8 +292,6 @@ func Test_CreateArticle_ReturnErr_WhenAuthorNotFound(t *testing.T) {
 	authorRepo := mock_repository.NewMockIAuthorRepository(ctrl)
 	articleSearch := mock_search.NewMockIArticleSearch(ctrl)
 
-	authorRepo.EXPECT().Get(gomock.Any(), author.ID).Return(nil, apperror.ErrObjectNotExists)
-
 	svc := ArticleSvc{
 		articleRepo:   articleRepo,
 		authorRepo:    authorRepo,
@@ -107,10 +299,10 @@ func Test_CreateArticle_ReturnErr_WhenAuthorNotFound(t *testing.T) {
 	}
 	id, err := svc.CreateArticle(context.Background(), dto)
 	assert.Equal(t, uuid.Nil, id)
-	assert.Equal(t, apperror.ErrAuthorNotFound, err)
+	assert.Equal(t, apperror.ErrStartTransactionFailed, err)
 }
 
-func Test_CreateArticle_ReturnErr_WhenGetAuthorFailed(t *testing.T) {
+func Test_CreateArticle_ReturnErr_WhenAuthorNotFound(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	db_client.InitDatabaseMockExpectingTxn(ctrl, true, nil)
 
@@ -125,7 +317,7 @@ func Test_CreateArticle_ReturnErr_WhenGetAuthorFailed(t *testing.T) {
 	authorRepo := mock_repository.NewMockIAuthorRepository(ctrl)
 	articleSearch := mock_search.NewMockIArticleSearch(ctrl)
 
-	authorRepo.EXPECT().Get(gomock.Any(), author.ID).Return(nil, apperror.ErrGetRecordFailed)
+	authorRepo.EXPECT().Get(gomock.Any(), author.ID).Return(nil, apperror.ErrObjectNotExists)
 
 	svc := ArticleSvc{
 		articleRepo:   articleRepo,
@@ -134,10 +326,10 @@ func Test_CreateArticle_ReturnErr_WhenGetAuthorFailed(t *testing.T) {
 	}
 	id, err := svc.CreateArticle(context.Background(), dto)
 	assert.Equal(t, uuid.Nil, id)
-	assert.Equal(t, apperror.ErrGetRecordFailed, err)
+	assert.Equal(t, apperror.ErrAuthorNotFound, err)
 }
 
-func Test_CreateArticle_ReturnErr_WhenCreateArticleFailed(t *testing.T) {
+func Test_CreateArticle_ReturnErr_WhenGetAuthorFailed(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	db_client.InitDatabaseMockExpectingTxn(ctrl, true, nil)
 
@@ -152,8 +344,7 @@ func Test_CreateArticle_ReturnErr_WhenCreateArticleFailed(t *testing.T) {
 	authorRepo := mock_repository.NewMockIAuthorRepository(ctrl)
 	articleSearch := mock_search.NewMockIArticleSearch(ctrl)
 
-	authorRepo.EXPECT().Get(gomock.Any(), author.ID).Return(&author, nil)
-	articleRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(apperror.ErrCreateRecordFailed)
+	authorRepo.EXPECT().Get(gomock.Any(), author.ID).Return(nil, apperror.ErrGetRecordFailed)
 
 	svc := ArticleSvc{
 		articleRepo:   articleRepo,
@@ -162,10 +353,10 @@ func Test_CreateArticle_ReturnErr_WhenCreateArticleFailed(t *testing.T) {
 	}
 	id, err := svc.CreateArticle(context.Background(), dto)
 	assert.Equal(t, uuid.Nil, id)
-	assert.Equal(t, apperror.ErrCreateRecordFailed, err)
+	assert.Equal(t, apperror.ErrGetRecordFailed, err)
 }
 
-func Test_CreateArticle_ReturnErr_WhenIndexArticleFailed(t *testing.T) {
+func Test_CreateArticle_ReturnErr_WhenCreateArticleFailed(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	db_client.InitDatabaseMockExpectingTxn(ctrl, true, nil)
 
@@ -181,17 +372,19 @@ func Test_CreateArticle_ReturnErr_WhenIndexArticleFailed(t *testing.T) {
 	articleSearch := mock_search.NewMockIArticleSearch(ctrl)
 
 	authorRepo.EXPECT().Get(gomock.Any(), author.ID).Return(&author, nil)
-	articleRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
-	articleSearch.EXPECT().Index(gomock.Any(), gomock.Any()).Return(apperror.ErrIndexElasticFailed)
+	articleSlugRepo := mock_repository.NewMockIArticleSlugRepository(ctrl)
+	articleSlugRepo.EXPECT().ListTaken(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+	articleRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(apperror.ErrCreateRecordFailed)
 
 	svc := ArticleSvc{
-		articleRepo:   articleRepo,
-		authorRepo:    authorRepo,
-		articleSearch: articleSearch,
+		articleRepo:     articleRepo,
+		articleSlugRepo: articleSlugRepo,
+		authorRepo:      authorRepo,
+		articleSearch:   articleSearch,
 	}
 	id, err := svc.CreateArticle(context.Background(), dto)
 	assert.Equal(t, uuid.Nil, id)
-	assert.Equal(t, apperror.ErrIndexElasticFailed, err)
+	assert.Equal(t, apperror.ErrCreateRecordFailed, err)
 }
 
 func Test_CreateArticle_ReturnErr_WhenCommitTransactionFailed(t *testing.T) {
@@ -208,15 +401,21 @@ func Test_CreateArticle_ReturnErr_WhenCommitTransactionFailed(t *testing.T) {
 	articleRepo := mock_repository.NewMockIArticleRepository(ctrl)
 	authorRepo := mock_repository.NewMockIAuthorRepository(ctrl)
 	articleSearch := mock_search.NewMockIArticleSearch(ctrl)
+	articleRevisionRepo := mock_repository.NewMockIArticleRevisionRepository(ctrl)
+	articleRevisionRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
 
 	authorRepo.EXPECT().Get(gomock.Any(), author.ID).Return(&author, nil)
+	articleSlugRepo := mock_repository.NewMockIArticleSlugRepository(ctrl)
+	articleSlugRepo.EXPECT().ListTaken(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+	articleSlugRepo.EXPECT().Create(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
 	articleRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
-	articleSearch.EXPECT().Index(gomock.Any(), gomock.Any()).Return(nil)
 
 	svc := ArticleSvc{
-		articleRepo:   articleRepo,
-		authorRepo:    authorRepo,
-		articleSearch: articleSearch,
+		articleRepo:         articleRepo,
+		articleSlugRepo:     articleSlugRepo,
+		authorRepo:          authorRepo,
+		articleRevisionRepo: articleRevisionRepo,
+		articleSearch:       articleSearch,
 	}
 	id, err := svc.CreateArticle(context.Background(), dto)
 	assert.Equal(t, uuid.Nil, id)
@@ -241,6 +440,7 @@ func Test_ListArticle_Success_WithEmptyQuery(t *testing.T) {
 	mockArticles := []*model.Article{&factory.SampleArticle1}
 	mockRecordsCount := int64(1)
 	expectedFilter := repository.ArticleFilter{
+		Status:        model.ArticleStatusPublished,
 		AuthorName:    dto.AuthorName,
 		SortBy:        dto.SortBy,
 		SortDirection: dto.SortDirection,
@@ -263,6 +463,37 @@ func Test_ListArticle_Success_WithEmptyQuery(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func Test_ListArticle_Success_WithAllTags(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	db_client.InitDatabaseMock()
+
+	articleRepo := mock_repository.NewMockIArticleRepository(ctrl)
+
+	dto := v1req.ListArticlesDTO{
+		Tags:      []string{"Puisi", "keluarga"},
+		TagsMatch: "all",
+	}
+	mockArticles := []*model.Article{&factory.SampleArticle1}
+	expectedFilter := repository.ArticleFilter{
+		Status:       model.ArticleStatusPublished,
+		Tags:         []string{"puisi", "keluarga"},
+		MatchAllTags: true,
+		Limit:        20,
+	}
+
+	articleRepo.EXPECT().List(gomock.Any(), expectedFilter).Return(mockArticles, nil)
+	articleRepo.EXPECT().GetRecordsCount(gomock.Any(), expectedFilter).Return(int64(1), nil)
+
+	svc := ArticleSvc{
+		articleRepo: articleRepo,
+	}
+
+	articles, recordsCount, err := svc.ListArticles(context.Background(), dto)
+	assert.Equal(t, mockArticles, articles)
+	assert.Equal(t, int64(1), recordsCount)
+	assert.Nil(t, err)
+}
+
 func Test_ListArticle_Success_WithQuery(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	db_client.InitDatabaseMock()
@@ -272,29 +503,37 @@ func Test_ListArticle_Success_WithQuery(t *testing.T) {
 	articleSearch := mock_search.NewMockIArticleSearch(ctrl)
 
 	dto := v1req.ListArticlesDTO{
-		Query:         "Article",
-		AuthorName:    "Chandra",
-		SortBy:        "created_at",
-		SortDirection: "desc",
-		Limit:         10,
-		Page:          1,
+		Query:      "Article",
+		AuthorName: "Chandra",
+		Limit:      10,
+		Page:       3,
+	}
+	article1 := factory.SampleArticle1
+	article2 := factory.SampleArticle2
+	mockArticles := []*model.Article{&article2, &article1}
+	mockResult := search.SearchResult{
+		IDs:   []uuid.UUID{article2.ID, article1.ID},
+		Total: 22,
+		Highlights: map[uuid.UUID]model.ArticleHighlight{
+			article2.ID: {Body: []string{"<em>Article</em> body"}},
+		},
 	}
-	mockArticles := []*model.Article{&factory.SampleArticle1}
-	mockRecordsCount := int64(1)
-	mockArticleIds := []uuid.UUID{factory.SampleArticle1.ID}
 
+	expectedParams := search.SearchParams{
+		Query:     dto.Query,
+		Filter:    search.SearchFilter{AuthorName: dto.AuthorName},
+		Highlight: true,
+		Limit:     10,
+		Offset:    20,
+	}
 	expectedFilter := repository.ArticleFilter{
-		Ids:           mockArticleIds,
-		AuthorName:    dto.AuthorName,
-		SortBy:        dto.SortBy,
-		SortDirection: dto.SortDirection,
-		Limit:         10,
-		Offset:        0,
+		Status: model.ArticleStatusPublished,
+		Ids:    mockResult.IDs,
+		Limit:  10,
 	}
 
-	articleSearch.EXPECT().Search(gomock.Any(), dto.Query).Return(mockArticleIds, nil)
+	articleSearch.EXPECT().Search(gomock.Any(), expectedParams).Return(mockResult, nil)
 	articleRepo.EXPECT().List(gomock.Any(), expectedFilter).Return(mockArticles, nil)
-	articleRepo.EXPECT().GetRecordsCount(gomock.Any(), expectedFilter).Return(mockRecordsCount, nil)
 
 	svc := ArticleSvc{
 		articleRepo:   articleRepo,
@@ -304,92 +543,1576 @@ func Test_ListArticle_Success_WithQuery(t *testing.T) {
 
 	articles, recordsCount, err := svc.ListArticles(context.Background(), dto)
 	assert.Equal(t, mockArticles, articles)
-	assert.Equal(t, mockRecordsCount, recordsCount)
+	assert.Equal(t, mockResult.Total, recordsCount)
+	assert.Equal(t, &model.ArticleHighlight{Body: []string{"<em>Article</em> body"}}, articles[0].Highlight)
+	assert.Equal(t, &model.ArticleHighlight{}, articles[1].Highlight)
 	assert.Nil(t, err)
 }
 
-func Test_ListArticle_ReturnErr_WhenSearchFailed(t *testing.T) {
+func Test_ListArticle_Success_WithQuerySortedByRelevance(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	db_client.InitDatabaseMock()
 
 	articleRepo := mock_repository.NewMockIArticleRepository(ctrl)
-	authorRepo := mock_repository.NewMockIAuthorRepository(ctrl)
 	articleSearch := mock_search.NewMockIArticleSearch(ctrl)
 
-	dto := v1req.ListArticlesDTO{Query: "Article"}
-	articleSearch.EXPECT().Search(gomock.Any(), dto.Query).Return(nil, apperror.ErrSearchElasticFailed)
+	dto := v1req.ListArticlesDTO{Query: "Article", SortBy: "relevance", SortDirection: "asc"}
+	article1 := factory.SampleArticle1
+	article2 := factory.SampleArticle2
+	mockArticles := []*model.Article{&article2, &article1}
+	mockResult := search.SearchResult{
+		IDs:   []uuid.UUID{article2.ID, article1.ID},
+		Total: 2,
+	}
+
+	expectedFilter := repository.ArticleFilter{
+		Status: model.ArticleStatusPublished,
+		Ids:    mockResult.IDs,
+		Limit:  20,
+	}
+
+	articleSearch.EXPECT().Search(gomock.Any(), search.SearchParams{Query: dto.Query, Highlight: true, Limit: 20}).Return(mockResult, nil)
+	articleRepo.EXPECT().List(gomock.Any(), expectedFilter).Return(mockArticles, nil)
 
 	svc := ArticleSvc{
 		articleRepo:   articleRepo,
-		authorRepo:    authorRepo,
 		articleSearch: articleSearch,
 	}
 
 	articles, recordsCount, err := svc.ListArticles(context.Background(), dto)
-	assert.Nil(t, articles)
-	assert.Equal(t, int64(0), recordsCount)
-	assert.Equal(t, apperror.ErrSearchElasticFailed, err)
+	assert.Equal(t, mockArticles, articles)
+	assert.Equal(t, int64(2), recordsCount)
+	assert.Nil(t, err)
 }
 
-func Test_ListArticle_ReturnErr_WhenListArticlesFailed(t *testing.T) {
+func Test_ListArticle_Success_WithQuerySortedByTitle(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	db_client.InitDatabaseMock()
 
 	articleRepo := mock_repository.NewMockIArticleRepository(ctrl)
-	authorRepo := mock_repository.NewMockIAuthorRepository(ctrl)
 	articleSearch := mock_search.NewMockIArticleSearch(ctrl)
 
-	dto := v1req.ListArticlesDTO{Query: "Article"}
-	mockArticleIds := []uuid.UUID{factory.SampleArticle1.ID}
+	dto := v1req.ListArticlesDTO{Query: "Article", SortBy: "title", SortDirection: "asc", Limit: 10, Page: 2}
+	article := factory.SampleArticle1
+	mockArticles := []*model.Article{&article}
+	mockResult := search.SearchResult{
+		IDs:   []uuid.UUID{article.ID},
+		Total: 11,
+		Highlights: map[uuid.UUID]model.ArticleHighlight{
+			article.ID: {Title: []string{"<em>Article</em> title"}},
+		},
+	}
+
+	expectedParams := search.SearchParams{
+		Query:         dto.Query,
+		SortBy:        "title",
+		SortDirection: "asc",
+		Highlight:     true,
+		Limit:         10,
+		Offset:        10,
+	}
 	expectedFilter := repository.ArticleFilter{
-		Ids:    mockArticleIds,
-		Limit:  20,
-		Offset: 0,
+		Status: model.ArticleStatusPublished,
+		Ids:    mockResult.IDs,
+		Limit:  10,
 	}
-	articleSearch.EXPECT().Search(gomock.Any(), dto.Query).Return(mockArticleIds, nil)
-	articleRepo.EXPECT().List(gomock.Any(), expectedFilter).Return(nil, apperror.ErrGetRecordFailed)
+
+	articleSearch.EXPECT().Search(gomock.Any(), expectedParams).Return(mockResult, nil)
+	articleRepo.EXPECT().List(gomock.Any(), expectedFilter).Return(mockArticles, nil)
 
 	svc := ArticleSvc{
 		articleRepo:   articleRepo,
-		authorRepo:    authorRepo,
 		articleSearch: articleSearch,
 	}
 
 	articles, recordsCount, err := svc.ListArticles(context.Background(), dto)
-	assert.Nil(t, articles)
-	assert.Equal(t, int64(0), recordsCount)
-	assert.Equal(t, apperror.ErrGetRecordFailed, err)
+	assert.Equal(t, mockArticles, articles)
+	assert.Equal(t, int64(11), recordsCount)
+	assert.Equal(t, []string{"<em>Article</em> title"}, articles[0].Highlight.Title)
+	assert.Nil(t, err)
 }
 
-func Test_ListArticle_ReturnErr_WhenGetRecordsCountFailed(t *testing.T) {
+func Test_ListArticle_Success_WhenSearchPageIsEmpty(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	db_client.InitDatabaseMock()
 
 	articleRepo := mock_repository.NewMockIArticleRepository(ctrl)
-	authorRepo := mock_repository.NewMockIAuthorRepository(ctrl)
 	articleSearch := mock_search.NewMockIArticleSearch(ctrl)
 
-	dto := v1req.ListArticlesDTO{Query: "Article"}
-	mockArticleIds := []uuid.UUID{factory.SampleArticle1.ID}
-	mockArticles := []*model.Article{&factory.SampleArticle1}
-	expectedFilter := repository.ArticleFilter{
-		Ids:    mockArticleIds,
-		Limit:  20,
-		Offset: 0,
+	dto := v1req.ListArticlesDTO{Query: "Article", Limit: 10, Page: 5}
+	expectedParams := search.SearchParams{Query: dto.Query, Highlight: true, Limit: 10, Offset: 40}
+	articleSearch.EXPECT().Search(gomock.Any(), expectedParams).Return(search.SearchResult{Total: 12}, nil)
+
+	svc := ArticleSvc{
+		articleRepo:   articleRepo,
+		articleSearch: articleSearch,
 	}
 
-	articleSearch.EXPECT().Search(gomock.Any(), dto.Query).Return(mockArticleIds, nil)
-	articleRepo.EXPECT().List(gomock.Any(), expectedFilter).Return(mockArticles, nil)
-	articleRepo.EXPECT().GetRecordsCount(gomock.Any(), expectedFilter).Return(int64(0), apperror.ErrGetRecordFailed)
+	articles, recordsCount, err := svc.ListArticles(context.Background(), dto)
+	assert.Empty(t, articles)
+	assert.Equal(t, int64(12), recordsCount)
+	assert.Nil(t, err)
+}
+
+func Test_ListArticle_Success_WithAuthorAndCreatedFilters(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	db_client.InitDatabaseMock()
+
+	articleRepo := mock_repository.NewMockIArticleRepository(ctrl)
+	articleSearch := mock_search.NewMockIArticleSearch(ctrl)
+
+	chandra, phang := factory.SampleAuthorChandra.ID, factory.SampleAuthorPhang.ID
+	dto := v1req.ListArticlesDTO{
+		Query:            "sayang",
+		AuthorIds:        []string{chandra.String()},
+		ExcludeAuthorIds: []string{phang.String()},
+		CreatedFrom:      "2025-07-01T00:00:00+07:00",
+		CreatedTo:        "2025-07-31T23:59:59+07:00",
+		Limit:            10,
+	}
+	createdFrom, _ := time.Parse(time.RFC3339, dto.CreatedFrom)
+	createdTo, _ := time.Parse(time.RFC3339, dto.CreatedTo)
+
+	article := factory.SampleArticle1
+	expectedParams := search.SearchParams{
+		Query: dto.Query,
+		Filter: search.SearchFilter{
+			CreatedFrom:      createdFrom,
+			CreatedTo:        createdTo,
+			AuthorIDs:        []uuid.UUID{chandra},
+			ExcludeAuthorIDs: []uuid.UUID{phang},
+		},
+		Highlight: true,
+		Limit:     10,
+	}
+	expectedFilter := repository.ArticleFilter{
+		Ids:    []uuid.UUID{article.ID},
+		Status: model.ArticleStatusPublished,
+		Limit:  10,
+	}
+	articleSearch.EXPECT().Search(gomock.Any(), expectedParams).Return(search.SearchResult{IDs: []uuid.UUID{article.ID}, Total: 1}, nil)
+	articleRepo.EXPECT().List(gomock.Any(), expectedFilter).Return([]*model.Article{&article}, nil)
 
 	svc := ArticleSvc{
 		articleRepo:   articleRepo,
-		authorRepo:    authorRepo,
 		articleSearch: articleSearch,
 	}
 
 	articles, recordsCount, err := svc.ListArticles(context.Background(), dto)
-	assert.Nil(t, articles)
-	assert.Equal(t, int64(0), recordsCount)
-	assert.Equal(t, apperror.ErrGetRecordFailed, err)
+	assert.Equal(t, []*model.Article{&article}, articles)
+	assert.Equal(t, int64(1), recordsCount)
+	assert.Nil(t, err)
+}
+
+func Test_ListArticle_Success_WithQueryAndCategoryAndTags_WhenHitIsNoLongerPublished(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	db_client.InitDatabaseMock()
+
+	articleRepo := mock_repository.NewMockIArticleRepository(ctrl)
+	categoryRepo := mock_repository.NewMockICategoryRepository(ctrl)
+	articleSearch := mock_search.NewMockIArticleSearch(ctrl)
+
+	news, local := factory.SampleCategoryNews, factory.SampleCategoryLocal
+	dto := v1req.ListArticlesDTO{
+		Query:      "sayang",
+		CategoryId: news.ID.String(),
+		Tags:       []string{"Puisi", "keluarga"},
+		TagsMatch:  "all",
+		Limit:      2,
+	}
+
+	article1 := factory.SampleArticle1
+	article2 := factory.SampleArticle2
+	expectedParams := search.SearchParams{
+		Query: dto.Query,
+		Filter: search.SearchFilter{
+			CategoryIDs:  []uuid.UUID{news.ID, local.ID},
+			Tags:         []string{"puisi", "keluarga"},
+			MatchAllTags: true,
+		},
+		Highlight: true,
+		Limit:     2,
+	}
+	// article2 was archived after the search engine indexed it
+	expectedFilter := repository.ArticleFilter{
+		Ids:    []uuid.UUID{article1.ID, article2.ID},
+		Status: model.ArticleStatusPublished,
+		Limit:  2,
+	}
+	categoryRepo.EXPECT().ListSubtreeIDs(gomock.Any(), news.ID).Return([]uuid.UUID{news.ID, local.ID}, nil)
+	articleSearch.EXPECT().Search(gomock.Any(), expectedParams).Return(search.SearchResult{IDs: expectedFilter.Ids, Total: 3}, nil)
+	articleRepo.EXPECT().List(gomock.Any(), expectedFilter).Return([]*model.Article{&article1}, nil)
+
+	svc := ArticleSvc{
+		articleRepo:   articleRepo,
+		categoryRepo:  categoryRepo,
+		articleSearch: articleSearch,
+	}
+
+	articles, recordsCount, err := svc.ListArticles(context.Background(), dto)
+	assert.Equal(t, []*model.Article{&article1}, articles)
+	assert.Equal(t, int64(2), recordsCount)
+	assert.Nil(t, err)
+}
+
+func Test_ListArticle_ReturnErr_WhenListSubtreeIDsFailed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	db_client.InitDatabaseMock()
+
+	categoryRepo := mock_repository.NewMockICategoryRepository(ctrl)
+	categoryRepo.EXPECT().ListSubtreeIDs(gomock.Any(), factory.SampleCategoryNews.ID).Return(nil, apperror.ErrGetRecordFailed)
+
+	svc := ArticleSvc{categoryRepo: categoryRepo}
+
+	dto := v1req.ListArticlesDTO{Query: "sayang", CategoryId: factory.SampleCategoryNews.ID.String()}
+	articles, recordsCount, err := svc.ListArticles(context.Background(), dto)
+	assert.Nil(t, articles)
+	assert.Equal(t, int64(0), recordsCount)
+	assert.Equal(t, apperror.ErrGetRecordFailed, err)
+}
+
+func Test_ListArticle_Success_WithFuzzyQuery(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	db_client.InitDatabaseMock()
+
+	articleSearch := mock_search.NewMockIArticleSearch(ctrl)
+
+	dto := v1req.ListArticlesDTO{Query: "sayng", Fuzzy: true, Limit: 10}
+	expectedParams := search.SearchParams{Query: dto.Query, Fuzzy: true, Highlight: true, Limit: 10}
+	articleSearch.EXPECT().Search(gomock.Any(), expectedParams).Return(search.SearchResult{}, nil)
+
+	svc := ArticleSvc{
+		articleSearch: articleSearch,
+	}
+
+	articles, recordsCount, err := svc.ListArticles(context.Background(), dto)
+	assert.Empty(t, articles)
+	assert.Equal(t, int64(0), recordsCount)
+	assert.Nil(t, err)
+}
+
+func Test_CorrectSearchQuery_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	categoryRepo := mock_repository.NewMockICategoryRepository(ctrl)
+	articleSearch := mock_search.NewMockIArticleSearch(ctrl)
+
+	news, local := factory.SampleCategoryNews, factory.SampleCategoryLocal
+	dto := v1req.ListArticlesDTO{
+		Query:      "sayng ibu",
+		Fuzzy:      true,
+		CategoryId: news.ID.String(),
+		Tags:       []string{"puisi"},
+	}
+	expectedParams := search.SearchParams{
+		Query: dto.Query,
+		Filter: search.SearchFilter{
+			CategoryIDs: []uuid.UUID{news.ID, local.ID},
+			Tags:        []string{"puisi"},
+		},
+		Fuzzy: true,
+	}
+	categoryRepo.EXPECT().ListSubtreeIDs(gomock.Any(), news.ID).Return([]uuid.UUID{news.ID, local.ID}, nil)
+	articleSearch.EXPECT().CorrectQuery(gomock.Any(), expectedParams).Return("sayang ibu", nil)
+
+	svc := ArticleSvc{
+		categoryRepo:  categoryRepo,
+		articleSearch: articleSearch,
+	}
+
+	suggestion, err := svc.CorrectSearchQuery(context.Background(), dto)
+	assert.Equal(t, "sayang ibu", suggestion)
+	assert.Nil(t, err)
+}
+
+func Test_CorrectSearchQuery_ReturnErr_WhenCorrectQueryFailed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	articleSearch := mock_search.NewMockIArticleSearch(ctrl)
+	articleSearch.EXPECT().CorrectQuery(gomock.Any(), search.SearchParams{Query: "sayng"}).Return("", apperror.ErrSearchElasticFailed)
+
+	svc := ArticleSvc{
+		articleSearch: articleSearch,
+	}
+
+	suggestion, err := svc.CorrectSearchQuery(context.Background(), v1req.ListArticlesDTO{Query: "sayng"})
+	assert.Equal(t, "", suggestion)
+	assert.Equal(t, apperror.ErrSearchElasticFailed, err)
+}
+
+func Test_CorrectSearchQuery_ReturnErr_WhenListSubtreeIDsFailed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	categoryRepo := mock_repository.NewMockICategoryRepository(ctrl)
+	categoryRepo.EXPECT().ListSubtreeIDs(gomock.Any(), factory.SampleCategoryNews.ID).Return(nil, apperror.ErrGetRecordFailed)
+
+	svc := ArticleSvc{
+		categoryRepo: categoryRepo,
+	}
+
+	dto := v1req.ListArticlesDTO{Query: "sayng", CategoryId: factory.SampleCategoryNews.ID.String()}
+	suggestion, err := svc.CorrectSearchQuery(context.Background(), dto)
+	assert.Equal(t, "", suggestion)
+	assert.Equal(t, apperror.ErrGetRecordFailed, err)
+}
+
+func Test_ListArticleFacets_Success_WithoutQuery(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	db_client.InitDatabaseMock()
+
+	articleRepo := mock_repository.NewMockIArticleRepository(ctrl)
+
+	dto := v1req.ListArticlesDTO{
+		AuthorName: "Chandra",
+		Tags:       []string{"Puisi"},
+		Facets:     []string{model.FacetMonth},
+		SortBy:     "title",
+		Limit:      10,
+		Page:       2,
+	}
+	mockFacets := model.ArticleFacets{model.FacetMonth: {{Value: "2025-07", Count: 1}}}
+	expectedFilter := repository.ArticleFilter{
+		Status:     model.ArticleStatusPublished,
+		AuthorName: dto.AuthorName,
+		Tags:       []string{"puisi"},
+	}
+
+	articleRepo.EXPECT().CountFacets(gomock.Any(), expectedFilter, dto.Facets).Return(mockFacets, nil)
+
+	svc := ArticleSvc{
+		articleRepo: articleRepo,
+	}
+
+	facets, err := svc.ListArticleFacets(context.Background(), dto)
+	assert.Equal(t, mockFacets, facets)
+	assert.Nil(t, err)
+}
+
+func Test_ListArticleFacets_Success_WithQuery(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	db_client.InitDatabaseMock()
+
+	articleSearch := mock_search.NewMockIArticleSearch(ctrl)
+
+	dto := v1req.ListArticlesDTO{Query: "ibu", Facets: []string{model.FacetAuthor, model.FacetTag}, Limit: 10}
+	mockResult := search.SearchResult{
+		Total: 1,
+		Facets: model.ArticleFacets{
+			model.FacetAuthor: {{Value: factory.SampleAuthorChandra.ID.String(), Label: "Chandra", Count: 1}},
+			model.FacetTag:    {{Value: "puisi", Count: 1}},
+		},
+	}
+
+	expectedParams := search.SearchParams{Query: dto.Query, Facets: dto.Facets}
+	articleSearch.EXPECT().Search(gomock.Any(), expectedParams).Return(mockResult, nil)
+
+	svc := ArticleSvc{
+		articleSearch: articleSearch,
+	}
+
+	facets, err := svc.ListArticleFacets(context.Background(), dto)
+	assert.Equal(t, mockResult.Facets, facets)
+	assert.Nil(t, err)
+}
+
+func Test_ListArticleFacets_Success_WithQueryAndFilters(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	db_client.InitDatabaseMock()
+
+	categoryRepo := mock_repository.NewMockICategoryRepository(ctrl)
+	articleSearch := mock_search.NewMockIArticleSearch(ctrl)
+
+	news := factory.SampleCategoryNews
+	dto := v1req.ListArticlesDTO{
+		Query:      "ibu",
+		AuthorName: "Chandra",
+		CategoryId: news.ID.String(),
+		Tags:       []string{"Puisi"},
+		Facets:     []string{model.FacetMonth},
+	}
+	mockResult := search.SearchResult{Total: 1, Facets: model.ArticleFacets{model.FacetMonth: {{Value: "2025-07", Count: 1}}}}
+
+	expectedParams := search.SearchParams{
+		Query: dto.Query,
+		Filter: search.SearchFilter{
+			AuthorName:  dto.AuthorName,
+			CategoryIDs: []uuid.UUID{news.ID},
+			Tags:        []string{"puisi"},
+		},
+		Facets: dto.Facets,
+	}
+	categoryRepo.EXPECT().ListSubtreeIDs(gomock.Any(), news.ID).Return([]uuid.UUID{news.ID}, nil)
+	articleSearch.EXPECT().Search(gomock.Any(), expectedParams).Return(mockResult, nil)
+
+	svc := ArticleSvc{
+		categoryRepo:  categoryRepo,
+		articleSearch: articleSearch,
+	}
+
+	facets, err := svc.ListArticleFacets(context.Background(), dto)
+	assert.Equal(t, mockResult.Facets, facets)
+	assert.Nil(t, err)
+}
+
+func Test_ListArticleFacets_ReturnErr_WhenSearchFailed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	db_client.InitDatabaseMock()
+
+	articleSearch := mock_search.NewMockIArticleSearch(ctrl)
+	articleSearch.EXPECT().Search(gomock.Any(), gomock.Any()).Return(search.SearchResult{}, apperror.ErrSearchElasticFailed)
+
+	svc := ArticleSvc{
+		articleSearch: articleSearch,
+	}
+
+	facets, err := svc.ListArticleFacets(context.Background(), v1req.ListArticlesDTO{Query: "ibu", Facets: []string{model.FacetTag}})
+	assert.Nil(t, facets)
+	assert.Equal(t, apperror.ErrSearchElasticFailed, err)
+}
+
+func Test_SuggestArticles_Success_WithDefaultLimit(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	articleSearch := mock_search.NewMockIArticleSearch(ctrl)
+
+	mockSuggestions := model.ArticleSuggestions{
+		Titles:  []model.TitleSuggestion{{ArticleID: factory.SampleArticle1.ID, Title: factory.SampleArticle1.Title}},
+		Authors: []string{"Chandra"},
+	}
+	articleSearch.EXPECT().Suggest(gomock.Any(), "sa", defaultSuggestLimit).Return(mockSuggestions, nil)
+
+	svc := ArticleSvc{
+		articleSearch: articleSearch,
+	}
+
+	suggestions, err := svc.SuggestArticles(context.Background(), v1req.SuggestArticlesDTO{Query: "sa"})
+	assert.Equal(t, mockSuggestions, suggestions)
+	assert.Nil(t, err)
+}
+
+func Test_SuggestArticles_ReturnNoSuggestions_WhenSearchTimedOut(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	articleSearch := mock_search.NewMockIArticleSearch(ctrl)
+	articleSearch.EXPECT().Suggest(gomock.Any(), "sa", 3).DoAndReturn(
+		func(ctx context.Context, prefix string, size int) (model.ArticleSuggestions, error) {
+			<-ctx.Done()
+			return model.ArticleSuggestions{}, apperror.ErrSearchElasticFailed
+		})
+
+	svc := ArticleSvc{
+		articleSearch: articleSearch,
+	}
+
+	suggestions, err := svc.SuggestArticles(context.Background(), v1req.SuggestArticlesDTO{Query: "sa", Limit: 3})
+	assert.Equal(t, model.ArticleSuggestions{Titles: []model.TitleSuggestion{}, Authors: []string{}}, suggestions)
+	assert.Nil(t, err)
+}
+
+func Test_SuggestArticles_ReturnErr_WhenSuggestFailed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	articleSearch := mock_search.NewMockIArticleSearch(ctrl)
+	articleSearch.EXPECT().Suggest(gomock.Any(), "sa", defaultSuggestLimit).Return(model.ArticleSuggestions{}, apperror.ErrSearchElasticFailed)
+
+	svc := ArticleSvc{
+		articleSearch: articleSearch,
+	}
+
+	suggestions, err := svc.SuggestArticles(context.Background(), v1req.SuggestArticlesDTO{Query: "sa"})
+	assert.Equal(t, model.ArticleSuggestions{}, suggestions)
+	assert.Equal(t, apperror.ErrSearchElasticFailed, err)
+}
+
+func Test_ListRelatedArticles_Success_WithDefaultLimit(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	articleRepo := mock_repository.NewMockIArticleRepository(ctrl)
+	articleSearch := mock_search.NewMockIArticleSearch(ctrl)
+
+	article1 := factory.SampleArticle1
+	article2 := factory.SampleArticle2
+	// the second related article became a draft after it was indexed
+	relatedIDs := []uuid.UUID{article2.ID, uuid.MustParse("0197db1c-c6c4-7140-bee3-8efd703f30ca")}
+	expectedFilter := repository.ArticleFilter{
+		Ids:    relatedIDs,
+		Status: model.ArticleStatusPublished,
+		Limit:  2,
+	}
+
+	articleRepo.EXPECT().Get(gomock.Any(), article1.ID).Return(&article1, nil)
+	articleSearch.EXPECT().Related(gomock.Any(), article1, defaultRelatedLimit).Return(relatedIDs, nil)
+	articleRepo.EXPECT().List(gomock.Any(), expectedFilter).Return([]*model.Article{&article2}, nil)
+
+	svc := ArticleSvc{
+		articleRepo:   articleRepo,
+		articleSearch: articleSearch,
+	}
+
+	articles, err := svc.ListRelatedArticles(context.Background(), article1.ID, v1req.ListRelatedArticlesDTO{})
+	assert.Equal(t, []*model.Article{&article2}, articles)
+	assert.Nil(t, err)
+}
+
+func Test_ListRelatedArticles_ReturnEmpty_WhenNothingIsRelated(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	articleRepo := mock_repository.NewMockIArticleRepository(ctrl)
+	articleSearch := mock_search.NewMockIArticleSearch(ctrl)
+
+	article := factory.SampleArticle1
+	articleRepo.EXPECT().Get(gomock.Any(), article.ID).Return(&article, nil)
+	articleSearch.EXPECT().Related(gomock.Any(), article, 3).Return(nil, nil)
+
+	svc := ArticleSvc{
+		articleRepo:   articleRepo,
+		articleSearch: articleSearch,
+	}
+
+	articles, err := svc.ListRelatedArticles(context.Background(), article.ID, v1req.ListRelatedArticlesDTO{Limit: 3})
+	assert.Equal(t, []*model.Article{}, articles)
+	assert.Nil(t, err)
+}
+
+func Test_ListRelatedArticles_ReturnErr_WhenArticleNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	articleRepo := mock_repository.NewMockIArticleRepository(ctrl)
+	articleSearch := mock_search.NewMockIArticleSearch(ctrl)
+
+	id := factory.SampleArticle1.ID
+	articleRepo.EXPECT().Get(gomock.Any(), id).Return(nil, apperror.ErrObjectNotExists)
+
+	svc := ArticleSvc{
+		articleRepo:   articleRepo,
+		articleSearch: articleSearch,
+	}
+
+	articles, err := svc.ListRelatedArticles(context.Background(), id, v1req.ListRelatedArticlesDTO{})
+	assert.Nil(t, articles)
+	assert.Equal(t, apperror.ErrObjectNotExists, err)
+}
+
+func Test_ListRelatedArticles_ReturnErr_WhenRelatedFailed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	articleRepo := mock_repository.NewMockIArticleRepository(ctrl)
+	articleSearch := mock_search.NewMockIArticleSearch(ctrl)
+
+	article := factory.SampleArticle1
+	articleRepo.EXPECT().Get(gomock.Any(), article.ID).Return(&article, nil)
+	articleSearch.EXPECT().Related(gomock.Any(), article, defaultRelatedLimit).Return(nil, apperror.ErrSearchElasticFailed)
+
+	svc := ArticleSvc{
+		articleRepo:   articleRepo,
+		articleSearch: articleSearch,
+	}
+
+	articles, err := svc.ListRelatedArticles(context.Background(), article.ID, v1req.ListRelatedArticlesDTO{})
+	assert.Nil(t, articles)
+	assert.Equal(t, apperror.ErrSearchElasticFailed, err)
+}
+
+func Test_ListArticle_ReturnErr_WhenSearchFailed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	db_client.InitDatabaseMock()
+
+	articleRepo := mock_repository.NewMockIArticleRepository(ctrl)
+	authorRepo := mock_repository.NewMockIAuthorRepository(ctrl)
+	articleSearch := mock_search.NewMockIArticleSearch(ctrl)
+
+	dto := v1req.ListArticlesDTO{Query: "Article"}
+	articleSearch.EXPECT().Search(gomock.Any(), gomock.Any()).Return(search.SearchResult{}, apperror.ErrSearchElasticFailed)
+
+	svc := ArticleSvc{
+		articleRepo:   articleRepo,
+		authorRepo:    authorRepo,
+		articleSearch: articleSearch,
+	}
+
+	articles, recordsCount, err := svc.ListArticles(context.Background(), dto)
+	assert.Nil(t, articles)
+	assert.Equal(t, int64(0), recordsCount)
+	assert.Equal(t, apperror.ErrSearchElasticFailed, err)
+}
+
+func Test_ListArticle_ReturnErr_WhenListArticlesFailed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	db_client.InitDatabaseMock()
+
+	articleRepo := mock_repository.NewMockIArticleRepository(ctrl)
+	authorRepo := mock_repository.NewMockIAuthorRepository(ctrl)
+	articleSearch := mock_search.NewMockIArticleSearch(ctrl)
+
+	dto := v1req.ListArticlesDTO{Query: "Article"}
+	mockResult := search.SearchResult{IDs: []uuid.UUID{factory.SampleArticle1.ID}, Total: 1}
+	expectedFilter := repository.ArticleFilter{
+		Status: model.ArticleStatusPublished,
+		Ids:    mockResult.IDs,
+		Limit:  20,
+		Offset: 0,
+	}
+	articleSearch.EXPECT().Search(gomock.Any(), search.SearchParams{Query: dto.Query, Highlight: true, Limit: 20}).Return(mockResult, nil)
+	articleRepo.EXPECT().List(gomock.Any(), expectedFilter).Return(nil, apperror.ErrGetRecordFailed)
+
+	svc := ArticleSvc{
+		articleRepo:   articleRepo,
+		authorRepo:    authorRepo,
+		articleSearch: articleSearch,
+	}
+
+	articles, recordsCount, err := svc.ListArticles(context.Background(), dto)
+	assert.Nil(t, articles)
+	assert.Equal(t, int64(0), recordsCount)
+	assert.Equal(t, apperror.ErrGetRecordFailed, err)
+}
+
+func Test_ListArticle_ReturnErr_WhenGetRecordsCountFailed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	db_client.InitDatabaseMock()
+
+	articleRepo := mock_repository.NewMockIArticleRepository(ctrl)
+	authorRepo := mock_repository.NewMockIAuthorRepository(ctrl)
+	articleSearch := mock_search.NewMockIArticleSearch(ctrl)
+
+	dto := v1req.ListArticlesDTO{}
+	mockArticles := []*model.Article{&factory.SampleArticle1}
+	expectedFilter := repository.ArticleFilter{
+		Status: model.ArticleStatusPublished,
+		Limit:  20,
+		Offset: 0,
+	}
+
+	articleRepo.EXPECT().List(gomock.Any(), expectedFilter).Return(mockArticles, nil)
+	articleRepo.EXPECT().GetRecordsCount(gomock.Any(), expectedFilter).Return(int64(0), apperror.ErrGetRecordFailed)
+
+	svc := ArticleSvc{
+		articleRepo:   articleRepo,
+		authorRepo:    authorRepo,
+		articleSearch: articleSearch,
+	}
+
+	articles, recordsCount, err := svc.ListArticles(context.Background(), dto)
+	assert.Nil(t, articles)
+	assert.Equal(t, int64(0), recordsCount)
+	assert.Equal(t, apperror.ErrGetRecordFailed, err)
+}
+
+func Test_GetArticle_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	db_client.InitDatabaseMock()
+
+	articleRepo := mock_repository.NewMockIArticleRepository(ctrl)
+	authorRepo := mock_repository.NewMockIAuthorRepository(ctrl)
+	articleSearch := mock_search.NewMockIArticleSearch(ctrl)
+
+	article := factory.SampleArticle1
+	articleRepo.EXPECT().Get(gomock.Any(), article.ID).Return(&article, nil)
+
+	svc := ArticleSvc{
+		articleRepo:   articleRepo,
+		authorRepo:    authorRepo,
+		articleSearch: articleSearch,
+	}
+
+	result, err := svc.GetArticle(context.Background(), article.ID)
+	assert.Equal(t, &article, result)
+	assert.Nil(t, err)
+}
+
+func Test_GetArticle_ReturnErr_WhenArticleNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	db_client.InitDatabaseMock()
+
+	articleRepo := mock_repository.NewMockIArticleRepository(ctrl)
+	authorRepo := mock_repository.NewMockIAuthorRepository(ctrl)
+	articleSearch := mock_search.NewMockIArticleSearch(ctrl)
+
+	article := factory.SampleArticle1
+	articleRepo.EXPECT().Get(gomock.Any(), article.ID).Return(nil, apperror.ErrObjectNotExists)
+
+	svc := ArticleSvc{
+		articleRepo:   articleRepo,
+		authorRepo:    authorRepo,
+		articleSearch: articleSearch,
+	}
+
+	result, err := svc.GetArticle(context.Background(), article.ID)
+	assert.Nil(t, result)
+	assert.Equal(t, apperror.ErrObjectNotExists, err)
+}
+
+func Test_GetArticleBySlug_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	article := factory.SampleArticle1
+	articleRepo := mock_repository.NewMockIArticleRepository(ctrl)
+	articleSlugRepo := mock_repository.NewMockIArticleSlugRepository(ctrl)
+	articleSlugRepo.EXPECT().GetArticleID(gomock.Any(), "old-slug").Return(article.ID, nil)
+	articleRepo.EXPECT().Get(gomock.Any(), article.ID).Return(&article, nil)
+
+	svc := ArticleSvc{
+		articleRepo:     articleRepo,
+		articleSlugRepo: articleSlugRepo,
+	}
+	result, err := svc.GetArticleBySlug(context.Background(), "old-slug")
+	assert.Nil(t, err)
+	assert.Equal(t, article.Slug, result.Slug)
+}
+
+func Test_GetArticleBySlug_ReturnErr_WhenSlugNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	articleSlugRepo := mock_repository.NewMockIArticleSlugRepository(ctrl)
+	articleSlugRepo.EXPECT().GetArticleID(gomock.Any(), "missing").Return(uuid.Nil, apperror.ErrObjectNotExists)
+
+	svc := ArticleSvc{
+		articleSlugRepo: articleSlugRepo,
+	}
+	result, err := svc.GetArticleBySlug(context.Background(), "missing")
+	assert.Nil(t, result)
+	assert.Equal(t, apperror.ErrObjectNotExists, err)
+}
+
+func Test_UpdateArticle_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	db_client.InitDatabaseMockExpectingTxn(ctrl, true, true)
+
+	article := factory.SampleArticle1
+	article.Category = &factory.SampleCategoryNews
+	author := factory.SampleAuthorPhang
+	dto := v1req.UpdateArticleDTO{
+		Title:    "Updated Title",
+		Body:     "Updated Body",
+		AuthorId: author.ID.String(),
+	}
+
+	articleRepo := mock_repository.NewMockIArticleRepository(ctrl)
+	authorRepo := mock_repository.NewMockIAuthorRepository(ctrl)
+	outboxEventRepo := mock_repository.NewMockIOutboxEventRepository(ctrl)
+	articleRevisionRepo := mock_repository.NewMockIArticleRevisionRepository(ctrl)
+	articleRevisionRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+
+	articleRepo.EXPECT().GetForUpdate(gomock.Any(), article.ID).Return(&article, nil)
+	authorRepo.EXPECT().Get(gomock.Any(), author.ID).Return(&author, nil)
+	articleSlugRepo := mock_repository.NewMockIArticleSlugRepository(ctrl)
+	articleSlugRepo.EXPECT().ListTaken(gomock.Any(), "updated-title", article.ID).Return(nil, nil)
+	articleSlugRepo.EXPECT().Create(gomock.Any(), article.ID, "updated-title").Return(nil)
+	articleRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+	// a PUT without category and tags replaces them with none
+	tagRepo := mock_repository.NewMockITagRepository(ctrl)
+	tagRepo.EXPECT().SetArticleTags(gomock.Any(), article.ID, nil).Return(nil)
+	outboxEventRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+
+	svc := ArticleSvc{
+		articleRepo:         articleRepo,
+		articleSlugRepo:     articleSlugRepo,
+		authorRepo:          authorRepo,
+		articleRevisionRepo: articleRevisionRepo,
+		tagRepo:             tagRepo,
+		outboxEventRepo:     outboxEventRepo,
+	}
+	result, err := svc.UpdateArticle(context.Background(), article.ID, dto)
+	assert.Nil(t, err)
+	assert.Equal(t, dto.Title, result.Title)
+	assert.Equal(t, "updated-title", result.Slug)
+	assert.Equal(t, dto.Body, result.Body)
+	assert.Equal(t, author, result.Author)
+	assert.Nil(t, result.Category)
+	assert.Empty(t, result.Tags)
+}
+
+func Test_UpdateArticle_KeepSlug_WhenTitleOnlyChangesCasing(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	db_client.InitDatabaseMockExpectingTxn(ctrl, true, true)
+
+	article := factory.SampleArticle1
+	title := "Satu Satu, Aku Sayang Ibu!"
+	dto := v1req.PatchArticleDTO{Title: &title}
+
+	articleRepo := mock_repository.NewMockIArticleRepository(ctrl)
+	outboxEventRepo := mock_repository.NewMockIOutboxEventRepository(ctrl)
+	articleRevisionRepo := mock_repository.NewMockIArticleRevisionRepository(ctrl)
+	articleRevisionRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+	articleSlugRepo := mock_repository.NewMockIArticleSlugRepository(ctrl)
+
+	articleRepo.EXPECT().GetForUpdate(gomock.Any(), article.ID).Return(&article, nil)
+	articleRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+	outboxEventRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+
+	svc := ArticleSvc{
+		articleRepo:         articleRepo,
+		articleSlugRepo:     articleSlugRepo,
+		articleRevisionRepo: articleRevisionRepo,
+		outboxEventRepo:     outboxEventRepo,
+	}
+	result, err := svc.PatchArticle(context.Background(), article.ID, dto)
+	assert.Nil(t, err)
+	assert.Equal(t, title, result.Title)
+	assert.Equal(t, factory.SampleArticle1.Slug, result.Slug)
+}
+
+func Test_UpdateArticle_Success_WithTags(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	db_client.InitDatabaseMockExpectingTxn(ctrl, true, true)
+
+	article := factory.SampleArticle1
+	dto := v1req.UpdateArticleDTO{
+		Title:    article.Title,
+		Body:     article.Body,
+		AuthorId: article.Author.ID.String(),
+		Tags:     []string{},
+	}
+
+	articleRepo := mock_repository.NewMockIArticleRepository(ctrl)
+	outboxEventRepo := mock_repository.NewMockIOutboxEventRepository(ctrl)
+	articleRevisionRepo := mock_repository.NewMockIArticleRevisionRepository(ctrl)
+	articleRevisionRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+	tagRepo := mock_repository.NewMockITagRepository(ctrl)
+	tagRepo.EXPECT().SetArticleTags(gomock.Any(), article.ID, nil).Return(nil)
+
+	articleRepo.EXPECT().GetForUpdate(gomock.Any(), article.ID).Return(&article, nil)
+	articleRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+	outboxEventRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+
+	svc := ArticleSvc{
+		articleRepo:         articleRepo,
+		articleRevisionRepo: articleRevisionRepo,
+		tagRepo:             tagRepo,
+		outboxEventRepo:     outboxEventRepo,
+	}
+	result, err := svc.UpdateArticle(context.Background(), article.ID, dto)
+	assert.Nil(t, err)
+	assert.Empty(t, result.Tags)
+}
+
+func Test_UpdateArticle_ReturnErr_WhenArticleNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	db_client.InitDatabaseMockExpectingTxn(ctrl, true, nil)
+
+	articleID := utils.GenerateUUID()
+	dto := v1req.UpdateArticleDTO{
+		Title:    "Updated Title",
+		Body:     "Updated Body",
+		AuthorId: factory.SampleAuthorChandra.ID.String(),
+	}
+
+	articleRepo := mock_repository.NewMockIArticleRepository(ctrl)
+	authorRepo := mock_repository.NewMockIAuthorRepository(ctrl)
+	articleSearch := mock_search.NewMockIArticleSearch(ctrl)
+
+	articleRepo.EXPECT().GetForUpdate(gomock.Any(), articleID).Return(nil, apperror.ErrObjectNotExists)
+
+	svc := ArticleSvc{
+		articleRepo:   articleRepo,
+		authorRepo:    authorRepo,
+		articleSearch: articleSearch,
+	}
+	result, err := svc.UpdateArticle(context.Background(), articleID, dto)
+	assert.Nil(t, result)
+	assert.Equal(t, apperror.ErrObjectNotExists, err)
+}
+
+func Test_UpdateArticle_ReturnErr_WhenAuthorNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	db_client.InitDatabaseMockExpectingTxn(ctrl, true, nil)
+
+	article := factory.SampleArticle1
+	authorID := utils.GenerateUUID()
+	dto := v1req.UpdateArticleDTO{
+		Title:    "Updated Title",
+		Body:     "Updated Body",
+		AuthorId: authorID.String(),
+	}
+
+	articleRepo := mock_repository.NewMockIArticleRepository(ctrl)
+	authorRepo := mock_repository.NewMockIAuthorRepository(ctrl)
+	articleSearch := mock_search.NewMockIArticleSearch(ctrl)
+
+	articleRepo.EXPECT().GetForUpdate(gomock.Any(), article.ID).Return(&article, nil)
+	authorRepo.EXPECT().Get(gomock.Any(), authorID).Return(nil, apperror.ErrObjectNotExists)
+
+	svc := ArticleSvc{
+		articleRepo:   articleRepo,
+		authorRepo:    authorRepo,
+		articleSearch: articleSearch,
+	}
+	result, err := svc.UpdateArticle(context.Background(), article.ID, dto)
+	assert.Nil(t, result)
+	assert.Equal(t, apperror.ErrAuthorNotFound, err)
+}
+
+func Test_UpdateArticle_ReturnErr_WhenUpdateArticleFailed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	db_client.InitDatabaseMockExpectingTxn(ctrl, true, nil)
+
+	article := factory.SampleArticle1
+	dto := v1req.UpdateArticleDTO{
+		Title:    "Updated Title",
+		Body:     "Updated Body",
+		AuthorId: article.Author.ID.String(),
+	}
+
+	articleRepo := mock_repository.NewMockIArticleRepository(ctrl)
+	authorRepo := mock_repository.NewMockIAuthorRepository(ctrl)
+	articleSearch := mock_search.NewMockIArticleSearch(ctrl)
+
+	articleRepo.EXPECT().GetForUpdate(gomock.Any(), article.ID).Return(&article, nil)
+	articleSlugRepo := mock_repository.NewMockIArticleSlugRepository(ctrl)
+	articleSlugRepo.EXPECT().ListTaken(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+	articleRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(apperror.ErrUpdateRecordFailed)
+
+	svc := ArticleSvc{
+		articleRepo:     articleRepo,
+		articleSlugRepo: articleSlugRepo,
+		authorRepo:      authorRepo,
+		articleSearch:   articleSearch,
+	}
+	result, err := svc.UpdateArticle(context.Background(), article.ID, dto)
+	assert.Nil(t, result)
+	assert.Equal(t, apperror.ErrUpdateRecordFailed, err)
+}
+
+func Test_UpdateArticle_ReturnErr_WhenEnqueueSearchSyncFailed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	db_client.InitDatabaseMockExpectingTxn(ctrl, true, nil)
+
+	article := factory.SampleArticle1
+	dto := v1req.UpdateArticleDTO{
+		Title:    "Updated Title",
+		Body:     "Updated Body",
+		AuthorId: article.Author.ID.String(),
+	}
+
+	articleRepo := mock_repository.NewMockIArticleRepository(ctrl)
+	authorRepo := mock_repository.NewMockIAuthorRepository(ctrl)
+	outboxEventRepo := mock_repository.NewMockIOutboxEventRepository(ctrl)
+	articleRevisionRepo := mock_repository.NewMockIArticleRevisionRepository(ctrl)
+	articleRevisionRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+
+	articleRepo.EXPECT().GetForUpdate(gomock.Any(), article.ID).Return(&article, nil)
+	articleSlugRepo := mock_repository.NewMockIArticleSlugRepository(ctrl)
+	articleSlugRepo.EXPECT().ListTaken(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+	articleSlugRepo.EXPECT().Create(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+	articleRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+	tagRepo := mock_repository.NewMockITagRepository(ctrl)
+	tagRepo.EXPECT().SetArticleTags(gomock.Any(), gomock.Any(), nil).Return(nil)
+	outboxEventRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(apperror.ErrCreateRecordFailed)
+
+	svc := ArticleSvc{
+		articleRepo:         articleRepo,
+		tagRepo:             tagRepo,
+		articleSlugRepo:     articleSlugRepo,
+		authorRepo:          authorRepo,
+		articleRevisionRepo: articleRevisionRepo,
+		outboxEventRepo:     outboxEventRepo,
+	}
+	result, err := svc.UpdateArticle(context.Background(), article.ID, dto)
+	assert.Nil(t, result)
+	assert.Equal(t, apperror.ErrCreateRecordFailed, err)
+}
+
+func Test_UpdateArticle_ReturnErr_WhenCommitTransactionFailed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	db_client.InitDatabaseMockExpectingTxn(ctrl, true, false)
+
+	article := factory.SampleArticle1
+	dto := v1req.UpdateArticleDTO{
+		Title:    "Updated Title",
+		Body:     "Updated Body",
+		AuthorId: article.Author.ID.String(),
+	}
+
+	articleRepo := mock_repository.NewMockIArticleRepository(ctrl)
+	authorRepo := mock_repository.NewMockIAuthorRepository(ctrl)
+	outboxEventRepo := mock_repository.NewMockIOutboxEventRepository(ctrl)
+	articleRevisionRepo := mock_repository.NewMockIArticleRevisionRepository(ctrl)
+	articleRevisionRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+
+	articleRepo.EXPECT().GetForUpdate(gomock.Any(), article.ID).Return(&article, nil)
+	articleSlugRepo := mock_repository.NewMockIArticleSlugRepository(ctrl)
+	articleSlugRepo.EXPECT().ListTaken(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+	articleSlugRepo.EXPECT().Create(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+	articleRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+	tagRepo := mock_repository.NewMockITagRepository(ctrl)
+	tagRepo.EXPECT().SetArticleTags(gomock.Any(), gomock.Any(), nil).Return(nil)
+	outboxEventRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+
+	svc := ArticleSvc{
+		articleRepo:         articleRepo,
+		tagRepo:             tagRepo,
+		articleSlugRepo:     articleSlugRepo,
+		authorRepo:          authorRepo,
+		articleRevisionRepo: articleRevisionRepo,
+		outboxEventRepo:     outboxEventRepo,
+	}
+	result, err := svc.UpdateArticle(context.Background(), article.ID, dto)
+	assert.Nil(t, result)
+	assert.Equal(t, apperror.ErrCommitTransactionFailed, err)
+}
+
+func Test_PatchArticle_Success_WhenCategoryCleared(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	db_client.InitDatabaseMockExpectingTxn(ctrl, true, true)
+
+	article := factory.SampleArticle1
+	category := factory.SampleCategoryNews
+	article.Category = &category
+	categoryID := ""
+	dto := v1req.PatchArticleDTO{CategoryId: &categoryID}
+
+	articleRepo := mock_repository.NewMockIArticleRepository(ctrl)
+	outboxEventRepo := mock_repository.NewMockIOutboxEventRepository(ctrl)
+	articleRevisionRepo := mock_repository.NewMockIArticleRevisionRepository(ctrl)
+	articleRevisionRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+
+	articleRepo.EXPECT().GetForUpdate(gomock.Any(), article.ID).Return(&article, nil)
+	articleRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+	outboxEventRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+
+	svc := ArticleSvc{
+		articleRepo:         articleRepo,
+		articleRevisionRepo: articleRevisionRepo,
+		outboxEventRepo:     outboxEventRepo,
+	}
+	result, err := svc.PatchArticle(context.Background(), article.ID, dto)
+	assert.Nil(t, err)
+	assert.Nil(t, result.Category)
+}
+
+func Test_PatchArticle_Success_WhenOnlyTitleGiven(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	db_client.InitDatabaseMockExpectingTxn(ctrl, true, true)
+
+	article := factory.SampleArticle1
+	title := "Patched Title"
+	dto := v1req.PatchArticleDTO{Title: &title}
+
+	articleRepo := mock_repository.NewMockIArticleRepository(ctrl)
+	authorRepo := mock_repository.NewMockIAuthorRepository(ctrl)
+	outboxEventRepo := mock_repository.NewMockIOutboxEventRepository(ctrl)
+	articleRevisionRepo := mock_repository.NewMockIArticleRevisionRepository(ctrl)
+	articleRevisionRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+
+	articleRepo.EXPECT().GetForUpdate(gomock.Any(), article.ID).Return(&article, nil)
+	articleSlugRepo := mock_repository.NewMockIArticleSlugRepository(ctrl)
+	articleSlugRepo.EXPECT().ListTaken(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+	articleSlugRepo.EXPECT().Create(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+	articleRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+	outboxEventRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+
+	svc := ArticleSvc{
+		articleRepo:         articleRepo,
+		articleSlugRepo:     articleSlugRepo,
+		authorRepo:          authorRepo,
+		articleRevisionRepo: articleRevisionRepo,
+		outboxEventRepo:     outboxEventRepo,
+	}
+	result, err := svc.PatchArticle(context.Background(), article.ID, dto)
+	assert.Nil(t, err)
+	assert.Equal(t, title, result.Title)
+	assert.Equal(t, factory.SampleArticle1.Body, result.Body)
+	assert.Equal(t, factory.SampleArticle1.Author, result.Author)
+}
+
+func Test_PatchArticle_Success_MergeIntoLockedArticle(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	db_client.InitDatabaseMockExpectingTxn(ctrl, true, true)
+
+	// the body was patched by a concurrent request that committed while this one waited for the lock
+	locked := factory.SampleArticle1
+	locked.Body = "Body of the other patch"
+	body := "Patched Body"
+	dto := v1req.PatchArticleDTO{Body: &body}
+
+	articleRepo := mock_repository.NewMockIArticleRepository(ctrl)
+	outboxEventRepo := mock_repository.NewMockIOutboxEventRepository(ctrl)
+	articleRevisionRepo := mock_repository.NewMockIArticleRevisionRepository(ctrl)
+
+	gomock.InOrder(
+		articleRepo.EXPECT().GetForUpdate(gomock.Any(), locked.ID).Return(&locked, nil),
+		articleRepo.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, article *model.Article) error {
+			assert.Equal(t, body, article.Body)
+			assert.Equal(t, factory.SampleArticle1.Title, article.Title)
+			return nil
+		}),
+		articleRevisionRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil),
+		outboxEventRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil),
+	)
+
+	svc := ArticleSvc{
+		articleRepo:         articleRepo,
+		articleRevisionRepo: articleRevisionRepo,
+		outboxEventRepo:     outboxEventRepo,
+	}
+	result, err := svc.PatchArticle(context.Background(), locked.ID, dto)
+	assert.Nil(t, err)
+	assert.Equal(t, body, result.Body)
+}
+
+func Test_GetArticle_ReturnErr_WhenArticleIsDeleted(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	db_client.InitDatabaseMock()
+
+	articleRepo := mock_repository.NewMockIArticleRepository(ctrl)
+	authorRepo := mock_repository.NewMockIAuthorRepository(ctrl)
+	articleSearch := mock_search.NewMockIArticleSearch(ctrl)
+
+	article := factory.SampleArticle1
+	deletedAt := time.Now()
+	article.DeletedAt = &deletedAt
+	articleRepo.EXPECT().Get(gomock.Any(), article.ID).Return(&article, nil)
+
+	svc := ArticleSvc{
+		articleRepo:   articleRepo,
+		authorRepo:    authorRepo,
+		articleSearch: articleSearch,
+	}
+
+	result, err := svc.GetArticle(context.Background(), article.ID)
+	assert.Nil(t, result)
+	assert.Equal(t, apperror.ErrObjectNotExists, err)
+}
+
+func Test_DeleteArticle_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	db_client.InitDatabaseMockExpectingTxn(ctrl, true, true)
+
+	article := factory.SampleArticle1
+
+	articleRepo := mock_repository.NewMockIArticleRepository(ctrl)
+	authorRepo := mock_repository.NewMockIAuthorRepository(ctrl)
+	outboxEventRepo := mock_repository.NewMockIOutboxEventRepository(ctrl)
+
+	articleRepo.EXPECT().Get(gomock.Any(), article.ID).Return(&article, nil)
+	articleRepo.EXPECT().Delete(gomock.Any(), article.ID).Return(nil)
+	outboxEventRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+
+	svc := ArticleSvc{
+		articleRepo:     articleRepo,
+		authorRepo:      authorRepo,
+		outboxEventRepo: outboxEventRepo,
+	}
+	err := svc.DeleteArticle(context.Background(), article.ID)
+	assert.Nil(t, err)
+}
+
+func Test_DeleteArticle_ReturnErr_WhenArticleAlreadyDeleted(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	db_client.InitDatabaseMockExpectingTxn(ctrl, true, nil)
+
+	article := factory.SampleArticle1
+	deletedAt := time.Now()
+	article.DeletedAt = &deletedAt
+
+	articleRepo := mock_repository.NewMockIArticleRepository(ctrl)
+	authorRepo := mock_repository.NewMockIAuthorRepository(ctrl)
+	articleSearch := mock_search.NewMockIArticleSearch(ctrl)
+
+	articleRepo.EXPECT().Get(gomock.Any(), article.ID).Return(&article, nil)
+
+	svc := ArticleSvc{
+		articleRepo:   articleRepo,
+		authorRepo:    authorRepo,
+		articleSearch: articleSearch,
+	}
+	err := svc.DeleteArticle(context.Background(), article.ID)
+	assert.Equal(t, apperror.ErrObjectNotExists, err)
+}
+
+func Test_DeleteArticle_ReturnErr_WhenEnqueueSearchSyncFailed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	db_client.InitDatabaseMockExpectingTxn(ctrl, true, nil)
+
+	article := factory.SampleArticle1
+
+	articleRepo := mock_repository.NewMockIArticleRepository(ctrl)
+	authorRepo := mock_repository.NewMockIAuthorRepository(ctrl)
+	outboxEventRepo := mock_repository.NewMockIOutboxEventRepository(ctrl)
+
+	articleRepo.EXPECT().Get(gomock.Any(), article.ID).Return(&article, nil)
+	articleRepo.EXPECT().Delete(gomock.Any(), article.ID).Return(nil)
+	outboxEventRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(apperror.ErrCreateRecordFailed)
+
+	svc := ArticleSvc{
+		articleRepo:     articleRepo,
+		authorRepo:      authorRepo,
+		outboxEventRepo: outboxEventRepo,
+	}
+	err := svc.DeleteArticle(context.Background(), article.ID)
+	assert.Equal(t, apperror.ErrCreateRecordFailed, err)
+}
+
+func Test_RestoreArticle_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	db_client.InitDatabaseMockExpectingTxn(ctrl, true, true)
+
+	article := factory.SampleArticle1
+	deletedAt := time.Now()
+	article.DeletedAt = &deletedAt
+
+	articleRepo := mock_repository.NewMockIArticleRepository(ctrl)
+	authorRepo := mock_repository.NewMockIAuthorRepository(ctrl)
+	outboxEventRepo := mock_repository.NewMockIOutboxEventRepository(ctrl)
+
+	articleRepo.EXPECT().Get(gomock.Any(), article.ID).Return(&article, nil)
+	articleRepo.EXPECT().Restore(gomock.Any(), article.ID).Return(nil)
+	outboxEventRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+
+	svc := ArticleSvc{
+		articleRepo:     articleRepo,
+		authorRepo:      authorRepo,
+		outboxEventRepo: outboxEventRepo,
+	}
+	result, err := svc.RestoreArticle(context.Background(), article.ID)
+	assert.Nil(t, err)
+	assert.Nil(t, result.DeletedAt)
+}
+
+func Test_RestoreArticle_ReturnErr_WhenArticleNotDeleted(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	db_client.InitDatabaseMockExpectingTxn(ctrl, true, nil)
+
+	article := factory.SampleArticle1
+
+	articleRepo := mock_repository.NewMockIArticleRepository(ctrl)
+	authorRepo := mock_repository.NewMockIAuthorRepository(ctrl)
+	articleSearch := mock_search.NewMockIArticleSearch(ctrl)
+
+	articleRepo.EXPECT().Get(gomock.Any(), article.ID).Return(&article, nil)
+
+	svc := ArticleSvc{
+		articleRepo:   articleRepo,
+		authorRepo:    authorRepo,
+		articleSearch: articleSearch,
+	}
+	result, err := svc.RestoreArticle(context.Background(), article.ID)
+	assert.Nil(t, result)
+	assert.Equal(t, apperror.ErrArticleNotDeleted, err)
+}
+
+func Test_PublishArticle_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	db_client.InitDatabaseMockExpectingTxn(ctrl, true, true)
+
+	article := factory.SampleArticle1
+	article.Status = model.ArticleStatusDraft
+	article.PublishedAt = nil
+
+	articleRepo := mock_repository.NewMockIArticleRepository(ctrl)
+	authorRepo := mock_repository.NewMockIAuthorRepository(ctrl)
+	outboxEventRepo := mock_repository.NewMockIOutboxEventRepository(ctrl)
+
+	articleRepo.EXPECT().Get(gomock.Any(), article.ID).Return(&article, nil)
+	articleRepo.EXPECT().UpdateStatus(gomock.Any(), gomock.Any()).Return(nil)
+	outboxEventRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+
+	svc := ArticleSvc{
+		articleRepo:     articleRepo,
+		authorRepo:      authorRepo,
+		outboxEventRepo: outboxEventRepo,
+	}
+	result, err := svc.PublishArticle(context.Background(), article.ID)
+	assert.Nil(t, err)
+	assert.Equal(t, model.ArticleStatusPublished, result.Status)
+	assert.NotNil(t, result.PublishedAt)
+}
+
+func Test_PublishArticle_KeepPublishedAt_WhenRepublishingArchivedArticle(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	db_client.InitDatabaseMockExpectingTxn(ctrl, true, true)
+
+	article := factory.SampleArticle1
+	article.Status = model.ArticleStatusArchived
+
+	articleRepo := mock_repository.NewMockIArticleRepository(ctrl)
+	authorRepo := mock_repository.NewMockIAuthorRepository(ctrl)
+	outboxEventRepo := mock_repository.NewMockIOutboxEventRepository(ctrl)
+
+	articleRepo.EXPECT().Get(gomock.Any(), article.ID).Return(&article, nil)
+	articleRepo.EXPECT().UpdateStatus(gomock.Any(), gomock.Any()).Return(nil)
+	outboxEventRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+
+	svc := ArticleSvc{
+		articleRepo:     articleRepo,
+		authorRepo:      authorRepo,
+		outboxEventRepo: outboxEventRepo,
+	}
+	result, err := svc.PublishArticle(context.Background(), article.ID)
+	assert.Nil(t, err)
+	assert.Equal(t, factory.SampleArticle1.PublishedAt, result.PublishedAt)
+}
+
+func Test_PublishArticle_ReturnErr_WhenAlreadyPublished(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	db_client.InitDatabaseMockExpectingTxn(ctrl, true, nil)
+
+	article := factory.SampleArticle1
+
+	articleRepo := mock_repository.NewMockIArticleRepository(ctrl)
+	authorRepo := mock_repository.NewMockIAuthorRepository(ctrl)
+	articleSearch := mock_search.NewMockIArticleSearch(ctrl)
+
+	articleRepo.EXPECT().Get(gomock.Any(), article.ID).Return(&article, nil)
+
+	svc := ArticleSvc{
+		articleRepo:   articleRepo,
+		authorRepo:    authorRepo,
+		articleSearch: articleSearch,
+	}
+	result, err := svc.PublishArticle(context.Background(), article.ID)
+	assert.Nil(t, result)
+	assert.Equal(t, apperror.ErrInvalidStatusTransition, err)
+}
+
+func Test_PublishArticle_ReturnErr_WhenArticleIsDeleted(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	db_client.InitDatabaseMockExpectingTxn(ctrl, true, nil)
+
+	article := factory.SampleArticle1
+	article.Status = model.ArticleStatusDraft
+	deletedAt := time.Now()
+	article.DeletedAt = &deletedAt
+
+	articleRepo := mock_repository.NewMockIArticleRepository(ctrl)
+	authorRepo := mock_repository.NewMockIAuthorRepository(ctrl)
+	articleSearch := mock_search.NewMockIArticleSearch(ctrl)
+
+	articleRepo.EXPECT().Get(gomock.Any(), article.ID).Return(&article, nil)
+
+	svc := ArticleSvc{
+		articleRepo:   articleRepo,
+		authorRepo:    authorRepo,
+		articleSearch: articleSearch,
+	}
+	result, err := svc.PublishArticle(context.Background(), article.ID)
+	assert.Nil(t, result)
+	assert.Equal(t, apperror.ErrObjectNotExists, err)
+}
+
+func Test_ArchiveArticle_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	db_client.InitDatabaseMockExpectingTxn(ctrl, true, true)
+
+	article := factory.SampleArticle1
+
+	articleRepo := mock_repository.NewMockIArticleRepository(ctrl)
+	authorRepo := mock_repository.NewMockIAuthorRepository(ctrl)
+	outboxEventRepo := mock_repository.NewMockIOutboxEventRepository(ctrl)
+
+	articleRepo.EXPECT().Get(gomock.Any(), article.ID).Return(&article, nil)
+	articleRepo.EXPECT().UpdateStatus(gomock.Any(), gomock.Any()).Return(nil)
+	outboxEventRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+
+	svc := ArticleSvc{
+		articleRepo:     articleRepo,
+		authorRepo:      authorRepo,
+		outboxEventRepo: outboxEventRepo,
+	}
+	result, err := svc.ArchiveArticle(context.Background(), article.ID)
+	assert.Nil(t, err)
+	assert.Equal(t, model.ArticleStatusArchived, result.Status)
+}
+
+func Test_ArchiveArticle_ReturnErr_WhenAlreadyArchived(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	db_client.InitDatabaseMockExpectingTxn(ctrl, true, nil)
+
+	article := factory.SampleArticle1
+	article.Status = model.ArticleStatusArchived
+
+	articleRepo := mock_repository.NewMockIArticleRepository(ctrl)
+	authorRepo := mock_repository.NewMockIAuthorRepository(ctrl)
+	articleSearch := mock_search.NewMockIArticleSearch(ctrl)
+
+	articleRepo.EXPECT().Get(gomock.Any(), article.ID).Return(&article, nil)
+
+	svc := ArticleSvc{
+		articleRepo:   articleRepo,
+		authorRepo:    authorRepo,
+		articleSearch: articleSearch,
+	}
+	result, err := svc.ArchiveArticle(context.Background(), article.ID)
+	assert.Nil(t, result)
+	assert.Equal(t, apperror.ErrInvalidStatusTransition, err)
+}
+
+func Test_UpdateArticle_DoesNotIndex_WhenArticleIsDraft(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	db_client.InitDatabaseMockExpectingTxn(ctrl, true, true)
+
+	article := factory.SampleArticle1
+	article.Status = model.ArticleStatusDraft
+	article.PublishedAt = nil
+	title := "Draft title"
+	dto := v1req.PatchArticleDTO{Title: &title}
+
+	articleRepo := mock_repository.NewMockIArticleRepository(ctrl)
+	authorRepo := mock_repository.NewMockIAuthorRepository(ctrl)
+	outboxEventRepo := mock_repository.NewMockIOutboxEventRepository(ctrl)
+	articleRevisionRepo := mock_repository.NewMockIArticleRevisionRepository(ctrl)
+	articleRevisionRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+
+	articleRepo.EXPECT().GetForUpdate(gomock.Any(), article.ID).Return(&article, nil)
+	articleSlugRepo := mock_repository.NewMockIArticleSlugRepository(ctrl)
+	articleSlugRepo.EXPECT().ListTaken(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+	articleSlugRepo.EXPECT().Create(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+	articleRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+	outboxEventRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+
+	svc := ArticleSvc{
+		articleRepo:         articleRepo,
+		articleSlugRepo:     articleSlugRepo,
+		authorRepo:          authorRepo,
+		articleRevisionRepo: articleRevisionRepo,
+		outboxEventRepo:     outboxEventRepo,
+	}
+	result, err := svc.PatchArticle(context.Background(), article.ID, dto)
+	assert.Nil(t, err)
+	assert.Equal(t, title, result.Title)
+}
+
+func Test_ScheduleArticle_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	db_client.InitDatabaseMockExpectingTxn(ctrl, true, true)
+
+	article := factory.SampleArticle1
+	article.Status = model.ArticleStatusDraft
+	article.PublishedAt = nil
+	dto := v1req.ScheduleArticleDTO{PublishAt: time.Now().Add(time.Hour)}
+
+	articleRepo := mock_repository.NewMockIArticleRepository(ctrl)
+	authorRepo := mock_repository.NewMockIAuthorRepository(ctrl)
+	articleSearch := mock_search.NewMockIArticleSearch(ctrl)
+
+	articleRepo.EXPECT().Get(gomock.Any(), article.ID).Return(&article, nil)
+	articleRepo.EXPECT().UpdateStatus(gomock.Any(), gomock.Any()).Return(nil)
+
+	svc := ArticleSvc{
+		articleRepo:   articleRepo,
+		authorRepo:    authorRepo,
+		articleSearch: articleSearch,
+	}
+	result, err := svc.ScheduleArticle(context.Background(), article.ID, dto)
+	assert.Nil(t, err)
+	assert.Equal(t, model.ArticleStatusDraft, result.Status)
+	assert.Equal(t, dto.PublishAt, *result.PublishAt)
+}
+
+func Test_ScheduleArticle_ReturnErr_WhenArticleIsNotDraft(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	db_client.InitDatabaseMockExpectingTxn(ctrl, true, nil)
+
+	article := factory.SampleArticle1
+	dto := v1req.ScheduleArticleDTO{PublishAt: time.Now().Add(time.Hour)}
+
+	articleRepo := mock_repository.NewMockIArticleRepository(ctrl)
+	authorRepo := mock_repository.NewMockIAuthorRepository(ctrl)
+	articleSearch := mock_search.NewMockIArticleSearch(ctrl)
+
+	articleRepo.EXPECT().Get(gomock.Any(), article.ID).Return(&article, nil)
+
+	svc := ArticleSvc{
+		articleRepo:   articleRepo,
+		authorRepo:    authorRepo,
+		articleSearch: articleSearch,
+	}
+	result, err := svc.ScheduleArticle(context.Background(), article.ID, dto)
+	assert.Nil(t, result)
+	assert.Equal(t, apperror.ErrArticleNotDraft, err)
+}
+
+func Test_PublishDueArticles_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	db_client.InitDatabaseMockExpectingTxn(ctrl, true, true)
+
+	publishAt := time.Now().Add(-time.Minute)
+	article := factory.SampleArticle1
+	article.Status = model.ArticleStatusDraft
+	article.PublishedAt = nil
+	article.PublishAt = &publishAt
+
+	articleRepo := mock_repository.NewMockIArticleRepository(ctrl)
+	authorRepo := mock_repository.NewMockIAuthorRepository(ctrl)
+	outboxEventRepo := mock_repository.NewMockIOutboxEventRepository(ctrl)
+
+	articleRepo.EXPECT().ListDueForPublishing(gomock.Any(), gomock.Any(), 10).Return([]*model.Article{&article}, nil)
+	articleRepo.EXPECT().UpdateStatus(gomock.Any(), &article).Return(nil)
+	outboxEventRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+
+	svc := ArticleSvc{
+		articleRepo:     articleRepo,
+		authorRepo:      authorRepo,
+		outboxEventRepo: outboxEventRepo,
+	}
+	published, err := svc.PublishDueArticles(context.Background(), 10)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, published)
+	assert.Equal(t, model.ArticleStatusPublished, article.Status)
+	assert.Equal(t, &publishAt, article.PublishedAt)
+	assert.Nil(t, article.PublishAt)
+}
+
+func Test_PublishDueArticles_ReturnErr_WhenEnqueueSearchSyncFailed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	db_client.InitDatabaseMockExpectingTxn(ctrl, true, nil)
+
+	publishAt := time.Now().Add(-time.Minute)
+	article := factory.SampleArticle1
+	article.Status = model.ArticleStatusDraft
+	article.PublishAt = &publishAt
+
+	articleRepo := mock_repository.NewMockIArticleRepository(ctrl)
+	authorRepo := mock_repository.NewMockIAuthorRepository(ctrl)
+	outboxEventRepo := mock_repository.NewMockIOutboxEventRepository(ctrl)
+
+	articleRepo.EXPECT().ListDueForPublishing(gomock.Any(), gomock.Any(), 10).Return([]*model.Article{&article}, nil)
+	articleRepo.EXPECT().UpdateStatus(gomock.Any(), &article).Return(nil)
+	outboxEventRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(apperror.ErrCreateRecordFailed)
+
+	svc := ArticleSvc{
+		articleRepo:     articleRepo,
+		authorRepo:      authorRepo,
+		outboxEventRepo: outboxEventRepo,
+	}
+	published, err := svc.PublishDueArticles(context.Background(), 10)
+	assert.Equal(t, 0, published)
+	assert.Equal(t, apperror.ErrCreateRecordFailed, err)
+}
+
+func Test_UpdateArticle_ReturnErr_WhenCreateRevisionFailed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	db_client.InitDatabaseMockExpectingTxn(ctrl, true, nil)
+
+	article := factory.SampleArticle1
+	title := "New title"
+	dto := v1req.PatchArticleDTO{Title: &title}
+
+	articleRepo := mock_repository.NewMockIArticleRepository(ctrl)
+	authorRepo := mock_repository.NewMockIAuthorRepository(ctrl)
+	articleSearch := mock_search.NewMockIArticleSearch(ctrl)
+	articleRevisionRepo := mock_repository.NewMockIArticleRevisionRepository(ctrl)
+
+	articleRepo.EXPECT().GetForUpdate(gomock.Any(), article.ID).Return(&article, nil)
+	articleSlugRepo := mock_repository.NewMockIArticleSlugRepository(ctrl)
+	articleSlugRepo.EXPECT().ListTaken(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+	articleSlugRepo.EXPECT().Create(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+	articleRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+	articleRevisionRepo.EXPECT().Create(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, revision *model.ArticleRevision) error {
+			assert.Equal(t, article.ID, revision.ArticleID)
+			assert.Equal(t, title, revision.Title)
+			return apperror.ErrCreateRecordFailed
+		})
+
+	svc := ArticleSvc{
+		articleRepo:         articleRepo,
+		articleSlugRepo:     articleSlugRepo,
+		authorRepo:          authorRepo,
+		articleRevisionRepo: articleRevisionRepo,
+		articleSearch:       articleSearch,
+	}
+	result, err := svc.PatchArticle(context.Background(), article.ID, dto)
+	assert.Nil(t, result)
+	assert.Equal(t, apperror.ErrCreateRecordFailed, err)
 }
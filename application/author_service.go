@@ -0,0 +1,223 @@
+package application
+
+import (
+	"context"
+
+	"article-service/apperror"
+	"article-service/db/db_client"
+	"article-service/db/repository"
+	v1req "article-service/dto/request/v1_req"
+	"article-service/infrastructure/log"
+	"article-service/model"
+	"article-service/utils"
+
+	"github.com/google/uuid"
+)
+
+//go:generate mockgen -source=author_service.go -destination=./mock_application/author_service_mock.go
+type IAuthorService interface {
+	CreateAuthor(ctx context.Context, dto v1req.CreateAuthorDTO) (uuid.UUID, error)
+	GetAuthor(ctx context.Context, id uuid.UUID) (*model.Author, error)
+	ListAuthors(ctx context.Context, dto v1req.ListAuthorsDTO) ([]*model.Author, int64, error)
+	UpdateAuthor(ctx context.Context, id uuid.UUID, dto v1req.UpdateAuthorDTO) (*model.Author, error)
+	DeleteAuthor(ctx context.Context, id uuid.UUID) error
+	ListAuthorArticles(ctx context.Context, dto v1req.ListAuthorArticlesDTO) ([]*model.Article, int64, error)
+}
+
+type AuthorSvc struct {
+	authorRepo      repository.IAuthorRepository
+	articleRepo     repository.IArticleRepository
+	outboxEventRepo repository.IOutboxEventRepository
+}
+
+var authorSvcSingleton IAuthorService
+
+func InitAuthorService() {
+	authorSvcSingleton = AuthorSvc{
+		repository.GetAuthorRepository(),
+		repository.GetArticleRepository(),
+		repository.GetOutboxEventRepository(),
+	}
+}
+
+func GetAuthorService() IAuthorService {
+	return authorSvcSingleton
+}
+
+func (svc AuthorSvc) CreateAuthor(ctx context.Context, dto v1req.CreateAuthorDTO) (uuid.UUID, error) {
+	author := model.Author{
+		ID:        utils.GenerateUUID(),
+		Name:      dto.Name,
+		Handle:    dto.Handle,
+		Bio:       dto.Bio,
+		AvatarURL: dto.AvatarURL,
+		Website:   dto.Website,
+	}
+
+	err := svc.authorRepo.Create(ctx, &author)
+	if err != nil {
+		log.Errorf(ctx, err, "[AuthorSvc][CreateAuthor] authorRepo.Create is failed, author: %v", author)
+		return uuid.Nil, err
+	}
+
+	return author.ID, nil
+}
+
+func (svc AuthorSvc) GetAuthor(ctx context.Context, id uuid.UUID) (*model.Author, error) {
+	author, err := svc.authorRepo.Get(ctx, id)
+	if err != nil {
+		log.Errorf(ctx, err, "[AuthorSvc][GetAuthor] authorRepo.Get is failed, id: %s", id)
+		return nil, err
+	}
+
+	return author, nil
+}
+
+func (svc AuthorSvc) ListAuthors(ctx context.Context, dto v1req.ListAuthorsDTO) ([]*model.Author, int64, error) {
+	limit := utils.SetLimit(dto.Limit)
+
+	filter := repository.AuthorFilter{
+		Name:   dto.Name,
+		Limit:  limit,
+		Offset: utils.SetOffset(dto.Page, limit),
+	}
+
+	authors, err := svc.authorRepo.List(ctx, filter)
+	if err != nil {
+		log.Errorf(ctx, err, "[AuthorSvc][ListAuthors] authorRepo.List is failed")
+		return nil, 0, err
+	}
+
+	recordsCount, err := svc.authorRepo.GetRecordsCount(ctx, filter)
+	if err != nil {
+		log.Errorf(ctx, err, "[AuthorSvc][ListAuthors] authorRepo.GetRecordsCount is failed")
+		return nil, 0, err
+	}
+
+	return authors, recordsCount, nil
+}
+
+func (svc AuthorSvc) UpdateAuthor(ctx context.Context, id uuid.UUID, dto v1req.UpdateAuthorDTO) (*model.Author, error) {
+	ctx, txn, err := db_client.StartTransactionCtx(ctx)
+	if err != nil {
+		log.Errorf(ctx, err, "[AuthorSvc][UpdateAuthor] failed to start transaction")
+		return nil, apperror.ErrStartTransactionFailed
+	}
+	defer txn.Rollback(ctx)
+
+	author, err := svc.authorRepo.Get(ctx, id)
+	if err != nil {
+		log.Errorf(ctx, err, "[AuthorSvc][UpdateAuthor] authorRepo.Get is failed, id: %s", id)
+		return nil, err
+	}
+
+	nameChanged := dto.Name != nil && *dto.Name != author.Name
+	if dto.Name != nil {
+		author.Name = *dto.Name
+	}
+	if dto.Handle != nil {
+		author.Handle = *dto.Handle
+	}
+	if dto.Bio != nil {
+		author.Bio = *dto.Bio
+	}
+	if dto.AvatarURL != nil {
+		author.AvatarURL = *dto.AvatarURL
+	}
+	if dto.Website != nil {
+		author.Website = *dto.Website
+	}
+
+	err = svc.authorRepo.Update(ctx, author)
+	if err != nil {
+		log.Errorf(ctx, err, "[AuthorSvc][UpdateAuthor] authorRepo.Update is failed, author: %v", author)
+		return nil, err
+	}
+
+	// the author name is part of every indexed article, so renaming has to reindex them
+	if nameChanged {
+		err = svc.reindexArticles(ctx, author.ID)
+		if err != nil {
+			log.Errorf(ctx, err, "[AuthorSvc][UpdateAuthor] reindexArticles is failed, author: %v", author)
+			return nil, err
+		}
+	}
+
+	if err = txn.Commit(ctx); err != nil {
+		log.Errorf(ctx, err, "[AuthorSvc][UpdateAuthor] txn.Commit is failed!")
+		return nil, apperror.ErrCommitTransactionFailed
+	}
+
+	return author, nil
+}
+
+func (svc AuthorSvc) DeleteAuthor(ctx context.Context, id uuid.UUID) error {
+	err := svc.authorRepo.Delete(ctx, id)
+	if err != nil {
+		log.Errorf(ctx, err, "[AuthorSvc][DeleteAuthor] authorRepo.Delete is failed, id: %s", id)
+		return err
+	}
+
+	return nil
+}
+
+func (svc AuthorSvc) ListAuthorArticles(ctx context.Context, dto v1req.ListAuthorArticlesDTO) ([]*model.Article, int64, error) {
+	author, err := svc.authorRepo.GetByHandle(ctx, dto.Handle)
+	if err != nil {
+		log.Errorf(ctx, err, "[AuthorSvc][ListAuthorArticles] authorRepo.GetByHandle is failed, handle: %s", dto.Handle)
+		return nil, 0, err
+	}
+
+	limit := utils.SetLimit(dto.Limit)
+
+	filter := repository.ArticleFilter{
+		AuthorID:      author.ID,
+		Status:        model.ArticleStatusPublished,
+		SortBy:        dto.SortBy,
+		SortDirection: dto.SortDirection,
+		Limit:         limit,
+		Offset:        utils.SetOffset(dto.Page, limit),
+	}
+
+	articles, err := svc.articleRepo.List(ctx, filter)
+	if err != nil {
+		log.Errorf(ctx, err, "[AuthorSvc][ListAuthorArticles] articleRepo.List is failed, authorId: %s", author.ID)
+		return nil, 0, err
+	}
+
+	recordsCount, err := svc.articleRepo.GetRecordsCount(ctx, filter)
+	if err != nil {
+		log.Errorf(ctx, err, "[AuthorSvc][ListAuthorArticles] articleRepo.GetRecordsCount is failed, authorId: %s", author.ID)
+		return nil, 0, err
+	}
+
+	return articles, recordsCount, nil
+}
+
+func (svc AuthorSvc) reindexArticles(ctx context.Context, authorID uuid.UUID) error {
+	filter := repository.ArticleFilter{
+		AuthorID: authorID,
+		Status:   model.ArticleStatusPublished,
+		Limit:    utils.MaxPageLimit,
+	}
+
+	for {
+		articles, err := svc.articleRepo.List(ctx, filter)
+		if err != nil {
+			return err
+		}
+
+		for _, article := range articles {
+			event := newArticleChangedEvent(article.ID)
+			err = svc.outboxEventRepo.Create(ctx, &event)
+			if err != nil {
+				return err
+			}
+		}
+
+		if len(articles) < filter.Limit {
+			return nil
+		}
+		filter.Offset += filter.Limit
+	}
+}
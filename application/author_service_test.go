@@ -0,0 +1,303 @@
+package application
+
+import (
+	"article-service/apperror"
+	"article-service/db/db_client"
+	"article-service/db/repository"
+	"article-service/db/repository/mock_repository"
+	v1req "article-service/dto/request/v1_req"
+	"article-service/factory"
+	"article-service/model"
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_GetAuthorService(t *testing.T) {
+	svc := GetAuthorService()
+	assert.Nil(t, svc)
+
+	InitAuthorService()
+
+	svc = GetAuthorService()
+	assert.NotNil(t, svc)
+}
+
+func Test_CreateAuthor_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	db_client.InitDatabaseMock()
+
+	dto := v1req.CreateAuthorDTO{Name: "New Author", Handle: "new-author"}
+
+	authorRepo := mock_repository.NewMockIAuthorRepository(ctrl)
+	authorRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+
+	svc := AuthorSvc{authorRepo: authorRepo}
+	id, err := svc.CreateAuthor(context.Background(), dto)
+	assert.NotEqual(t, uuid.Nil, id)
+	assert.Nil(t, err)
+}
+
+func Test_CreateAuthor_ReturnErr_WhenCreateAuthorFailed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	db_client.InitDatabaseMock()
+
+	dto := v1req.CreateAuthorDTO{Name: "New Author", Handle: "new-author"}
+
+	authorRepo := mock_repository.NewMockIAuthorRepository(ctrl)
+	authorRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(apperror.ErrCreateRecordFailed)
+
+	svc := AuthorSvc{authorRepo: authorRepo}
+	id, err := svc.CreateAuthor(context.Background(), dto)
+	assert.Equal(t, uuid.Nil, id)
+	assert.Equal(t, apperror.ErrCreateRecordFailed, err)
+}
+
+func Test_GetAuthor_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	db_client.InitDatabaseMock()
+
+	author := factory.SampleAuthorChandra
+
+	authorRepo := mock_repository.NewMockIAuthorRepository(ctrl)
+	authorRepo.EXPECT().Get(gomock.Any(), author.ID).Return(&author, nil)
+
+	svc := AuthorSvc{authorRepo: authorRepo}
+	result, err := svc.GetAuthor(context.Background(), author.ID)
+	assert.Equal(t, &author, result)
+	assert.Nil(t, err)
+}
+
+func Test_ListAuthors_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	db_client.InitDatabaseMock()
+
+	dto := v1req.ListAuthorsDTO{Name: "Chandra", Limit: 10, Page: 2}
+	mockAuthors := []*model.Author{&factory.SampleAuthorChandra}
+	expectedFilter := repository.AuthorFilter{
+		Name:   dto.Name,
+		Limit:  10,
+		Offset: 10,
+	}
+
+	authorRepo := mock_repository.NewMockIAuthorRepository(ctrl)
+	authorRepo.EXPECT().List(gomock.Any(), expectedFilter).Return(mockAuthors, nil)
+	authorRepo.EXPECT().GetRecordsCount(gomock.Any(), expectedFilter).Return(int64(11), nil)
+
+	svc := AuthorSvc{authorRepo: authorRepo}
+	authors, recordsCount, err := svc.ListAuthors(context.Background(), dto)
+	assert.Equal(t, mockAuthors, authors)
+	assert.Equal(t, int64(11), recordsCount)
+	assert.Nil(t, err)
+}
+
+func Test_ListAuthors_ReturnErr_WhenListAuthorsFailed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	db_client.InitDatabaseMock()
+
+	authorRepo := mock_repository.NewMockIAuthorRepository(ctrl)
+	authorRepo.EXPECT().List(gomock.Any(), gomock.Any()).Return(nil, apperror.ErrGetRecordFailed)
+
+	svc := AuthorSvc{authorRepo: authorRepo}
+	authors, recordsCount, err := svc.ListAuthors(context.Background(), v1req.ListAuthorsDTO{})
+	assert.Nil(t, authors)
+	assert.Equal(t, int64(0), recordsCount)
+	assert.Equal(t, apperror.ErrGetRecordFailed, err)
+}
+
+func Test_UpdateAuthor_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	db_client.InitDatabaseMockExpectingTxn(ctrl, true, true)
+
+	author := factory.SampleAuthorChandra
+	name := "Chandra Phang"
+	bio := "Writes about Go"
+	dto := v1req.UpdateAuthorDTO{Name: &name, Bio: &bio}
+
+	authorRepo := mock_repository.NewMockIAuthorRepository(ctrl)
+	authorRepo.EXPECT().Get(gomock.Any(), author.ID).Return(&author, nil)
+	authorRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+
+	article := factory.SampleArticle1
+	expectedFilter := repository.ArticleFilter{
+		AuthorID: author.ID,
+		Status:   model.ArticleStatusPublished,
+		Limit:    100,
+	}
+	articleRepo := mock_repository.NewMockIArticleRepository(ctrl)
+	articleRepo.EXPECT().List(gomock.Any(), expectedFilter).Return([]*model.Article{&article}, nil)
+	outboxEventRepo := mock_repository.NewMockIOutboxEventRepository(ctrl)
+	outboxEventRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, event *model.OutboxEvent) error {
+		assert.Equal(t, article.ID, event.AggregateID)
+		assert.Equal(t, model.OutboxEventArticleChanged, event.EventType)
+		return nil
+	})
+
+	svc := AuthorSvc{
+		authorRepo:      authorRepo,
+		articleRepo:     articleRepo,
+		outboxEventRepo: outboxEventRepo,
+	}
+	result, err := svc.UpdateAuthor(context.Background(), author.ID, dto)
+	assert.Nil(t, err)
+	assert.Equal(t, name, result.Name)
+	assert.Equal(t, bio, result.Bio)
+	assert.Equal(t, factory.SampleAuthorChandra.Handle, result.Handle)
+}
+
+func Test_UpdateAuthor_Success_WithoutReindex_WhenNameUnchanged(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	db_client.InitDatabaseMockExpectingTxn(ctrl, true, true)
+
+	author := factory.SampleAuthorChandra
+	name := author.Name
+	bio := "Writes about Go"
+	dto := v1req.UpdateAuthorDTO{Name: &name, Bio: &bio}
+
+	authorRepo := mock_repository.NewMockIAuthorRepository(ctrl)
+	authorRepo.EXPECT().Get(gomock.Any(), author.ID).Return(&author, nil)
+	authorRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+
+	svc := AuthorSvc{authorRepo: authorRepo}
+	result, err := svc.UpdateAuthor(context.Background(), author.ID, dto)
+	assert.Nil(t, err)
+	assert.Equal(t, bio, result.Bio)
+}
+
+func Test_UpdateAuthor_ReturnErr_WhenReindexFailed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	db_client.InitDatabaseMockExpectingTxn(ctrl, true, nil)
+
+	author := factory.SampleAuthorChandra
+	name := "Chandra Phang"
+	dto := v1req.UpdateAuthorDTO{Name: &name}
+
+	authorRepo := mock_repository.NewMockIAuthorRepository(ctrl)
+	authorRepo.EXPECT().Get(gomock.Any(), author.ID).Return(&author, nil)
+	authorRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+
+	article := factory.SampleArticle1
+	articleRepo := mock_repository.NewMockIArticleRepository(ctrl)
+	articleRepo.EXPECT().List(gomock.Any(), gomock.Any()).Return([]*model.Article{&article}, nil)
+	outboxEventRepo := mock_repository.NewMockIOutboxEventRepository(ctrl)
+	outboxEventRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(apperror.ErrCreateRecordFailed)
+
+	svc := AuthorSvc{
+		authorRepo:      authorRepo,
+		articleRepo:     articleRepo,
+		outboxEventRepo: outboxEventRepo,
+	}
+	result, err := svc.UpdateAuthor(context.Background(), author.ID, dto)
+	assert.Nil(t, result)
+	assert.Equal(t, apperror.ErrCreateRecordFailed, err)
+}
+
+func Test_UpdateAuthor_ReturnErr_WhenAuthorNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	db_client.InitDatabaseMockExpectingTxn(ctrl, true, nil)
+
+	authorID := factory.SampleAuthorChandra.ID
+	name := "Chandra Phang"
+	dto := v1req.UpdateAuthorDTO{Name: &name}
+
+	authorRepo := mock_repository.NewMockIAuthorRepository(ctrl)
+	authorRepo.EXPECT().Get(gomock.Any(), authorID).Return(nil, apperror.ErrObjectNotExists)
+
+	svc := AuthorSvc{authorRepo: authorRepo}
+	result, err := svc.UpdateAuthor(context.Background(), authorID, dto)
+	assert.Nil(t, result)
+	assert.Equal(t, apperror.ErrObjectNotExists, err)
+}
+
+func Test_UpdateAuthor_ReturnErr_WhenCommitTransactionFailed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	db_client.InitDatabaseMockExpectingTxn(ctrl, true, false)
+
+	author := factory.SampleAuthorChandra
+	bio := "Writes about Go"
+	dto := v1req.UpdateAuthorDTO{Bio: &bio}
+
+	authorRepo := mock_repository.NewMockIAuthorRepository(ctrl)
+	authorRepo.EXPECT().Get(gomock.Any(), author.ID).Return(&author, nil)
+	authorRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+
+	svc := AuthorSvc{authorRepo: authorRepo}
+	result, err := svc.UpdateAuthor(context.Background(), author.ID, dto)
+	assert.Nil(t, result)
+	assert.Equal(t, apperror.ErrCommitTransactionFailed, err)
+}
+
+func Test_DeleteAuthor_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	db_client.InitDatabaseMock()
+
+	authorID := factory.SampleAuthorChandra.ID
+
+	authorRepo := mock_repository.NewMockIAuthorRepository(ctrl)
+	authorRepo.EXPECT().Delete(gomock.Any(), authorID).Return(nil)
+
+	svc := AuthorSvc{authorRepo: authorRepo}
+	err := svc.DeleteAuthor(context.Background(), authorID)
+	assert.Nil(t, err)
+}
+
+func Test_DeleteAuthor_ReturnErr_WhenAuthorHasArticles(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	db_client.InitDatabaseMock()
+
+	authorID := factory.SampleAuthorChandra.ID
+
+	authorRepo := mock_repository.NewMockIAuthorRepository(ctrl)
+	authorRepo.EXPECT().Delete(gomock.Any(), authorID).Return(apperror.ErrAuthorHasArticles)
+
+	svc := AuthorSvc{authorRepo: authorRepo}
+	err := svc.DeleteAuthor(context.Background(), authorID)
+	assert.Equal(t, apperror.ErrAuthorHasArticles, err)
+}
+
+func Test_ListAuthorArticles_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	db_client.InitDatabaseMock()
+
+	author := factory.SampleAuthorChandra
+	dto := v1req.ListAuthorArticlesDTO{Handle: author.Handle, Limit: 10, Page: 2}
+	mockArticles := []*model.Article{&factory.SampleArticle1}
+	expectedFilter := repository.ArticleFilter{
+		AuthorID: author.ID,
+		Status:   model.ArticleStatusPublished,
+		Limit:    10,
+		Offset:   10,
+	}
+
+	authorRepo := mock_repository.NewMockIAuthorRepository(ctrl)
+	authorRepo.EXPECT().GetByHandle(gomock.Any(), author.Handle).Return(&author, nil)
+	articleRepo := mock_repository.NewMockIArticleRepository(ctrl)
+	articleRepo.EXPECT().List(gomock.Any(), expectedFilter).Return(mockArticles, nil)
+	articleRepo.EXPECT().GetRecordsCount(gomock.Any(), expectedFilter).Return(int64(11), nil)
+
+	svc := AuthorSvc{authorRepo: authorRepo, articleRepo: articleRepo}
+	articles, recordsCount, err := svc.ListAuthorArticles(context.Background(), dto)
+	assert.Equal(t, mockArticles, articles)
+	assert.Equal(t, int64(11), recordsCount)
+	assert.Nil(t, err)
+}
+
+func Test_ListAuthorArticles_ReturnErr_WhenAuthorNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	db_client.InitDatabaseMock()
+
+	dto := v1req.ListAuthorArticlesDTO{Handle: "unknown"}
+
+	authorRepo := mock_repository.NewMockIAuthorRepository(ctrl)
+	authorRepo.EXPECT().GetByHandle(gomock.Any(), dto.Handle).Return(nil, apperror.ErrObjectNotExists)
+	articleRepo := mock_repository.NewMockIArticleRepository(ctrl)
+
+	svc := AuthorSvc{authorRepo: authorRepo, articleRepo: articleRepo}
+	articles, recordsCount, err := svc.ListAuthorArticles(context.Background(), dto)
+	assert.Nil(t, articles)
+	assert.Equal(t, int64(0), recordsCount)
+	assert.Equal(t, apperror.ErrObjectNotExists, err)
+}
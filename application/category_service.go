@@ -0,0 +1,146 @@
+package application
+
+import (
+	"context"
+
+	"article-service/apperror"
+	"article-service/db/db_client"
+	"article-service/db/repository"
+	v1req "article-service/dto/request/v1_req"
+	"article-service/infrastructure/log"
+	"article-service/model"
+	"article-service/utils"
+
+	"github.com/google/uuid"
+)
+
+//go:generate mockgen -source=category_service.go -destination=./mock_application/category_service_mock.go
+type ICategoryService interface {
+	CreateCategory(ctx context.Context, dto v1req.CreateCategoryDTO) (uuid.UUID, error)
+	GetCategory(ctx context.Context, id uuid.UUID) (*model.Category, error)
+	ListCategories(ctx context.Context) ([]*model.Category, error)
+	UpdateCategory(ctx context.Context, id uuid.UUID, dto v1req.UpdateCategoryDTO) (*model.Category, error)
+	DeleteCategory(ctx context.Context, id uuid.UUID) error
+}
+
+type CategorySvc struct {
+	categoryRepo repository.ICategoryRepository
+}
+
+var categorySvcSingleton ICategoryService
+
+func InitCategoryService() {
+	categorySvcSingleton = CategorySvc{
+		repository.GetCategoryRepository(),
+	}
+}
+
+func GetCategoryService() ICategoryService {
+	return categorySvcSingleton
+}
+
+func (svc CategorySvc) CreateCategory(ctx context.Context, dto v1req.CreateCategoryDTO) (uuid.UUID, error) {
+	category := model.Category{
+		ID:   utils.GenerateUUID(),
+		Name: dto.Name,
+	}
+	if dto.ParentId != "" {
+		parentID, _ := uuid.Parse(dto.ParentId)
+		category.ParentID = &parentID
+	}
+
+	err := svc.categoryRepo.Create(ctx, &category)
+	if err != nil {
+		log.Errorf(ctx, err, "[CategorySvc][CreateCategory] categoryRepo.Create is failed, category: %v", category)
+		return uuid.Nil, err
+	}
+
+	return category.ID, nil
+}
+
+func (svc CategorySvc) GetCategory(ctx context.Context, id uuid.UUID) (*model.Category, error) {
+	category, err := svc.categoryRepo.Get(ctx, id)
+	if err != nil {
+		log.Errorf(ctx, err, "[CategorySvc][GetCategory] categoryRepo.Get is failed, id: %s", id)
+		return nil, err
+	}
+
+	return category, nil
+}
+
+func (svc CategorySvc) ListCategories(ctx context.Context) ([]*model.Category, error) {
+	categories, err := svc.categoryRepo.List(ctx)
+	if err != nil {
+		log.Errorf(ctx, err, "[CategorySvc][ListCategories] categoryRepo.List is failed")
+		return nil, err
+	}
+
+	return categories, nil
+}
+
+func (svc CategorySvc) UpdateCategory(ctx context.Context, id uuid.UUID, dto v1req.UpdateCategoryDTO) (*model.Category, error) {
+	ctx, txn, err := db_client.StartTransactionCtx(ctx)
+	if err != nil {
+		log.Errorf(ctx, err, "[CategorySvc][UpdateCategory] failed to start transaction")
+		return nil, apperror.ErrStartTransactionFailed
+	}
+	defer txn.Rollback(ctx)
+
+	category, err := svc.categoryRepo.Get(ctx, id)
+	if err != nil {
+		log.Errorf(ctx, err, "[CategorySvc][UpdateCategory] categoryRepo.Get is failed, id: %s", id)
+		return nil, err
+	}
+
+	if dto.Name != nil {
+		category.Name = *dto.Name
+	}
+	if dto.ParentId != nil {
+		category.ParentID = nil
+		if *dto.ParentId != "" {
+			parentID, _ := uuid.Parse(*dto.ParentId)
+
+			err = svc.categoryRepo.LockTree(ctx)
+			if err != nil {
+				log.Errorf(ctx, err, "[CategorySvc][UpdateCategory] categoryRepo.LockTree is failed, id: %s", id)
+				return nil, err
+			}
+
+			// moving a category under its own subtree would detach that subtree from the root
+			isDescendant, err := svc.categoryRepo.IsDescendant(ctx, parentID, id)
+			if err != nil {
+				log.Errorf(ctx, err, "[CategorySvc][UpdateCategory] categoryRepo.IsDescendant is failed, id: %s, parentId: %s", id, parentID)
+				return nil, err
+			}
+			if isDescendant {
+				log.Errorf(ctx, apperror.ErrCategoryCycle, "[CategorySvc][UpdateCategory] parent is in the category's subtree, id: %s, parentId: %s", id, parentID)
+				return nil, apperror.ErrCategoryCycle
+			}
+
+			category.ParentID = &parentID
+		}
+	}
+
+	err = svc.categoryRepo.Update(ctx, category)
+	if err != nil {
+		log.Errorf(ctx, err, "[CategorySvc][UpdateCategory] categoryRepo.Update is failed, category: %v", category)
+		return nil, err
+	}
+
+	if err = txn.Commit(ctx); err != nil {
+		log.Errorf(ctx, err, "[CategorySvc][UpdateCategory] txn.Commit is failed!")
+		return nil, apperror.ErrCommitTransactionFailed
+	}
+
+	return category, nil
+}
+
+func (svc CategorySvc) DeleteCategory(ctx context.Context, id uuid.UUID) error {
+	err := svc.categoryRepo.Delete(ctx, id)
+	if err != nil {
+		log.Errorf(ctx, err, "[CategorySvc][DeleteCategory] categoryRepo.Delete is failed, id: %s", id)
+		return err
+	}
+
+	return nil
+}
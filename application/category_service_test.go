@@ -0,0 +1,193 @@
+package application
+
+import (
+	"article-service/apperror"
+	"article-service/db/db_client"
+	"article-service/db/repository/mock_repository"
+	v1req "article-service/dto/request/v1_req"
+	"article-service/factory"
+	"article-service/model"
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_GetCategoryService(t *testing.T) {
+	svc := GetCategoryService()
+	assert.Nil(t, svc)
+
+	InitCategoryService()
+
+	svc = GetCategoryService()
+	assert.NotNil(t, svc)
+}
+
+func Test_CreateCategory_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	parent := factory.SampleCategoryNews
+	dto := v1req.CreateCategoryDTO{Name: "Local", ParentId: parent.ID.String()}
+
+	categoryRepo := mock_repository.NewMockICategoryRepository(ctrl)
+	categoryRepo.EXPECT().Create(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, category *model.Category) error {
+			assert.Equal(t, dto.Name, category.Name)
+			assert.Equal(t, parent.ID, *category.ParentID)
+			return nil
+		})
+
+	svc := CategorySvc{categoryRepo: categoryRepo}
+	id, err := svc.CreateCategory(context.Background(), dto)
+	assert.NotEqual(t, uuid.Nil, id)
+	assert.Nil(t, err)
+}
+
+func Test_CreateCategory_ReturnErr_WhenParentNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	dto := v1req.CreateCategoryDTO{Name: "Local", ParentId: uuid.NewString()}
+
+	categoryRepo := mock_repository.NewMockICategoryRepository(ctrl)
+	categoryRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(apperror.ErrCategoryParentNotFound)
+
+	svc := CategorySvc{categoryRepo: categoryRepo}
+	id, err := svc.CreateCategory(context.Background(), dto)
+	assert.Equal(t, uuid.Nil, id)
+	assert.Equal(t, apperror.ErrCategoryParentNotFound, err)
+}
+
+func Test_GetCategory_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	category := factory.SampleCategoryLocal
+	categoryRepo := mock_repository.NewMockICategoryRepository(ctrl)
+	categoryRepo.EXPECT().Get(gomock.Any(), category.ID).Return(&category, nil)
+
+	svc := CategorySvc{categoryRepo: categoryRepo}
+	result, err := svc.GetCategory(context.Background(), category.ID)
+	assert.Equal(t, &category, result)
+	assert.Nil(t, err)
+}
+
+func Test_ListCategories_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	categories := []*model.Category{&factory.SampleCategoryLocal, &factory.SampleCategoryNews}
+	categoryRepo := mock_repository.NewMockICategoryRepository(ctrl)
+	categoryRepo.EXPECT().List(gomock.Any()).Return(categories, nil)
+
+	svc := CategorySvc{categoryRepo: categoryRepo}
+	result, err := svc.ListCategories(context.Background())
+	assert.Equal(t, categories, result)
+	assert.Nil(t, err)
+}
+
+func Test_UpdateCategory_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	db_client.InitDatabaseMockExpectingTxn(ctrl, true, true)
+
+	category := factory.SampleCategoryLocal
+	name := "Regional"
+	parentID := ""
+	dto := v1req.UpdateCategoryDTO{Name: &name, ParentId: &parentID}
+
+	categoryRepo := mock_repository.NewMockICategoryRepository(ctrl)
+	categoryRepo.EXPECT().Get(gomock.Any(), category.ID).Return(&category, nil)
+	categoryRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+
+	svc := CategorySvc{categoryRepo: categoryRepo}
+	result, err := svc.UpdateCategory(context.Background(), category.ID, dto)
+	assert.Nil(t, err)
+	assert.Equal(t, name, result.Name)
+	assert.Nil(t, result.ParentID)
+}
+
+func Test_UpdateCategory_Success_WhenMovedUnderAnotherParent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	db_client.InitDatabaseMockExpectingTxn(ctrl, true, true)
+
+	category := factory.SampleCategoryLocal
+	parentID := uuid.New()
+	parent := parentID.String()
+	dto := v1req.UpdateCategoryDTO{ParentId: &parent}
+
+	categoryRepo := mock_repository.NewMockICategoryRepository(ctrl)
+	categoryRepo.EXPECT().Get(gomock.Any(), category.ID).Return(&category, nil)
+	gomock.InOrder(
+		categoryRepo.EXPECT().LockTree(gomock.Any()).Return(nil),
+		categoryRepo.EXPECT().IsDescendant(gomock.Any(), parentID, category.ID).Return(false, nil),
+	)
+	categoryRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+
+	svc := CategorySvc{categoryRepo: categoryRepo}
+	result, err := svc.UpdateCategory(context.Background(), category.ID, dto)
+	assert.Nil(t, err)
+	assert.Equal(t, parentID, *result.ParentID)
+}
+
+func Test_UpdateCategory_ReturnErr_WhenParentIsDescendant(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	db_client.InitDatabaseMockExpectingTxn(ctrl, true, nil)
+
+	category := factory.SampleCategoryNews
+	child := factory.SampleCategoryLocal
+	parent := child.ID.String()
+	dto := v1req.UpdateCategoryDTO{ParentId: &parent}
+
+	categoryRepo := mock_repository.NewMockICategoryRepository(ctrl)
+	categoryRepo.EXPECT().Get(gomock.Any(), category.ID).Return(&category, nil)
+	categoryRepo.EXPECT().LockTree(gomock.Any()).Return(nil)
+	categoryRepo.EXPECT().IsDescendant(gomock.Any(), child.ID, category.ID).Return(true, nil)
+
+	svc := CategorySvc{categoryRepo: categoryRepo}
+	result, err := svc.UpdateCategory(context.Background(), category.ID, dto)
+	assert.Nil(t, result)
+	assert.Equal(t, apperror.ErrCategoryCycle, err)
+}
+
+func Test_UpdateCategory_ReturnErr_WhenLockTreeFailed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	db_client.InitDatabaseMockExpectingTxn(ctrl, true, nil)
+
+	category := factory.SampleCategoryLocal
+	parent := uuid.New().String()
+	dto := v1req.UpdateCategoryDTO{ParentId: &parent}
+
+	categoryRepo := mock_repository.NewMockICategoryRepository(ctrl)
+	categoryRepo.EXPECT().Get(gomock.Any(), category.ID).Return(&category, nil)
+	categoryRepo.EXPECT().LockTree(gomock.Any()).Return(apperror.ErrUpdateRecordFailed)
+
+	svc := CategorySvc{categoryRepo: categoryRepo}
+	result, err := svc.UpdateCategory(context.Background(), category.ID, dto)
+	assert.Nil(t, result)
+	assert.Equal(t, apperror.ErrUpdateRecordFailed, err)
+}
+
+func Test_UpdateCategory_ReturnErr_WhenCategoryNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	db_client.InitDatabaseMockExpectingTxn(ctrl, true, nil)
+
+	category := factory.SampleCategoryNews
+	categoryRepo := mock_repository.NewMockICategoryRepository(ctrl)
+	categoryRepo.EXPECT().Get(gomock.Any(), category.ID).Return(nil, apperror.ErrObjectNotExists)
+
+	svc := CategorySvc{categoryRepo: categoryRepo}
+	result, err := svc.UpdateCategory(context.Background(), category.ID, v1req.UpdateCategoryDTO{})
+	assert.Nil(t, result)
+	assert.Equal(t, apperror.ErrObjectNotExists, err)
+}
+
+func Test_DeleteCategory_ReturnErr_WhenCategoryInUse(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	category := factory.SampleCategoryNews
+	categoryRepo := mock_repository.NewMockICategoryRepository(ctrl)
+	categoryRepo.EXPECT().Delete(gomock.Any(), category.ID).Return(apperror.ErrCategoryInUse)
+
+	svc := CategorySvc{categoryRepo: categoryRepo}
+	err := svc.DeleteCategory(context.Background(), category.ID)
+	assert.Equal(t, apperror.ErrCategoryInUse, err)
+}
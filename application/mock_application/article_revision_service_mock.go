@@ -0,0 +1,97 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: article_revision_service.go
+
+// Package mock_application is a generated GoMock package.
+package mock_application
+
+import (
+	model "article-service/model"
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockIArticleRevisionService is a mock of IArticleRevisionService interface.
+type MockIArticleRevisionService struct {
+	ctrl     *gomock.Controller
+	recorder *MockIArticleRevisionServiceMockRecorder
+}
+
+// MockIArticleRevisionServiceMockRecorder is the mock recorder for MockIArticleRevisionService.
+type MockIArticleRevisionServiceMockRecorder struct {
+	mock *MockIArticleRevisionService
+}
+
+// NewMockIArticleRevisionService creates a new mock instance.
+func NewMockIArticleRevisionService(ctrl *gomock.Controller) *MockIArticleRevisionService {
+	mock := &MockIArticleRevisionService{ctrl: ctrl}
+	mock.recorder = &MockIArticleRevisionServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIArticleRevisionService) EXPECT() *MockIArticleRevisionServiceMockRecorder {
+	return m.recorder
+}
+
+// DiffRevisions mocks base method.
+func (m *MockIArticleRevisionService) DiffRevisions(ctx context.Context, articleID uuid.UUID, from, to int) (*model.ArticleRevisionDiff, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DiffRevisions", ctx, articleID, from, to)
+	ret0, _ := ret[0].(*model.ArticleRevisionDiff)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DiffRevisions indicates an expected call of DiffRevisions.
+func (mr *MockIArticleRevisionServiceMockRecorder) DiffRevisions(ctx, articleID, from, to interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DiffRevisions", reflect.TypeOf((*MockIArticleRevisionService)(nil).DiffRevisions), ctx, articleID, from, to)
+}
+
+// GetRevision mocks base method.
+func (m *MockIArticleRevisionService) GetRevision(ctx context.Context, articleID uuid.UUID, revision int) (*model.ArticleRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRevision", ctx, articleID, revision)
+	ret0, _ := ret[0].(*model.ArticleRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRevision indicates an expected call of GetRevision.
+func (mr *MockIArticleRevisionServiceMockRecorder) GetRevision(ctx, articleID, revision interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRevision", reflect.TypeOf((*MockIArticleRevisionService)(nil).GetRevision), ctx, articleID, revision)
+}
+
+// ListRevisions mocks base method.
+func (m *MockIArticleRevisionService) ListRevisions(ctx context.Context, articleID uuid.UUID) ([]*model.ArticleRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRevisions", ctx, articleID)
+	ret0, _ := ret[0].([]*model.ArticleRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRevisions indicates an expected call of ListRevisions.
+func (mr *MockIArticleRevisionServiceMockRecorder) ListRevisions(ctx, articleID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRevisions", reflect.TypeOf((*MockIArticleRevisionService)(nil).ListRevisions), ctx, articleID)
+}
+
+// RestoreRevision mocks base method.
+func (m *MockIArticleRevisionService) RestoreRevision(ctx context.Context, articleID uuid.UUID, revision int) (*model.Article, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreRevision", ctx, articleID, revision)
+	ret0, _ := ret[0].(*model.Article)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreRevision indicates an expected call of RestoreRevision.
+func (mr *MockIArticleRevisionServiceMockRecorder) RestoreRevision(ctx, articleID, revision interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreRevision", reflect.TypeOf((*MockIArticleRevisionService)(nil).RestoreRevision), ctx, articleID, revision)
+}
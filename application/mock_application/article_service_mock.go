@@ -37,6 +37,36 @@ func (m *MockIArticleService) EXPECT() *MockIArticleServiceMockRecorder {
 	return m.recorder
 }
 
+// ArchiveArticle mocks base method.
+func (m *MockIArticleService) ArchiveArticle(ctx context.Context, id uuid.UUID) (*model.Article, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ArchiveArticle", ctx, id)
+	ret0, _ := ret[0].(*model.Article)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ArchiveArticle indicates an expected call of ArchiveArticle.
+func (mr *MockIArticleServiceMockRecorder) ArchiveArticle(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArchiveArticle", reflect.TypeOf((*MockIArticleService)(nil).ArchiveArticle), ctx, id)
+}
+
+// CorrectSearchQuery mocks base method.
+func (m *MockIArticleService) CorrectSearchQuery(ctx context.Context, dto v1req.ListArticlesDTO) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CorrectSearchQuery", ctx, dto)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CorrectSearchQuery indicates an expected call of CorrectSearchQuery.
+func (mr *MockIArticleServiceMockRecorder) CorrectSearchQuery(ctx, dto interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CorrectSearchQuery", reflect.TypeOf((*MockIArticleService)(nil).CorrectSearchQuery), ctx, dto)
+}
+
 // CreateArticle mocks base method.
 func (m *MockIArticleService) CreateArticle(ctx context.Context, dto v1req.CreateArticleDTO) (uuid.UUID, error) {
 	m.ctrl.T.Helper()
@@ -52,6 +82,65 @@ func (mr *MockIArticleServiceMockRecorder) CreateArticle(ctx, dto interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateArticle", reflect.TypeOf((*MockIArticleService)(nil).CreateArticle), ctx, dto)
 }
 
+// DeleteArticle mocks base method.
+func (m *MockIArticleService) DeleteArticle(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteArticle", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteArticle indicates an expected call of DeleteArticle.
+func (mr *MockIArticleServiceMockRecorder) DeleteArticle(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteArticle", reflect.TypeOf((*MockIArticleService)(nil).DeleteArticle), ctx, id)
+}
+
+// GetArticle mocks base method.
+func (m *MockIArticleService) GetArticle(ctx context.Context, id uuid.UUID) (*model.Article, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetArticle", ctx, id)
+	ret0, _ := ret[0].(*model.Article)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetArticle indicates an expected call of GetArticle.
+func (mr *MockIArticleServiceMockRecorder) GetArticle(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetArticle", reflect.TypeOf((*MockIArticleService)(nil).GetArticle), ctx, id)
+}
+
+// GetArticleBySlug mocks base method.
+func (m *MockIArticleService) GetArticleBySlug(ctx context.Context, slug string) (*model.Article, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetArticleBySlug", ctx, slug)
+	ret0, _ := ret[0].(*model.Article)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetArticleBySlug indicates an expected call of GetArticleBySlug.
+func (mr *MockIArticleServiceMockRecorder) GetArticleBySlug(ctx, slug interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetArticleBySlug", reflect.TypeOf((*MockIArticleService)(nil).GetArticleBySlug), ctx, slug)
+}
+
+// ListArticleFacets mocks base method.
+func (m *MockIArticleService) ListArticleFacets(ctx context.Context, dto v1req.ListArticlesDTO) (model.ArticleFacets, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListArticleFacets", ctx, dto)
+	ret0, _ := ret[0].(model.ArticleFacets)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListArticleFacets indicates an expected call of ListArticleFacets.
+func (mr *MockIArticleServiceMockRecorder) ListArticleFacets(ctx, dto interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListArticleFacets", reflect.TypeOf((*MockIArticleService)(nil).ListArticleFacets), ctx, dto)
+}
+
 // ListArticles mocks base method.
 func (m *MockIArticleService) ListArticles(ctx context.Context, dto v1req.ListArticlesDTO) ([]*model.Article, int64, error) {
 	m.ctrl.T.Helper()
@@ -67,3 +156,123 @@ func (mr *MockIArticleServiceMockRecorder) ListArticles(ctx, dto interface{}) *g
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListArticles", reflect.TypeOf((*MockIArticleService)(nil).ListArticles), ctx, dto)
 }
+
+// ListRelatedArticles mocks base method.
+func (m *MockIArticleService) ListRelatedArticles(ctx context.Context, id uuid.UUID, dto v1req.ListRelatedArticlesDTO) ([]*model.Article, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRelatedArticles", ctx, id, dto)
+	ret0, _ := ret[0].([]*model.Article)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRelatedArticles indicates an expected call of ListRelatedArticles.
+func (mr *MockIArticleServiceMockRecorder) ListRelatedArticles(ctx, id, dto interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRelatedArticles", reflect.TypeOf((*MockIArticleService)(nil).ListRelatedArticles), ctx, id, dto)
+}
+
+// PatchArticle mocks base method.
+func (m *MockIArticleService) PatchArticle(ctx context.Context, id uuid.UUID, dto v1req.PatchArticleDTO) (*model.Article, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PatchArticle", ctx, id, dto)
+	ret0, _ := ret[0].(*model.Article)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PatchArticle indicates an expected call of PatchArticle.
+func (mr *MockIArticleServiceMockRecorder) PatchArticle(ctx, id, dto interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchArticle", reflect.TypeOf((*MockIArticleService)(nil).PatchArticle), ctx, id, dto)
+}
+
+// PublishArticle mocks base method.
+func (m *MockIArticleService) PublishArticle(ctx context.Context, id uuid.UUID) (*model.Article, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishArticle", ctx, id)
+	ret0, _ := ret[0].(*model.Article)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PublishArticle indicates an expected call of PublishArticle.
+func (mr *MockIArticleServiceMockRecorder) PublishArticle(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishArticle", reflect.TypeOf((*MockIArticleService)(nil).PublishArticle), ctx, id)
+}
+
+// PublishDueArticles mocks base method.
+func (m *MockIArticleService) PublishDueArticles(ctx context.Context, limit int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishDueArticles", ctx, limit)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PublishDueArticles indicates an expected call of PublishDueArticles.
+func (mr *MockIArticleServiceMockRecorder) PublishDueArticles(ctx, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishDueArticles", reflect.TypeOf((*MockIArticleService)(nil).PublishDueArticles), ctx, limit)
+}
+
+// RestoreArticle mocks base method.
+func (m *MockIArticleService) RestoreArticle(ctx context.Context, id uuid.UUID) (*model.Article, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreArticle", ctx, id)
+	ret0, _ := ret[0].(*model.Article)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreArticle indicates an expected call of RestoreArticle.
+func (mr *MockIArticleServiceMockRecorder) RestoreArticle(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreArticle", reflect.TypeOf((*MockIArticleService)(nil).RestoreArticle), ctx, id)
+}
+
+// ScheduleArticle mocks base method.
+func (m *MockIArticleService) ScheduleArticle(ctx context.Context, id uuid.UUID, dto v1req.ScheduleArticleDTO) (*model.Article, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScheduleArticle", ctx, id, dto)
+	ret0, _ := ret[0].(*model.Article)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ScheduleArticle indicates an expected call of ScheduleArticle.
+func (mr *MockIArticleServiceMockRecorder) ScheduleArticle(ctx, id, dto interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScheduleArticle", reflect.TypeOf((*MockIArticleService)(nil).ScheduleArticle), ctx, id, dto)
+}
+
+// SuggestArticles mocks base method.
+func (m *MockIArticleService) SuggestArticles(ctx context.Context, dto v1req.SuggestArticlesDTO) (model.ArticleSuggestions, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SuggestArticles", ctx, dto)
+	ret0, _ := ret[0].(model.ArticleSuggestions)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SuggestArticles indicates an expected call of SuggestArticles.
+func (mr *MockIArticleServiceMockRecorder) SuggestArticles(ctx, dto interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SuggestArticles", reflect.TypeOf((*MockIArticleService)(nil).SuggestArticles), ctx, dto)
+}
+
+// UpdateArticle mocks base method.
+func (m *MockIArticleService) UpdateArticle(ctx context.Context, id uuid.UUID, dto v1req.UpdateArticleDTO) (*model.Article, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateArticle", ctx, id, dto)
+	ret0, _ := ret[0].(*model.Article)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateArticle indicates an expected call of UpdateArticle.
+func (mr *MockIArticleServiceMockRecorder) UpdateArticle(ctx, id, dto interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateArticle", reflect.TypeOf((*MockIArticleService)(nil).UpdateArticle), ctx, id, dto)
+}
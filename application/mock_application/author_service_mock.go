@@ -0,0 +1,129 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: author_service.go
+
+// Package mock_application is a generated GoMock package.
+package mock_application
+
+import (
+	v1req "article-service/dto/request/v1_req"
+	model "article-service/model"
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockIAuthorService is a mock of IAuthorService interface.
+type MockIAuthorService struct {
+	ctrl     *gomock.Controller
+	recorder *MockIAuthorServiceMockRecorder
+}
+
+// MockIAuthorServiceMockRecorder is the mock recorder for MockIAuthorService.
+type MockIAuthorServiceMockRecorder struct {
+	mock *MockIAuthorService
+}
+
+// NewMockIAuthorService creates a new mock instance.
+func NewMockIAuthorService(ctrl *gomock.Controller) *MockIAuthorService {
+	mock := &MockIAuthorService{ctrl: ctrl}
+	mock.recorder = &MockIAuthorServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIAuthorService) EXPECT() *MockIAuthorServiceMockRecorder {
+	return m.recorder
+}
+
+// CreateAuthor mocks base method.
+func (m *MockIAuthorService) CreateAuthor(ctx context.Context, dto v1req.CreateAuthorDTO) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAuthor", ctx, dto)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAuthor indicates an expected call of CreateAuthor.
+func (mr *MockIAuthorServiceMockRecorder) CreateAuthor(ctx, dto interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAuthor", reflect.TypeOf((*MockIAuthorService)(nil).CreateAuthor), ctx, dto)
+}
+
+// DeleteAuthor mocks base method.
+func (m *MockIAuthorService) DeleteAuthor(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAuthor", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAuthor indicates an expected call of DeleteAuthor.
+func (mr *MockIAuthorServiceMockRecorder) DeleteAuthor(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAuthor", reflect.TypeOf((*MockIAuthorService)(nil).DeleteAuthor), ctx, id)
+}
+
+// GetAuthor mocks base method.
+func (m *MockIAuthorService) GetAuthor(ctx context.Context, id uuid.UUID) (*model.Author, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAuthor", ctx, id)
+	ret0, _ := ret[0].(*model.Author)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAuthor indicates an expected call of GetAuthor.
+func (mr *MockIAuthorServiceMockRecorder) GetAuthor(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuthor", reflect.TypeOf((*MockIAuthorService)(nil).GetAuthor), ctx, id)
+}
+
+// ListAuthorArticles mocks base method.
+func (m *MockIAuthorService) ListAuthorArticles(ctx context.Context, dto v1req.ListAuthorArticlesDTO) ([]*model.Article, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAuthorArticles", ctx, dto)
+	ret0, _ := ret[0].([]*model.Article)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListAuthorArticles indicates an expected call of ListAuthorArticles.
+func (mr *MockIAuthorServiceMockRecorder) ListAuthorArticles(ctx, dto interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAuthorArticles", reflect.TypeOf((*MockIAuthorService)(nil).ListAuthorArticles), ctx, dto)
+}
+
+// ListAuthors mocks base method.
+func (m *MockIAuthorService) ListAuthors(ctx context.Context, dto v1req.ListAuthorsDTO) ([]*model.Author, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAuthors", ctx, dto)
+	ret0, _ := ret[0].([]*model.Author)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListAuthors indicates an expected call of ListAuthors.
+func (mr *MockIAuthorServiceMockRecorder) ListAuthors(ctx, dto interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAuthors", reflect.TypeOf((*MockIAuthorService)(nil).ListAuthors), ctx, dto)
+}
+
+// UpdateAuthor mocks base method.
+func (m *MockIAuthorService) UpdateAuthor(ctx context.Context, id uuid.UUID, dto v1req.UpdateAuthorDTO) (*model.Author, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAuthor", ctx, id, dto)
+	ret0, _ := ret[0].(*model.Author)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateAuthor indicates an expected call of UpdateAuthor.
+func (mr *MockIAuthorServiceMockRecorder) UpdateAuthor(ctx, id, dto interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAuthor", reflect.TypeOf((*MockIAuthorService)(nil).UpdateAuthor), ctx, id, dto)
+}
@@ -0,0 +1,112 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: category_service.go
+
+// Package mock_application is a generated GoMock package.
+package mock_application
+
+import (
+	v1req "article-service/dto/request/v1_req"
+	model "article-service/model"
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockICategoryService is a mock of ICategoryService interface.
+type MockICategoryService struct {
+	ctrl     *gomock.Controller
+	recorder *MockICategoryServiceMockRecorder
+}
+
+// MockICategoryServiceMockRecorder is the mock recorder for MockICategoryService.
+type MockICategoryServiceMockRecorder struct {
+	mock *MockICategoryService
+}
+
+// NewMockICategoryService creates a new mock instance.
+func NewMockICategoryService(ctrl *gomock.Controller) *MockICategoryService {
+	mock := &MockICategoryService{ctrl: ctrl}
+	mock.recorder = &MockICategoryServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockICategoryService) EXPECT() *MockICategoryServiceMockRecorder {
+	return m.recorder
+}
+
+// CreateCategory mocks base method.
+func (m *MockICategoryService) CreateCategory(ctx context.Context, dto v1req.CreateCategoryDTO) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCategory", ctx, dto)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCategory indicates an expected call of CreateCategory.
+func (mr *MockICategoryServiceMockRecorder) CreateCategory(ctx, dto interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCategory", reflect.TypeOf((*MockICategoryService)(nil).CreateCategory), ctx, dto)
+}
+
+// DeleteCategory mocks base method.
+func (m *MockICategoryService) DeleteCategory(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCategory", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCategory indicates an expected call of DeleteCategory.
+func (mr *MockICategoryServiceMockRecorder) DeleteCategory(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCategory", reflect.TypeOf((*MockICategoryService)(nil).DeleteCategory), ctx, id)
+}
+
+// GetCategory mocks base method.
+func (m *MockICategoryService) GetCategory(ctx context.Context, id uuid.UUID) (*model.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCategory", ctx, id)
+	ret0, _ := ret[0].(*model.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCategory indicates an expected call of GetCategory.
+func (mr *MockICategoryServiceMockRecorder) GetCategory(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategory", reflect.TypeOf((*MockICategoryService)(nil).GetCategory), ctx, id)
+}
+
+// ListCategories mocks base method.
+func (m *MockICategoryService) ListCategories(ctx context.Context) ([]*model.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCategories", ctx)
+	ret0, _ := ret[0].([]*model.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCategories indicates an expected call of ListCategories.
+func (mr *MockICategoryServiceMockRecorder) ListCategories(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCategories", reflect.TypeOf((*MockICategoryService)(nil).ListCategories), ctx)
+}
+
+// UpdateCategory mocks base method.
+func (m *MockICategoryService) UpdateCategory(ctx context.Context, id uuid.UUID, dto v1req.UpdateCategoryDTO) (*model.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCategory", ctx, id, dto)
+	ret0, _ := ret[0].(*model.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateCategory indicates an expected call of UpdateCategory.
+func (mr *MockICategoryServiceMockRecorder) UpdateCategory(ctx, id, dto interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCategory", reflect.TypeOf((*MockICategoryService)(nil).UpdateCategory), ctx, id, dto)
+}
@@ -0,0 +1,66 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: outbox_service.go
+
+// Package mock_application is a generated GoMock package.
+package mock_application
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockIOutboxService is a mock of IOutboxService interface.
+type MockIOutboxService struct {
+	ctrl     *gomock.Controller
+	recorder *MockIOutboxServiceMockRecorder
+}
+
+// MockIOutboxServiceMockRecorder is the mock recorder for MockIOutboxService.
+type MockIOutboxServiceMockRecorder struct {
+	mock *MockIOutboxService
+}
+
+// NewMockIOutboxService creates a new mock instance.
+func NewMockIOutboxService(ctrl *gomock.Controller) *MockIOutboxService {
+	mock := &MockIOutboxService{ctrl: ctrl}
+	mock.recorder = &MockIOutboxServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIOutboxService) EXPECT() *MockIOutboxServiceMockRecorder {
+	return m.recorder
+}
+
+// PruneEvents mocks base method.
+func (m *MockIOutboxService) PruneEvents(ctx context.Context, retention time.Duration, limit int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PruneEvents", ctx, retention, limit)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PruneEvents indicates an expected call of PruneEvents.
+func (mr *MockIOutboxServiceMockRecorder) PruneEvents(ctx, retention, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PruneEvents", reflect.TypeOf((*MockIOutboxService)(nil).PruneEvents), ctx, retention, limit)
+}
+
+// RelayEvents mocks base method.
+func (m *MockIOutboxService) RelayEvents(ctx context.Context, limit int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RelayEvents", ctx, limit)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RelayEvents indicates an expected call of RelayEvents.
+func (mr *MockIOutboxServiceMockRecorder) RelayEvents(ctx, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RelayEvents", reflect.TypeOf((*MockIOutboxService)(nil).RelayEvents), ctx, limit)
+}
@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: reindex_service.go
+
+// Package mock_application is a generated GoMock package.
+package mock_application
+
+import (
+	model "article-service/model"
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockIReindexService is a mock of IReindexService interface.
+type MockIReindexService struct {
+	ctrl     *gomock.Controller
+	recorder *MockIReindexServiceMockRecorder
+}
+
+// MockIReindexServiceMockRecorder is the mock recorder for MockIReindexService.
+type MockIReindexServiceMockRecorder struct {
+	mock *MockIReindexService
+}
+
+// NewMockIReindexService creates a new mock instance.
+func NewMockIReindexService(ctrl *gomock.Controller) *MockIReindexService {
+	mock := &MockIReindexService{ctrl: ctrl}
+	mock.recorder = &MockIReindexServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIReindexService) EXPECT() *MockIReindexServiceMockRecorder {
+	return m.recorder
+}
+
+// Reindex mocks base method.
+func (m *MockIReindexService) Reindex(ctx context.Context, batchSize int) (*model.ReindexJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reindex", ctx, batchSize)
+	ret0, _ := ret[0].(*model.ReindexJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Reindex indicates an expected call of Reindex.
+func (mr *MockIReindexServiceMockRecorder) Reindex(ctx, batchSize interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reindex", reflect.TypeOf((*MockIReindexService)(nil).Reindex), ctx, batchSize)
+}
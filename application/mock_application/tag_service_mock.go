@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: tag_service.go
+
+// Package mock_application is a generated GoMock package.
+package mock_application
+
+import (
+	model "article-service/model"
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockITagService is a mock of ITagService interface.
+type MockITagService struct {
+	ctrl     *gomock.Controller
+	recorder *MockITagServiceMockRecorder
+}
+
+// MockITagServiceMockRecorder is the mock recorder for MockITagService.
+type MockITagServiceMockRecorder struct {
+	mock *MockITagService
+}
+
+// NewMockITagService creates a new mock instance.
+func NewMockITagService(ctrl *gomock.Controller) *MockITagService {
+	mock := &MockITagService{ctrl: ctrl}
+	mock.recorder = &MockITagServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockITagService) EXPECT() *MockITagServiceMockRecorder {
+	return m.recorder
+}
+
+// ListTags mocks base method.
+func (m *MockITagService) ListTags(ctx context.Context) ([]*model.Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTags", ctx)
+	ret0, _ := ret[0].([]*model.Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTags indicates an expected call of ListTags.
+func (mr *MockITagServiceMockRecorder) ListTags(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTags", reflect.TypeOf((*MockITagService)(nil).ListTags), ctx)
+}
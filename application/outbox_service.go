@@ -0,0 +1,148 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"article-service/apperror"
+	"article-service/db/db_client"
+	"article-service/db/repository"
+	"article-service/infrastructure/log"
+	"article-service/model"
+	"article-service/search"
+	"article-service/utils"
+
+	"github.com/google/uuid"
+)
+
+//go:generate mockgen -source=outbox_service.go -destination=./mock_application/outbox_service_mock.go
+type IOutboxService interface {
+	RelayEvents(ctx context.Context, limit int) (int, error)
+	PruneEvents(ctx context.Context, retention time.Duration, limit int) (int, error)
+}
+
+const (
+	outboxMaxAttempts    = 10
+	outboxRetryBaseDelay = 5 * time.Second
+	outboxRetryMaxDelay  = 30 * time.Minute
+)
+
+type OutboxSvc struct {
+	outboxEventRepo repository.IOutboxEventRepository
+	articleRepo     repository.IArticleRepository
+	articleSearch   search.IArticleSearch
+}
+
+var outboxSvcSingleton IOutboxService
+
+func InitOutboxService() {
+	outboxSvcSingleton = OutboxSvc{
+		repository.GetOutboxEventRepository(),
+		repository.GetArticleRepository(),
+		search.GetArticleSearch(),
+	}
+}
+
+func GetOutboxService() IOutboxService {
+	return outboxSvcSingleton
+}
+
+func (svc OutboxSvc) RelayEvents(ctx context.Context, limit int) (int, error) {
+	ctx, txn, err := db_client.StartTransactionCtx(ctx)
+	if err != nil {
+		log.Errorf(ctx, err, "[OutboxSvc][RelayEvents] failed to start transaction")
+		return 0, apperror.ErrStartTransactionFailed
+	}
+	defer txn.Rollback(ctx)
+
+	events, err := svc.outboxEventRepo.ListDeliverable(ctx, time.Now(), limit)
+	if err != nil {
+		log.Errorf(ctx, err, "[OutboxSvc][RelayEvents] outboxEventRepo.ListDeliverable is failed")
+		return 0, err
+	}
+
+	for _, event := range events {
+		event.Attempts++
+		deliverErr := svc.deliver(ctx, *event)
+
+		now := time.Now()
+		switch {
+		case deliverErr == nil:
+			event.Status = model.OutboxEventStatusDelivered
+			event.LastError = ""
+			event.DeliveredAt = &now
+		case deliverErr == apperror.ErrUnknownOutboxEvent || event.Attempts >= outboxMaxAttempts:
+			event.Status = model.OutboxEventStatusDead
+			event.LastError = deliverErr.Error()
+			log.Errorf(ctx, deliverErr, "[OutboxSvc][RelayEvents] event is dead after %d attempts, event: %v", event.Attempts, event)
+		default:
+			event.LastError = deliverErr.Error()
+			event.AvailableAt = now.Add(outboxRetryDelay(event.Attempts))
+			log.Errorf(ctx, deliverErr, "[OutboxSvc][RelayEvents] delivery failed, retrying at %s, event: %v", event.AvailableAt, event)
+		}
+
+		err = svc.outboxEventRepo.UpdateDelivery(ctx, event)
+		if err != nil {
+			log.Errorf(ctx, err, "[OutboxSvc][RelayEvents] outboxEventRepo.UpdateDelivery is failed, event: %v", event)
+			return 0, err
+		}
+	}
+
+	if err = txn.Commit(ctx); err != nil {
+		log.Errorf(ctx, err, "[OutboxSvc][RelayEvents] txn.Commit is failed!")
+		return 0, apperror.ErrCommitTransactionFailed
+	}
+
+	return len(events), nil
+}
+
+// PruneEvents deletes delivered and dead events older than the retention, pending events are always kept
+func (svc OutboxSvc) PruneEvents(ctx context.Context, retention time.Duration, limit int) (int, error) {
+	deleted, err := svc.outboxEventRepo.DeleteFinishedBefore(ctx, time.Now().Add(-retention), limit)
+	if err != nil {
+		log.Errorf(ctx, err, "[OutboxSvc][PruneEvents] outboxEventRepo.DeleteFinishedBefore is failed, retention: %s", retention)
+		return 0, err
+	}
+
+	return int(deleted), nil
+}
+
+// deliver copies the current state of the article, so delivering an event twice or late does no harm
+func (svc OutboxSvc) deliver(ctx context.Context, event model.OutboxEvent) error {
+	if event.EventType != model.OutboxEventArticleChanged {
+		return apperror.ErrUnknownOutboxEvent
+	}
+
+	article, err := svc.articleRepo.Get(ctx, event.AggregateID)
+	if err == apperror.ErrObjectNotExists {
+		return svc.articleSearch.Delete(ctx, event.AggregateID)
+	}
+	if err != nil {
+		return err
+	}
+
+	if article.IsPublic() {
+		return svc.articleSearch.Index(ctx, *article)
+	}
+	return svc.articleSearch.Delete(ctx, article.ID)
+}
+
+func outboxRetryDelay(attempts int) time.Duration {
+	delay := outboxRetryBaseDelay
+	for i := 1; i < attempts && delay < outboxRetryMaxDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, outboxRetryMaxDelay)
+}
+
+func newArticleChangedEvent(articleID uuid.UUID) model.OutboxEvent {
+	now := time.Now()
+	return model.OutboxEvent{
+		ID:          utils.GenerateUUID(),
+		AggregateID: articleID,
+		EventType:   model.OutboxEventArticleChanged,
+		Status:      model.OutboxEventStatusPending,
+		AvailableAt: now,
+		CreatedAt:   now,
+	}
+}
@@ -0,0 +1,217 @@
+package application
+
+import (
+	"article-service/apperror"
+	"article-service/db/db_client"
+	"article-service/db/repository/mock_repository"
+	"article-service/factory"
+	"article-service/model"
+	"article-service/search/mock_search"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_RelayEvents_Success_IndexPublicArticle(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	db_client.InitDatabaseMockExpectingTxn(ctrl, true, true)
+
+	article := factory.SampleArticle1
+	event := newArticleChangedEvent(article.ID)
+
+	outboxEventRepo := mock_repository.NewMockIOutboxEventRepository(ctrl)
+	articleRepo := mock_repository.NewMockIArticleRepository(ctrl)
+	articleSearch := mock_search.NewMockIArticleSearch(ctrl)
+
+	outboxEventRepo.EXPECT().ListDeliverable(gomock.Any(), gomock.Any(), 10).Return([]*model.OutboxEvent{&event}, nil)
+	articleRepo.EXPECT().Get(gomock.Any(), article.ID).Return(&article, nil)
+	articleSearch.EXPECT().Index(gomock.Any(), article).Return(nil)
+	outboxEventRepo.EXPECT().UpdateDelivery(gomock.Any(), &event).Return(nil)
+
+	svc := OutboxSvc{outboxEventRepo, articleRepo, articleSearch}
+	relayed, err := svc.RelayEvents(context.Background(), 10)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 1, relayed)
+	assert.Equal(t, model.OutboxEventStatusDelivered, event.Status)
+	assert.Equal(t, 1, event.Attempts)
+	assert.NotNil(t, event.DeliveredAt)
+}
+
+func Test_RelayEvents_Success_DeleteArticleThatIsNotPublic(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	db_client.InitDatabaseMockExpectingTxn(ctrl, true, true)
+
+	article := factory.SampleArticle1
+	deletedAt := time.Now()
+	article.DeletedAt = &deletedAt
+	event := newArticleChangedEvent(article.ID)
+
+	outboxEventRepo := mock_repository.NewMockIOutboxEventRepository(ctrl)
+	articleRepo := mock_repository.NewMockIArticleRepository(ctrl)
+	articleSearch := mock_search.NewMockIArticleSearch(ctrl)
+
+	outboxEventRepo.EXPECT().ListDeliverable(gomock.Any(), gomock.Any(), 10).Return([]*model.OutboxEvent{&event}, nil)
+	articleRepo.EXPECT().Get(gomock.Any(), article.ID).Return(&article, nil)
+	articleSearch.EXPECT().Delete(gomock.Any(), article.ID).Return(nil)
+	outboxEventRepo.EXPECT().UpdateDelivery(gomock.Any(), &event).Return(nil)
+
+	svc := OutboxSvc{outboxEventRepo, articleRepo, articleSearch}
+	relayed, err := svc.RelayEvents(context.Background(), 10)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 1, relayed)
+	assert.Equal(t, model.OutboxEventStatusDelivered, event.Status)
+}
+
+func Test_RelayEvents_Success_RetryLater_WhenDeliveryFailed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	db_client.InitDatabaseMockExpectingTxn(ctrl, true, true)
+
+	article := factory.SampleArticle1
+	event := newArticleChangedEvent(article.ID)
+	event.Attempts = 2
+
+	outboxEventRepo := mock_repository.NewMockIOutboxEventRepository(ctrl)
+	articleRepo := mock_repository.NewMockIArticleRepository(ctrl)
+	articleSearch := mock_search.NewMockIArticleSearch(ctrl)
+
+	outboxEventRepo.EXPECT().ListDeliverable(gomock.Any(), gomock.Any(), 10).Return([]*model.OutboxEvent{&event}, nil)
+	articleRepo.EXPECT().Get(gomock.Any(), article.ID).Return(&article, nil)
+	articleSearch.EXPECT().Index(gomock.Any(), article).Return(apperror.ErrIndexElasticFailed)
+	outboxEventRepo.EXPECT().UpdateDelivery(gomock.Any(), &event).Return(nil)
+
+	svc := OutboxSvc{outboxEventRepo, articleRepo, articleSearch}
+	before := time.Now()
+	relayed, err := svc.RelayEvents(context.Background(), 10)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 1, relayed)
+	assert.Equal(t, model.OutboxEventStatusPending, event.Status)
+	assert.Equal(t, 3, event.Attempts)
+	assert.Equal(t, apperror.ErrIndexElasticFailed.Error(), event.LastError)
+	assert.True(t, event.AvailableAt.After(before.Add(19*time.Second)))
+	assert.Nil(t, event.DeliveredAt)
+}
+
+func Test_RelayEvents_Success_MarkDead_WhenAttemptsUsedUp(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	db_client.InitDatabaseMockExpectingTxn(ctrl, true, true)
+
+	article := factory.SampleArticle1
+	event := newArticleChangedEvent(article.ID)
+	event.Attempts = outboxMaxAttempts - 1
+
+	outboxEventRepo := mock_repository.NewMockIOutboxEventRepository(ctrl)
+	articleRepo := mock_repository.NewMockIArticleRepository(ctrl)
+	articleSearch := mock_search.NewMockIArticleSearch(ctrl)
+
+	outboxEventRepo.EXPECT().ListDeliverable(gomock.Any(), gomock.Any(), 10).Return([]*model.OutboxEvent{&event}, nil)
+	articleRepo.EXPECT().Get(gomock.Any(), article.ID).Return(nil, apperror.ErrGetRecordFailed)
+	outboxEventRepo.EXPECT().UpdateDelivery(gomock.Any(), &event).Return(nil)
+
+	svc := OutboxSvc{outboxEventRepo, articleRepo, articleSearch}
+	relayed, err := svc.RelayEvents(context.Background(), 10)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 1, relayed)
+	assert.Equal(t, model.OutboxEventStatusDead, event.Status)
+	assert.Equal(t, apperror.ErrGetRecordFailed.Error(), event.LastError)
+}
+
+func Test_RelayEvents_Success_MarkDead_WhenEventTypeIsUnknown(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	db_client.InitDatabaseMockExpectingTxn(ctrl, true, true)
+
+	event := newArticleChangedEvent(factory.SampleArticle1.ID)
+	event.EventType = "author_changed"
+
+	outboxEventRepo := mock_repository.NewMockIOutboxEventRepository(ctrl)
+	outboxEventRepo.EXPECT().ListDeliverable(gomock.Any(), gomock.Any(), 10).Return([]*model.OutboxEvent{&event}, nil)
+	outboxEventRepo.EXPECT().UpdateDelivery(gomock.Any(), &event).Return(nil)
+
+	svc := OutboxSvc{outboxEventRepo: outboxEventRepo}
+	relayed, err := svc.RelayEvents(context.Background(), 10)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 1, relayed)
+	assert.Equal(t, model.OutboxEventStatusDead, event.Status)
+	assert.Equal(t, 1, event.Attempts)
+}
+
+func Test_RelayEvents_ReturnErr_WhenUpdateDeliveryFailed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	db_client.InitDatabaseMockExpectingTxn(ctrl, true, nil)
+
+	article := factory.SampleArticle1
+	event := newArticleChangedEvent(article.ID)
+
+	outboxEventRepo := mock_repository.NewMockIOutboxEventRepository(ctrl)
+	articleRepo := mock_repository.NewMockIArticleRepository(ctrl)
+	articleSearch := mock_search.NewMockIArticleSearch(ctrl)
+
+	outboxEventRepo.EXPECT().ListDeliverable(gomock.Any(), gomock.Any(), 10).Return([]*model.OutboxEvent{&event}, nil)
+	articleRepo.EXPECT().Get(gomock.Any(), article.ID).Return(&article, nil)
+	articleSearch.EXPECT().Index(gomock.Any(), article).Return(nil)
+	outboxEventRepo.EXPECT().UpdateDelivery(gomock.Any(), &event).Return(apperror.ErrUpdateRecordFailed)
+
+	svc := OutboxSvc{outboxEventRepo, articleRepo, articleSearch}
+	relayed, err := svc.RelayEvents(context.Background(), 10)
+
+	assert.Equal(t, 0, relayed)
+	assert.Equal(t, apperror.ErrUpdateRecordFailed, err)
+}
+
+func Test_RelayEvents_ReturnErr_WhenCommitFailed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	db_client.InitDatabaseMockExpectingTxn(ctrl, true, false)
+
+	outboxEventRepo := mock_repository.NewMockIOutboxEventRepository(ctrl)
+	outboxEventRepo.EXPECT().ListDeliverable(gomock.Any(), gomock.Any(), 10).Return([]*model.OutboxEvent{}, nil)
+
+	svc := OutboxSvc{outboxEventRepo: outboxEventRepo}
+	relayed, err := svc.RelayEvents(context.Background(), 10)
+
+	assert.Equal(t, 0, relayed)
+	assert.Equal(t, apperror.ErrCommitTransactionFailed, err)
+}
+
+func Test_PruneEvents_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	outboxEventRepo := mock_repository.NewMockIOutboxEventRepository(ctrl)
+	outboxEventRepo.EXPECT().DeleteFinishedBefore(gomock.Any(), gomock.Any(), 10).
+		DoAndReturn(func(_ context.Context, before time.Time, _ int) (int64, error) {
+			assert.WithinDuration(t, time.Now().Add(-time.Hour), before, time.Minute)
+			return 4, nil
+		})
+
+	svc := OutboxSvc{outboxEventRepo: outboxEventRepo}
+	pruned, err := svc.PruneEvents(context.Background(), time.Hour, 10)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 4, pruned)
+}
+
+func Test_PruneEvents_ReturnErr_WhenDeleteFailed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	outboxEventRepo := mock_repository.NewMockIOutboxEventRepository(ctrl)
+	outboxEventRepo.EXPECT().DeleteFinishedBefore(gomock.Any(), gomock.Any(), 10).Return(int64(0), apperror.ErrDeleteRecordFailed)
+
+	svc := OutboxSvc{outboxEventRepo: outboxEventRepo}
+	pruned, err := svc.PruneEvents(context.Background(), time.Hour, 10)
+
+	assert.Equal(t, 0, pruned)
+	assert.Equal(t, apperror.ErrDeleteRecordFailed, err)
+}
+
+func Test_OutboxRetryDelay_DoublesUpToMax(t *testing.T) {
+	assert.Equal(t, 5*time.Second, outboxRetryDelay(1))
+	assert.Equal(t, 10*time.Second, outboxRetryDelay(2))
+	assert.Equal(t, 40*time.Second, outboxRetryDelay(4))
+	assert.Equal(t, outboxRetryMaxDelay, outboxRetryDelay(20))
+}
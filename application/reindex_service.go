@@ -0,0 +1,160 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"article-service/apperror"
+	"article-service/db/repository"
+	"article-service/infrastructure/log"
+	"article-service/model"
+	"article-service/search"
+	"article-service/utils"
+
+	"github.com/google/uuid"
+)
+
+//go:generate mockgen -source=reindex_service.go -destination=./mock_application/reindex_service_mock.go
+type IReindexService interface {
+	Reindex(ctx context.Context, batchSize int) (*model.ReindexJob, error)
+}
+
+// reindexChangedMargin widens the catch up window, articles.updated_at is rounded to the second
+const reindexChangedMargin = time.Second
+
+type ReindexSvc struct {
+	articleRepo    repository.IArticleRepository
+	reindexJobRepo repository.IReindexJobRepository
+	articleIndexer search.IArticleIndexer
+}
+
+var reindexSvcSingleton IReindexService
+
+func InitReindexService() {
+	reindexSvcSingleton = ReindexSvc{
+		repository.GetArticleRepository(),
+		repository.GetReindexJobRepository(),
+		search.GetArticleIndexer(),
+	}
+}
+
+func GetReindexService() IReindexService {
+	return reindexSvcSingleton
+}
+
+func (svc ReindexSvc) Reindex(ctx context.Context, batchSize int) (*model.ReindexJob, error) {
+	job, err := svc.reindexJobRepo.GetRunning(ctx)
+	if err == apperror.ErrObjectNotExists {
+		job, err = svc.startJob(ctx)
+		if err != nil {
+			return nil, err
+		}
+	} else if err != nil {
+		log.Errorf(ctx, err, "[ReindexSvc][Reindex] reindexJobRepo.GetRunning is failed")
+		return nil, err
+	} else {
+		log.Infof(ctx, "[ReindexSvc][Reindex] resuming reindex into %s after article %s, %d/%d indexed", job.IndexName, job.LastArticleID, job.IndexedCount, job.TotalCount)
+	}
+
+	// the index may be missing when the previous run crashed right after saving the job
+	if err = svc.articleIndexer.CreateIndex(ctx, job.IndexName); err != nil {
+		log.Errorf(ctx, err, "[ReindexSvc][Reindex] articleIndexer.CreateIndex is failed, index: %s", job.IndexName)
+		return nil, err
+	}
+
+	for {
+		if ctx.Err() != nil {
+			log.Infof(ctx, "[ReindexSvc][Reindex] stopped at %d/%d articles, run again to resume", job.IndexedCount, job.TotalCount)
+			return job, ctx.Err()
+		}
+
+		// a batch that started is finished even on shutdown, so the saved progress matches the index
+		batchCtx := context.WithoutCancel(ctx)
+		articles, err := svc.articleRepo.ListForIndexing(batchCtx, job.LastArticleID, time.Time{}, batchSize)
+		if err != nil {
+			log.Errorf(ctx, err, "[ReindexSvc][Reindex] articleRepo.ListForIndexing is failed, after: %s", job.LastArticleID)
+			return nil, err
+		}
+		if len(articles) == 0 {
+			break
+		}
+
+		if err = svc.articleIndexer.BulkIndex(batchCtx, job.IndexName, articles); err != nil {
+			log.Errorf(ctx, err, "[ReindexSvc][Reindex] articleIndexer.BulkIndex is failed, index: %s", job.IndexName)
+			return nil, err
+		}
+
+		job.LastArticleID = articles[len(articles)-1].ID
+		job.IndexedCount += int64(len(articles))
+		if err = svc.reindexJobRepo.UpdateProgress(batchCtx, job); err != nil {
+			log.Errorf(ctx, err, "[ReindexSvc][Reindex] reindexJobRepo.UpdateProgress is failed, job: %s", job.ID)
+			return nil, err
+		}
+
+		log.Infof(ctx, "[ReindexSvc][Reindex] indexed %d/%d articles into %s", job.IndexedCount, job.TotalCount, job.IndexName)
+	}
+
+	if err = svc.articleIndexer.SwapAlias(ctx, job.IndexName); err != nil {
+		log.Errorf(ctx, err, "[ReindexSvc][Reindex] articleIndexer.SwapAlias is failed, index: %s", job.IndexName)
+		return nil, err
+	}
+
+	// articles changed while they were copied were written to the old index,
+	// now that the alias is swapped they are copied again
+	if err = svc.indexChangedSince(ctx, job.IndexName, job.StartedAt.Add(-reindexChangedMargin), batchSize); err != nil {
+		return nil, err
+	}
+
+	if err = svc.reindexJobRepo.Finish(ctx, job); err != nil {
+		log.Errorf(ctx, err, "[ReindexSvc][Reindex] reindexJobRepo.Finish is failed, job: %s", job.ID)
+		return nil, err
+	}
+
+	log.Infof(ctx, "[ReindexSvc][Reindex] finished reindex of %d articles into %s", job.IndexedCount, job.IndexName)
+	return job, nil
+}
+
+func (svc ReindexSvc) startJob(ctx context.Context) (*model.ReindexJob, error) {
+	total, err := svc.articleRepo.GetRecordsCount(ctx, repository.ArticleFilter{IncludeDeleted: true})
+	if err != nil {
+		log.Errorf(ctx, err, "[ReindexSvc][startJob] articleRepo.GetRecordsCount is failed")
+		return nil, err
+	}
+
+	now := time.Now()
+	job := &model.ReindexJob{
+		ID:         utils.GenerateUUID(),
+		IndexName:  search.NewArticleIndexName(now),
+		Status:     model.ReindexJobStatusRunning,
+		TotalCount: total,
+		StartedAt:  now,
+	}
+	if err = svc.reindexJobRepo.Create(ctx, job); err != nil {
+		log.Errorf(ctx, err, "[ReindexSvc][startJob] reindexJobRepo.Create is failed")
+		return nil, err
+	}
+
+	log.Infof(ctx, "[ReindexSvc][startJob] reindexing %d articles into %s", job.TotalCount, job.IndexName)
+	return job, nil
+}
+
+func (svc ReindexSvc) indexChangedSince(ctx context.Context, indexName string, since time.Time, batchSize int) error {
+	afterID := uuid.Nil
+	for {
+		articles, err := svc.articleRepo.ListForIndexing(ctx, afterID, since, batchSize)
+		if err != nil {
+			log.Errorf(ctx, err, "[ReindexSvc][indexChangedSince] articleRepo.ListForIndexing is failed, since: %s", since)
+			return err
+		}
+		if len(articles) == 0 {
+			return nil
+		}
+
+		if err = svc.articleIndexer.BulkIndex(ctx, indexName, articles); err != nil {
+			log.Errorf(ctx, err, "[ReindexSvc][indexChangedSince] articleIndexer.BulkIndex is failed, index: %s", indexName)
+			return err
+		}
+
+		afterID = articles[len(articles)-1].ID
+	}
+}
@@ -0,0 +1,163 @@
+package application
+
+import (
+	"article-service/apperror"
+	"article-service/db/repository"
+	"article-service/db/repository/mock_repository"
+	"article-service/factory"
+	"article-service/model"
+	"article-service/search/mock_search"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Reindex_Success_WhenNoJobIsRunning(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	article1, article2 := factory.SampleArticle1, factory.SampleArticle2
+	articleRepo := mock_repository.NewMockIArticleRepository(ctrl)
+	reindexJobRepo := mock_repository.NewMockIReindexJobRepository(ctrl)
+	articleIndexer := mock_search.NewMockIArticleIndexer(ctrl)
+
+	var indexName string
+	var startedAt time.Time
+	gomock.InOrder(
+		reindexJobRepo.EXPECT().GetRunning(gomock.Any()).Return(nil, apperror.ErrObjectNotExists),
+		articleRepo.EXPECT().GetRecordsCount(gomock.Any(), repository.ArticleFilter{IncludeDeleted: true}).Return(int64(2), nil),
+		reindexJobRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, job *model.ReindexJob) error {
+			indexName, startedAt = job.IndexName, job.StartedAt
+			return nil
+		}),
+		articleIndexer.EXPECT().CreateIndex(gomock.Any(), gomock.Any()).Return(nil),
+		articleRepo.EXPECT().ListForIndexing(gomock.Any(), uuid.Nil, time.Time{}, 1).Return([]*model.Article{&article1}, nil),
+		articleIndexer.EXPECT().BulkIndex(gomock.Any(), gomock.Any(), []*model.Article{&article1}).Return(nil),
+		reindexJobRepo.EXPECT().UpdateProgress(gomock.Any(), gomock.Any()).Return(nil),
+		articleRepo.EXPECT().ListForIndexing(gomock.Any(), article1.ID, time.Time{}, 1).Return([]*model.Article{&article2}, nil),
+		articleIndexer.EXPECT().BulkIndex(gomock.Any(), gomock.Any(), []*model.Article{&article2}).Return(nil),
+		reindexJobRepo.EXPECT().UpdateProgress(gomock.Any(), gomock.Any()).Return(nil),
+		articleRepo.EXPECT().ListForIndexing(gomock.Any(), article2.ID, time.Time{}, 1).Return([]*model.Article{}, nil),
+		articleIndexer.EXPECT().SwapAlias(gomock.Any(), gomock.Any()).Return(nil),
+		articleRepo.EXPECT().ListForIndexing(gomock.Any(), uuid.Nil, gomock.Any(), 1).Return([]*model.Article{}, nil),
+		reindexJobRepo.EXPECT().Finish(gomock.Any(), gomock.Any()).Return(nil),
+	)
+
+	svc := ReindexSvc{articleRepo, reindexJobRepo, articleIndexer}
+	job, err := svc.Reindex(context.Background(), 1)
+
+	assert.Nil(t, err)
+	assert.True(t, strings.HasPrefix(indexName, "articles_v4_"))
+	assert.Equal(t, indexName, job.IndexName)
+	assert.Equal(t, startedAt, job.StartedAt)
+	assert.Equal(t, article2.ID, job.LastArticleID)
+	assert.Equal(t, int64(2), job.IndexedCount)
+}
+
+func Test_Reindex_Success_ResumesRunningJob(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	article1, article2 := factory.SampleArticle1, factory.SampleArticle2
+	running := &model.ReindexJob{
+		ID:            uuid.New(),
+		IndexName:     "articles_v1_20251017090000",
+		Status:        model.ReindexJobStatusRunning,
+		LastArticleID: article1.ID,
+		IndexedCount:  1,
+		TotalCount:    2,
+		StartedAt:     time.Date(2025, 10, 17, 9, 0, 0, 0, time.UTC),
+	}
+
+	articleRepo := mock_repository.NewMockIArticleRepository(ctrl)
+	reindexJobRepo := mock_repository.NewMockIReindexJobRepository(ctrl)
+	articleIndexer := mock_search.NewMockIArticleIndexer(ctrl)
+
+	changedSince := running.StartedAt.Add(-time.Second)
+	gomock.InOrder(
+		reindexJobRepo.EXPECT().GetRunning(gomock.Any()).Return(running, nil),
+		articleIndexer.EXPECT().CreateIndex(gomock.Any(), running.IndexName).Return(nil),
+		articleRepo.EXPECT().ListForIndexing(gomock.Any(), article1.ID, time.Time{}, 10).Return([]*model.Article{&article2}, nil),
+		articleIndexer.EXPECT().BulkIndex(gomock.Any(), running.IndexName, []*model.Article{&article2}).Return(nil),
+		reindexJobRepo.EXPECT().UpdateProgress(gomock.Any(), running).Return(nil),
+		articleRepo.EXPECT().ListForIndexing(gomock.Any(), article2.ID, time.Time{}, 10).Return([]*model.Article{}, nil),
+		articleIndexer.EXPECT().SwapAlias(gomock.Any(), running.IndexName).Return(nil),
+		articleRepo.EXPECT().ListForIndexing(gomock.Any(), uuid.Nil, changedSince, 10).Return([]*model.Article{&article1}, nil),
+		articleIndexer.EXPECT().BulkIndex(gomock.Any(), running.IndexName, []*model.Article{&article1}).Return(nil),
+		articleRepo.EXPECT().ListForIndexing(gomock.Any(), article1.ID, changedSince, 10).Return([]*model.Article{}, nil),
+		reindexJobRepo.EXPECT().Finish(gomock.Any(), running).Return(nil),
+	)
+
+	svc := ReindexSvc{articleRepo, reindexJobRepo, articleIndexer}
+	job, err := svc.Reindex(context.Background(), 10)
+
+	assert.Nil(t, err)
+	assert.Equal(t, article2.ID, job.LastArticleID)
+	assert.Equal(t, int64(2), job.IndexedCount)
+}
+
+func Test_Reindex_StopsWithoutSwapping_WhenCancelled(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	running := &model.ReindexJob{
+		ID:        uuid.New(),
+		IndexName: "articles_v1_20251017090000",
+		Status:    model.ReindexJobStatusRunning,
+	}
+
+	articleRepo := mock_repository.NewMockIArticleRepository(ctrl)
+	reindexJobRepo := mock_repository.NewMockIReindexJobRepository(ctrl)
+	articleIndexer := mock_search.NewMockIArticleIndexer(ctrl)
+	reindexJobRepo.EXPECT().GetRunning(gomock.Any()).Return(running, nil)
+	articleIndexer.EXPECT().CreateIndex(gomock.Any(), running.IndexName).Return(nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	svc := ReindexSvc{articleRepo, reindexJobRepo, articleIndexer}
+	job, err := svc.Reindex(ctx, 10)
+
+	assert.Equal(t, context.Canceled, err)
+	assert.Equal(t, running, job)
+}
+
+func Test_Reindex_ReturnErr_WhenBulkIndexFailed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	article := factory.SampleArticle1
+	running := &model.ReindexJob{
+		ID:        uuid.New(),
+		IndexName: "articles_v1_20251017090000",
+		Status:    model.ReindexJobStatusRunning,
+	}
+
+	articleRepo := mock_repository.NewMockIArticleRepository(ctrl)
+	reindexJobRepo := mock_repository.NewMockIReindexJobRepository(ctrl)
+	articleIndexer := mock_search.NewMockIArticleIndexer(ctrl)
+	reindexJobRepo.EXPECT().GetRunning(gomock.Any()).Return(running, nil)
+	articleIndexer.EXPECT().CreateIndex(gomock.Any(), running.IndexName).Return(nil)
+	articleRepo.EXPECT().ListForIndexing(gomock.Any(), uuid.Nil, time.Time{}, 10).Return([]*model.Article{&article}, nil)
+	articleIndexer.EXPECT().BulkIndex(gomock.Any(), running.IndexName, gomock.Any()).Return(apperror.ErrIndexElasticFailed)
+
+	svc := ReindexSvc{articleRepo, reindexJobRepo, articleIndexer}
+	job, err := svc.Reindex(context.Background(), 10)
+
+	assert.Nil(t, job)
+	assert.Equal(t, apperror.ErrIndexElasticFailed, err)
+}
+
+func Test_Reindex_ReturnErr_WhenGetRunningFailed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	reindexJobRepo := mock_repository.NewMockIReindexJobRepository(ctrl)
+	reindexJobRepo.EXPECT().GetRunning(gomock.Any()).Return(nil, apperror.ErrGetRecordFailed)
+
+	svc := ReindexSvc{reindexJobRepo: reindexJobRepo}
+	job, err := svc.Reindex(context.Background(), 10)
+
+	assert.Nil(t, job)
+	assert.Equal(t, apperror.ErrGetRecordFailed, err)
+}
@@ -0,0 +1,40 @@
+package application
+
+import (
+	"context"
+
+	"article-service/db/repository"
+	"article-service/infrastructure/log"
+	"article-service/model"
+)
+
+//go:generate mockgen -source=tag_service.go -destination=./mock_application/tag_service_mock.go
+type ITagService interface {
+	ListTags(ctx context.Context) ([]*model.Tag, error)
+}
+
+type TagSvc struct {
+	tagRepo repository.ITagRepository
+}
+
+var tagSvcSingleton ITagService
+
+func InitTagService() {
+	tagSvcSingleton = TagSvc{
+		repository.GetTagRepository(),
+	}
+}
+
+func GetTagService() ITagService {
+	return tagSvcSingleton
+}
+
+func (svc TagSvc) ListTags(ctx context.Context) ([]*model.Tag, error) {
+	tags, err := svc.tagRepo.ListWithArticlesCount(ctx)
+	if err != nil {
+		log.Errorf(ctx, err, "[TagSvc][ListTags] tagRepo.ListWithArticlesCount is failed")
+		return nil, err
+	}
+
+	return tags, nil
+}
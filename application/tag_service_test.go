@@ -0,0 +1,39 @@
+package application
+
+import (
+	"article-service/apperror"
+	"article-service/db/repository/mock_repository"
+	"article-service/model"
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ListTags_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	tags := []*model.Tag{{Name: "keluarga", ArticlesCount: 2}, {Name: "puisi", ArticlesCount: 1}}
+	tagRepo := mock_repository.NewMockITagRepository(ctrl)
+	tagRepo.EXPECT().ListWithArticlesCount(gomock.Any()).Return(tags, nil)
+
+	svc := TagSvc{tagRepo: tagRepo}
+	result, err := svc.ListTags(context.Background())
+
+	assert.Nil(t, err)
+	assert.Equal(t, tags, result)
+}
+
+func Test_ListTags_ReturnErr_WhenRepoFailed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	tagRepo := mock_repository.NewMockITagRepository(ctrl)
+	tagRepo.EXPECT().ListWithArticlesCount(gomock.Any()).Return(nil, apperror.ErrGetRecordFailed)
+
+	svc := TagSvc{tagRepo: tagRepo}
+	result, err := svc.ListTags(context.Background())
+
+	assert.Nil(t, result)
+	assert.Equal(t, apperror.ErrGetRecordFailed, err)
+}
@@ -1,13 +1,18 @@
 package configloader
 
+import "time"
+
 type RootConfig struct {
-	AppConfig     `mapstructure:"app"`
-	DbConfig      `mapstructure:"db"`
-	ElasticConfig `mapstructure:"elastic"`
+	AppConfig       `mapstructure:"app"`
+	DbConfig        `mapstructure:"db"`
+	ElasticConfig   `mapstructure:"elastic"`
+	SearchConfig    `mapstructure:"search"`
+	SchedulerConfig `mapstructure:"scheduler"`
 }
 
 type AppConfig struct {
-	Port string `mapstructure:"port"`
+	Port       string `mapstructure:"port"`
+	AdminToken string `mapstructure:"admin_token"`
 }
 
 type DbConfig struct {
@@ -19,6 +24,27 @@ type DbConfig struct {
 	TestDbName string `mapstructure:"test_dbname"`
 }
 
+type SchedulerConfig struct {
+	PublishInterval  time.Duration `mapstructure:"publish_interval"`
+	PublishBatchSize int           `mapstructure:"publish_batch_size"`
+	OutboxInterval   time.Duration `mapstructure:"outbox_interval"`
+	OutboxBatchSize  int           `mapstructure:"outbox_batch_size"`
+	OutboxRetention  time.Duration `mapstructure:"outbox_retention"`
+}
+
+type SearchConfig struct {
+	// Backend is "elasticsearch", the default, "postgres" or "memory"
+	Backend string `mapstructure:"backend"`
+}
+
 type ElasticConfig struct {
-	URL string `json:"url"`
+	URL       string          `json:"url"`
+	Highlight HighlightConfig `mapstructure:"highlight"`
+}
+
+type HighlightConfig struct {
+	PreTag            string `mapstructure:"pre_tag"`
+	PostTag           string `mapstructure:"post_tag"`
+	FragmentSize      int    `mapstructure:"fragment_size"`
+	NumberOfFragments int    `mapstructure:"number_of_fragments"`
 }
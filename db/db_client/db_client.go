@@ -293,7 +293,8 @@ func TruncateTestDB(ctx context.Context, config configloader.DbConfig) error {
 	}
 	defer db.Close()
 
-	db.Exec("TRUNCATE articles, authors RESTART IDENTITY CASCADE")
+	// truncating skips the row triggers, so the words counted from the search documents go too
+	db.Exec("TRUNCATE articles, authors, article_search_words RESTART IDENTITY CASCADE")
 
 	return nil
 }
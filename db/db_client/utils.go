@@ -1,11 +1,20 @@
 package db_client
 
 import (
+	"errors"
 	"fmt"
 	"regexp"
 	"strconv"
 	"strings"
 	"time"
+
+	"github.com/lib/pq"
+)
+
+// Postgres error codes, see https://www.postgresql.org/docs/current/errcodes-appendix.html
+const (
+	pqForeignKeyViolation = "23503"
+	pqUniqueViolation     = "23505"
 )
 
 func normalizeWhitespace(s string) string {
@@ -21,3 +30,19 @@ func generateTransactionID() string {
 	// trim the leading 5 chars, since they're the most-significant bits that are mostly the same
 	return fmt.Sprintf("txnID::%s", base36[5:])
 }
+
+func IsForeignKeyViolation(err error) bool {
+	return hasPqErrorCode(err, pqForeignKeyViolation)
+}
+
+func IsUniqueViolation(err error) bool {
+	return hasPqErrorCode(err, pqUniqueViolation)
+}
+
+func hasPqErrorCode(err error, code pq.ErrorCode) bool {
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) {
+		return pqErr.Code == code
+	}
+	return false
+}
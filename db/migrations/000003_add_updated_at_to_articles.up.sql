@@ -0,0 +1,2 @@
+ALTER TABLE "articles" ADD COLUMN "updated_at" TIMESTAMPTZ(0) NOT NULL DEFAULT NOW();
+UPDATE "articles" SET "updated_at" = "created_at";
@@ -0,0 +1,2 @@
+ALTER TABLE "articles" ADD COLUMN "deleted_at" TIMESTAMPTZ(0);
+CREATE INDEX idx_articles_on_deleted_at ON articles("deleted_at");
@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_authors_on_handle;
+ALTER TABLE "authors"
+  DROP COLUMN "handle",
+  DROP COLUMN "bio",
+  DROP COLUMN "avatar_url",
+  DROP COLUMN "website";
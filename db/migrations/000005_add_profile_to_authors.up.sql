@@ -0,0 +1,29 @@
+ALTER TABLE "authors"
+  ADD COLUMN "handle" varchar(50),
+  ADD COLUMN "bio" text NOT NULL DEFAULT '',
+  ADD COLUMN "avatar_url" varchar(2048) NOT NULL DEFAULT '',
+  ADD COLUMN "website" varchar(2048) NOT NULL DEFAULT '';
+
+-- Backfill handles from the author name, cut to leave room for a suffix within varchar(50).
+-- Authors are handled one by one, so a suffixed handle never collides with a handle taken before.
+DO $$
+DECLARE
+  author RECORD;
+  base text;
+  candidate text;
+  n int;
+BEGIN
+  FOR author IN SELECT "id", "name" FROM "authors" ORDER BY "id" LOOP
+    base := COALESCE(NULLIF(TRIM(BOTH '-' FROM LOWER(REGEXP_REPLACE(LEFT(author.name, 40), '[^a-zA-Z0-9]+', '-', 'g'))), ''), 'author');
+    candidate := base;
+    n := 1;
+    WHILE EXISTS (SELECT 1 FROM "authors" WHERE "handle" = candidate) LOOP
+      n := n + 1;
+      candidate := base || '-' || n;
+    END LOOP;
+    UPDATE "authors" SET "handle" = candidate WHERE "id" = author.id;
+  END LOOP;
+END $$;
+
+ALTER TABLE "authors" ALTER COLUMN "handle" SET NOT NULL;
+CREATE UNIQUE INDEX idx_authors_on_handle ON authors("handle");
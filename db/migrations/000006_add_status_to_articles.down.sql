@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_articles_on_status;
+ALTER TABLE "articles"
+  DROP COLUMN "status",
+  DROP COLUMN "published_at";
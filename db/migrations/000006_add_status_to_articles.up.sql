@@ -0,0 +1,8 @@
+ALTER TABLE "articles"
+  ADD COLUMN "status" varchar(20) NOT NULL DEFAULT 'draft' CHECK ("status" IN ('draft', 'published', 'archived')),
+  ADD COLUMN "published_at" TIMESTAMPTZ(0);
+
+-- Articles created before the lifecycle existed were already public
+UPDATE "articles" SET "status" = 'published', "published_at" = "created_at";
+
+CREATE INDEX idx_articles_on_status ON articles("status");
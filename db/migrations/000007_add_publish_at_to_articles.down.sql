@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_articles_on_publish_at;
+ALTER TABLE "articles" DROP COLUMN "publish_at";
@@ -0,0 +1,4 @@
+ALTER TABLE "articles" ADD COLUMN "publish_at" TIMESTAMPTZ(0);
+
+CREATE INDEX idx_articles_on_publish_at ON articles("publish_at")
+  WHERE "status" = 'draft' AND "publish_at" IS NOT NULL AND "deleted_at" IS NULL;
@@ -0,0 +1,16 @@
+CREATE TABLE "article_revisions" (
+  "id" uuid PRIMARY KEY DEFAULT generate_uuid_v7(),
+  "article_id" uuid NOT NULL,
+  "revision" integer NOT NULL,
+  "title" varchar(255) NOT NULL,
+  "body" text NOT NULL,
+  "author_id" uuid NOT NULL,
+  "created_at" TIMESTAMPTZ(0) NOT NULL DEFAULT NOW(),
+  FOREIGN KEY ("article_id") REFERENCES articles("id"),
+  FOREIGN KEY ("author_id") REFERENCES authors("id")
+);
+CREATE UNIQUE INDEX idx_article_revisions_on_article_id_and_revision ON article_revisions("article_id", "revision");
+
+-- The current content of every existing article becomes its first revision
+INSERT INTO "article_revisions" ("article_id", "revision", "title", "body", "author_id", "created_at")
+SELECT "id", 1, "title", "body", "author_id", "updated_at" FROM "articles";
@@ -0,0 +1,15 @@
+CREATE TABLE "tags" (
+  "id" uuid PRIMARY KEY DEFAULT generate_uuid_v7(),
+  "name" varchar(30) NOT NULL,
+  "created_at" TIMESTAMPTZ(0) NOT NULL DEFAULT NOW()
+);
+CREATE UNIQUE INDEX idx_tags_on_name ON tags("name");
+
+CREATE TABLE "article_tags" (
+  "article_id" uuid NOT NULL,
+  "tag_id" uuid NOT NULL,
+  PRIMARY KEY ("article_id", "tag_id"),
+  FOREIGN KEY ("article_id") REFERENCES articles("id") ON DELETE CASCADE,
+  FOREIGN KEY ("tag_id") REFERENCES tags("id") ON DELETE CASCADE
+);
+CREATE INDEX idx_article_tags_on_tag_id ON article_tags("tag_id");
@@ -0,0 +1,13 @@
+CREATE TABLE "categories" (
+  "id" uuid PRIMARY KEY DEFAULT generate_uuid_v7(),
+  "parent_id" uuid,
+  "name" varchar(100) NOT NULL,
+  "created_at" TIMESTAMPTZ(0) NOT NULL DEFAULT NOW(),
+  FOREIGN KEY ("parent_id") REFERENCES categories("id")
+);
+CREATE INDEX idx_categories_on_parent_id ON categories("parent_id");
+-- Sibling categories have unique names, root categories share the nil parent
+CREATE UNIQUE INDEX idx_categories_on_parent_id_and_name ON categories(COALESCE("parent_id", '00000000-0000-0000-0000-000000000000'::uuid), "name");
+
+ALTER TABLE "articles" ADD COLUMN "category_id" uuid REFERENCES categories("id");
+CREATE INDEX idx_articles_on_category_id ON articles("category_id");
@@ -0,0 +1,35 @@
+ALTER TABLE "articles" ADD COLUMN "slug" varchar(120);
+
+-- Backfill slugs from the article title, suffixing duplicates with a sequence number
+UPDATE "articles" SET "slug" = slugs.slug
+FROM (
+  SELECT
+    id,
+    base || CASE
+      WHEN ROW_NUMBER() OVER (PARTITION BY base ORDER BY id) > 1
+      THEN '-' || ROW_NUMBER() OVER (PARTITION BY base ORDER BY id)
+      ELSE ''
+    END AS slug
+  FROM (
+    SELECT
+      id,
+      COALESCE(NULLIF(TRIM(BOTH '-' FROM LOWER(REGEXP_REPLACE(LEFT(title, 100), '[^a-zA-Z0-9]+', '-', 'g'))), ''), 'article') AS base
+    FROM "articles"
+  ) AS bases
+) AS slugs
+WHERE "articles"."id" = slugs.id;
+
+ALTER TABLE "articles" ALTER COLUMN "slug" SET NOT NULL;
+CREATE UNIQUE INDEX idx_articles_on_slug ON articles("slug");
+
+-- Every slug an article has ever had, so old links keep resolving to it
+CREATE TABLE "article_slugs" (
+  "slug" varchar(120) PRIMARY KEY,
+  "article_id" uuid NOT NULL,
+  "created_at" TIMESTAMPTZ(0) NOT NULL DEFAULT NOW(),
+  FOREIGN KEY ("article_id") REFERENCES articles("id") ON DELETE CASCADE
+);
+CREATE INDEX idx_article_slugs_on_article_id ON article_slugs("article_id");
+
+INSERT INTO "article_slugs" ("slug", "article_id", "created_at")
+SELECT "slug", "id", "created_at" FROM "articles";
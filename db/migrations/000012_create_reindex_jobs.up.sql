@@ -0,0 +1,14 @@
+CREATE TABLE "reindex_jobs" (
+  "id" uuid PRIMARY KEY DEFAULT generate_uuid_v7(),
+  "index_name" varchar(255) NOT NULL,
+  "status" varchar(20) NOT NULL DEFAULT 'running',
+  "last_article_id" uuid,
+  "indexed_count" bigint NOT NULL DEFAULT 0,
+  "total_count" bigint NOT NULL DEFAULT 0,
+  "started_at" TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  "updated_at" TIMESTAMPTZ(0) NOT NULL DEFAULT NOW(),
+  "finished_at" TIMESTAMPTZ(0)
+);
+
+-- At most one reindex runs at a time, a restarted reindex resumes it
+CREATE UNIQUE INDEX idx_reindex_jobs_on_running_status ON reindex_jobs("status") WHERE "status" = 'running';
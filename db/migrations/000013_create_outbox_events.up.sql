@@ -0,0 +1,13 @@
+CREATE TABLE "outbox_events" (
+  "id" uuid PRIMARY KEY DEFAULT generate_uuid_v7(),
+  "aggregate_id" uuid NOT NULL,
+  "event_type" varchar(50) NOT NULL,
+  "status" varchar(20) NOT NULL DEFAULT 'pending',
+  "attempts" integer NOT NULL DEFAULT 0,
+  "last_error" text NOT NULL DEFAULT '',
+  "available_at" TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  "created_at" TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  "delivered_at" TIMESTAMPTZ
+);
+CREATE INDEX idx_outbox_events_on_pending_available_at ON outbox_events("available_at") WHERE "status" = 'pending';
+CREATE INDEX idx_outbox_events_on_pending_aggregate_id ON outbox_events("aggregate_id", "id") WHERE "status" = 'pending';
@@ -0,0 +1,45 @@
+-- Search documents for the postgres search backend, written by the outbox relay like the Elasticsearch index.
+-- Tags are stored space separated, so every column can be turned into a tsvector by an immutable expression.
+CREATE TABLE "article_search_documents" (
+  "article_id" uuid PRIMARY KEY,
+  "title" varchar(255) NOT NULL,
+  "body" text NOT NULL,
+  "author_name" varchar(255) NOT NULL,
+  "tags" text NOT NULL DEFAULT '',
+  "created_at" TIMESTAMPTZ(0) NOT NULL,
+  "title_vector" tsvector GENERATED ALWAYS AS (to_tsvector('simple', "title")) STORED,
+  "body_vector" tsvector GENERATED ALWAYS AS (to_tsvector('simple', "body")) STORED,
+  "author_vector" tsvector GENERATED ALWAYS AS (to_tsvector('simple', "author_name")) STORED,
+  "tags_vector" tsvector GENERATED ALWAYS AS (to_tsvector('simple', "tags")) STORED,
+  "document" tsvector GENERATED ALWAYS AS (
+    setweight(to_tsvector('simple', "title"), 'A') ||
+    setweight(to_tsvector('simple', "tags"), 'B') ||
+    setweight(to_tsvector('simple', "author_name"), 'C') ||
+    setweight(to_tsvector('simple', "body"), 'D')
+  ) STORED,
+  FOREIGN KEY ("article_id") REFERENCES articles("id") ON DELETE CASCADE
+);
+CREATE INDEX idx_article_search_documents_on_document ON article_search_documents USING GIN ("document");
+CREATE INDEX idx_article_search_documents_on_title_vector ON article_search_documents USING GIN ("title_vector");
+CREATE INDEX idx_article_search_documents_on_body_vector ON article_search_documents USING GIN ("body_vector");
+CREATE INDEX idx_article_search_documents_on_author_vector ON article_search_documents USING GIN ("author_vector");
+CREATE INDEX idx_article_search_documents_on_tags_vector ON article_search_documents USING GIN ("tags_vector");
+
+-- Only published articles that are not deleted are searchable
+INSERT INTO "article_search_documents" ("article_id", "title", "body", "author_name", "tags", "created_at")
+SELECT
+  "articles"."id",
+  "articles"."title",
+  "articles"."body",
+  "authors"."name",
+  ARRAY_TO_STRING(ARRAY(
+    SELECT "tags"."name"
+    FROM "article_tags"
+    JOIN "tags" ON "article_tags"."tag_id" = "tags"."id"
+    WHERE "article_tags"."article_id" = "articles"."id"
+    ORDER BY "tags"."name"
+  ), ' '),
+  "articles"."created_at"
+FROM "articles"
+JOIN "authors" ON "articles"."author_id" = "authors"."id"
+WHERE "articles"."status" = 'published' AND "articles"."deleted_at" IS NULL;
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_article_search_documents_on_author_name_trgm;
+DROP INDEX IF EXISTS idx_article_search_documents_on_title_trgm;
@@ -0,0 +1,4 @@
+-- Trigram indexes let the postgres search backend complete titles and author names by prefix and with typos
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+CREATE INDEX idx_article_search_documents_on_title_trgm ON article_search_documents USING GIN ("title" gin_trgm_ops);
+CREATE INDEX idx_article_search_documents_on_author_name_trgm ON article_search_documents USING GIN ("author_name" gin_trgm_ops);
@@ -0,0 +1 @@
+ALTER TABLE "authors" DROP COLUMN "updated_at";
@@ -0,0 +1 @@
+ALTER TABLE "authors" ADD COLUMN "updated_at" TIMESTAMPTZ(0) NOT NULL DEFAULT NOW();
@@ -0,0 +1 @@
+DROP INDEX IF EXISTS idx_outbox_events_on_finished_created_at;
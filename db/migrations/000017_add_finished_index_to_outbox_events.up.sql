@@ -0,0 +1 @@
+CREATE INDEX idx_outbox_events_on_finished_created_at ON outbox_events("created_at") WHERE "status" <> 'pending';
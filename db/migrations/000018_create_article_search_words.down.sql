@@ -0,0 +1,3 @@
+DROP TRIGGER IF EXISTS count_article_search_words ON "article_search_documents";
+DROP FUNCTION IF EXISTS count_article_search_words();
+DROP TABLE IF EXISTS "article_search_words";
@@ -0,0 +1,50 @@
+-- Words of the search documents with the number of documents holding them, so the postgres search backend
+-- looks up similar words through a trigram index instead of running ts_stat over every document
+CREATE TABLE "article_search_words" (
+  "word" text PRIMARY KEY,
+  "ndoc" integer NOT NULL
+);
+CREATE INDEX idx_article_search_words_on_word_trgm ON article_search_words USING GIN ("word" gin_trgm_ops);
+
+CREATE OR REPLACE FUNCTION public.count_article_search_words()
+RETURNS trigger
+LANGUAGE plpgsql
+AS $function$
+DECLARE
+    old_words TEXT[] := '{}';
+    new_words TEXT[] := '{}';
+BEGIN
+    IF TG_OP IN ('UPDATE', 'DELETE') THEN
+        old_words := tsvector_to_array(OLD."document");
+    END IF;
+    IF TG_OP IN ('INSERT', 'UPDATE') THEN
+        new_words := tsvector_to_array(NEW."document");
+    END IF;
+
+    -- words are upserted in order, so concurrent writers lock them in the same order
+    INSERT INTO "article_search_words" ("word", "ndoc")
+    SELECT changes.word, SUM(changes.delta)
+    FROM (
+        SELECT unnest(old_words) AS word, -1 AS delta
+        UNION ALL
+        SELECT unnest(new_words), 1
+    ) AS changes
+    GROUP BY changes.word
+    HAVING SUM(changes.delta) <> 0
+    ORDER BY changes.word
+    ON CONFLICT ("word") DO UPDATE SET "ndoc" = "article_search_words"."ndoc" + EXCLUDED."ndoc";
+
+    DELETE FROM "article_search_words"
+    WHERE "word" = ANY(old_words) AND "ndoc" <= 0;
+
+    RETURN NULL;
+END;
+$function$;
+
+CREATE TRIGGER count_article_search_words
+AFTER INSERT OR DELETE OR UPDATE OF "title", "body", "author_name", "tags" ON "article_search_documents"
+FOR EACH ROW EXECUTE FUNCTION count_article_search_words();
+
+INSERT INTO "article_search_words" ("word", "ndoc")
+SELECT "word", "ndoc"
+FROM ts_stat('SELECT document FROM article_search_documents');
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"article-service/model"
 
@@ -11,15 +12,35 @@ import (
 //go:generate mockgen -source=article_repo.go -destination=./mock_repository/article_repo_mock.go
 type IArticleRepository interface {
 	Create(ctx context.Context, article *model.Article) error
+	Get(ctx context.Context, id uuid.UUID) (*model.Article, error)
+	GetForUpdate(ctx context.Context, id uuid.UUID) (*model.Article, error)
+	Update(ctx context.Context, article *model.Article) error
+	UpdateStatus(ctx context.Context, article *model.Article) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	Restore(ctx context.Context, id uuid.UUID) error
 	List(ctx context.Context, filter ArticleFilter) ([]*model.Article, error)
 	GetRecordsCount(ctx context.Context, filter ArticleFilter) (int64, error)
+	CountFacets(ctx context.Context, filter ArticleFilter, facets []string) (model.ArticleFacets, error)
+	ListDueForPublishing(ctx context.Context, now time.Time, limit int) ([]*model.Article, error)
+	ListForIndexing(ctx context.Context, afterID uuid.UUID, changedSince time.Time, limit int) ([]*model.Article, error)
 }
 
+// without SortBy, articles matching Ids are listed in the order of Ids. SortDirection defaults to desc
 type ArticleFilter struct {
-	Ids           []uuid.UUID
-	AuthorName    string
-	SortBy        string
-	SortDirection string
-	Limit         int
-	Offset        int
+	Ids              []uuid.UUID
+	AuthorID         uuid.UUID
+	AuthorIDs        []uuid.UUID
+	ExcludeAuthorIDs []uuid.UUID
+	AuthorName       string
+	CategoryID       uuid.UUID
+	Tags             []string
+	MatchAllTags     bool
+	Status           model.ArticleStatus
+	CreatedFrom      time.Time
+	CreatedTo        time.Time
+	IncludeDeleted   bool
+	SortBy           string
+	SortDirection    string
+	Limit            int
+	Offset           int
 }
@@ -2,7 +2,9 @@ package repository
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
@@ -12,6 +14,9 @@ import (
 	"article-service/infrastructure/log"
 	"article-service/model"
 	"article-service/utils"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
 )
 
 type ArticleRepo struct {
@@ -26,20 +31,30 @@ func (r ArticleRepo) Create(ctx context.Context, article *model.Article) error {
 
 	query := `
 		INSERT INTO articles
-			(id, title, body, author_id, created_at)
-		VALUES ($1, $2, $3, $4, $5)
+			(id, title, slug, body, author_id, created_at, updated_at, status, published_at, category_id)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
 	`
 
+	now := time.Now()
 	res, err := conn.Exec(
 		ctx,
 		query,
 		&article.ID,
 		&article.Title,
+		&article.Slug,
 		&article.Body,
 		&article.Author.ID,
-		time.Now(),
+		now,
+		now,
+		&article.Status,
+		&article.PublishedAt,
+		article.CategoryID(),
 	)
 	if err != nil {
+		if db_client.IsUniqueViolation(err) {
+			log.Errorf(ctx, err, "[ArticleRepo][Create] Slug is already taken")
+			return apperror.ErrArticleSlugTaken
+		}
 		log.Errorf(ctx, err, "[ArticleRepo][Create] Exec failed")
 		return apperror.ErrCreateRecordFailed
 	}
@@ -53,54 +68,261 @@ func (r ArticleRepo) Create(ctx context.Context, article *model.Article) error {
 	return nil
 }
 
-func (r ArticleRepo) List(ctx context.Context, filter ArticleFilter) ([]*model.Article, error) {
+func (r ArticleRepo) Get(ctx context.Context, id uuid.UUID) (*model.Article, error) {
+	return r.get(ctx, id, "Get", "")
+}
+
+// GetForUpdate locks the article row until the transaction ends, so concurrent updates
+// read the changes of each other instead of overwriting them
+func (r ArticleRepo) GetForUpdate(ctx context.Context, id uuid.UUID) (*model.Article, error) {
+	return r.get(ctx, id, "GetForUpdate", "FOR UPDATE OF articles")
+}
+
+func (r ArticleRepo) get(ctx context.Context, id uuid.UUID, method, lock string) (*model.Article, error) {
 	conn := transaction.GetClientOrTxn(ctx, db_client.GetDB)
 	query := `
 		SELECT
 			articles.id,
 			articles.title,
+			articles.slug,
 			articles.body,
 			articles.created_at,
+			articles.updated_at,
+			articles.status,
+			articles.published_at,
+			articles.publish_at,
+			articles.deleted_at,
 			authors.id AS author_id,
-			authors.name AS author_name
+			authors.name AS author_name,
+			authors.handle AS author_handle,
+			categories.id AS category_id,
+			categories.name AS category_name,
+			ARRAY(
+				SELECT tags.name
+				FROM article_tags
+				JOIN tags ON article_tags.tag_id = tags.id
+				WHERE article_tags.article_id = articles.id
+				ORDER BY tags.name
+			) AS tags
 		FROM articles
 		JOIN authors ON articles.author_id = authors.id
-		{{whereFilters}}
-		{{orderBy}}
-		{{limitAndOffset}}
-	`
+		LEFT JOIN categories ON articles.category_id = categories.id
+		WHERE articles.id = $1
+	` + lock
 
-	var params []interface{}
-	var whereFilters []string
+	rows, err := conn.Query(ctx, query, id)
+	if err != nil {
+		log.Errorf(ctx, err, "[ArticleRepo][%s] Query failed", method)
+		return nil, apperror.ErrGetRecordFailed
+	}
 
-	if len(filter.Ids) > 0 {
-		idsQuery := "articles.id IN ("
-		ids := []string{}
-		for _, id := range filter.Ids {
-			params = append(params, id)
-			ids = append(ids, fmt.Sprintf("$%d", len(params)))
+	var article = model.Article{}
+	for rows.Next() {
+		var categoryID uuid.NullUUID
+		var categoryName sql.NullString
+		err = rows.Scan(
+			&article.ID,
+			&article.Title,
+			&article.Slug,
+			&article.Body,
+			&article.CreatedAt,
+			&article.UpdatedAt,
+			&article.Status,
+			&article.PublishedAt,
+			&article.PublishAt,
+			&article.DeletedAt,
+			&article.Author.ID,
+			&article.Author.Name,
+			&article.Author.Handle,
+			&categoryID,
+			&categoryName,
+			pq.Array(&article.Tags),
+		)
+		if err != nil {
+			log.Errorf(ctx, err, "[ArticleRepo][%s] Scan failed", method)
+			return nil, apperror.ErrScanRecordFailed
 		}
-		idsQuery += strings.Join(ids, ", ") + ")"
-		whereFilters = append(whereFilters, idsQuery)
+		article.Category = articleCategory(categoryID, categoryName)
 	}
 
-	if filter.AuthorName != "" {
-		params = append(params, "%"+filter.AuthorName+"%")
-		whereFilters = append(whereFilters, fmt.Sprintf("LOWER(authors.name) LIKE LOWER($%d)", len(params)))
+	if article.ID == uuid.Nil {
+		return nil, apperror.ErrObjectNotExists
+	}
+
+	return &article, nil
+}
+
+func (r ArticleRepo) Update(ctx context.Context, article *model.Article) error {
+	conn := transaction.GetClientOrTxn(ctx, db_client.GetDB)
+
+	query := `
+		UPDATE articles
+		SET title = $1, slug = $2, body = $3, author_id = $4, category_id = $5, updated_at = $6
+		WHERE id = $7
+	`
+
+	now := time.Now()
+	res, err := conn.Exec(
+		ctx,
+		query,
+		&article.Title,
+		&article.Slug,
+		&article.Body,
+		&article.Author.ID,
+		article.CategoryID(),
+		now,
+		&article.ID,
+	)
+	if err != nil {
+		if db_client.IsUniqueViolation(err) {
+			log.Errorf(ctx, err, "[ArticleRepo][Update] Slug is already taken")
+			return apperror.ErrArticleSlugTaken
+		}
+		log.Errorf(ctx, err, "[ArticleRepo][Update] Exec failed")
+		return apperror.ErrUpdateRecordFailed
+	}
+
+	affected, _ := res.RowsAffected()
+	if affected == 0 {
+		log.Errorf(ctx, err, "[ArticleRepo][Update] No affected rows")
+		return apperror.ErrNoAffectedRows
+	}
+
+	article.UpdatedAt = now
+	return nil
+}
+
+func (r ArticleRepo) UpdateStatus(ctx context.Context, article *model.Article) error {
+	conn := transaction.GetClientOrTxn(ctx, db_client.GetDB)
+
+	query := `
+		UPDATE articles
+		SET status = $1, published_at = $2, publish_at = $3, updated_at = $4
+		WHERE id = $5
+	`
+
+	now := time.Now()
+	res, err := conn.Exec(
+		ctx,
+		query,
+		&article.Status,
+		&article.PublishedAt,
+		&article.PublishAt,
+		now,
+		&article.ID,
+	)
+	if err != nil {
+		log.Errorf(ctx, err, "[ArticleRepo][UpdateStatus] Exec failed")
+		return apperror.ErrUpdateRecordFailed
+	}
+
+	affected, _ := res.RowsAffected()
+	if affected == 0 {
+		log.Errorf(ctx, err, "[ArticleRepo][UpdateStatus] No affected rows")
+		return apperror.ErrNoAffectedRows
+	}
+
+	article.UpdatedAt = now
+	return nil
+}
+
+func (r ArticleRepo) Delete(ctx context.Context, id uuid.UUID) error {
+	conn := transaction.GetClientOrTxn(ctx, db_client.GetDB)
+
+	query := `
+		UPDATE articles
+		SET deleted_at = $1, updated_at = $1
+		WHERE id = $2 AND deleted_at IS NULL
+	`
+
+	res, err := conn.Exec(ctx, query, time.Now(), id)
+	if err != nil {
+		log.Errorf(ctx, err, "[ArticleRepo][Delete] Exec failed")
+		return apperror.ErrUpdateRecordFailed
 	}
 
-	if len(whereFilters) != 0 {
-		filters := "WHERE " + strings.Join(whereFilters, " AND ")
-		query = strings.ReplaceAll(query, "{{whereFilters}}", filters)
-	} else {
-		query = strings.ReplaceAll(query, "{{whereFilters}}", "")
+	affected, _ := res.RowsAffected()
+	if affected == 0 {
+		log.Errorf(ctx, err, "[ArticleRepo][Delete] No affected rows")
+		return apperror.ErrNoAffectedRows
 	}
 
+	return nil
+}
+
+func (r ArticleRepo) Restore(ctx context.Context, id uuid.UUID) error {
+	conn := transaction.GetClientOrTxn(ctx, db_client.GetDB)
+
+	query := `
+		UPDATE articles
+		SET deleted_at = NULL, updated_at = $1
+		WHERE id = $2 AND deleted_at IS NOT NULL
+	`
+
+	res, err := conn.Exec(ctx, query, time.Now(), id)
+	if err != nil {
+		log.Errorf(ctx, err, "[ArticleRepo][Restore] Exec failed")
+		return apperror.ErrUpdateRecordFailed
+	}
+
+	affected, _ := res.RowsAffected()
+	if affected == 0 {
+		log.Errorf(ctx, err, "[ArticleRepo][Restore] No affected rows")
+		return apperror.ErrNoAffectedRows
+	}
+
+	return nil
+}
+
+func (r ArticleRepo) List(ctx context.Context, filter ArticleFilter) ([]*model.Article, error) {
+	conn := transaction.GetClientOrTxn(ctx, db_client.GetDB)
+	query := `
+		SELECT
+			articles.id,
+			articles.title,
+			articles.slug,
+			articles.body,
+			articles.created_at,
+			articles.updated_at,
+			articles.status,
+			articles.published_at,
+			articles.publish_at,
+			articles.deleted_at,
+			authors.id AS author_id,
+			authors.name AS author_name,
+			authors.handle AS author_handle,
+			categories.id AS category_id,
+			categories.name AS category_name,
+			ARRAY(
+				SELECT tags.name
+				FROM article_tags
+				JOIN tags ON article_tags.tag_id = tags.id
+				WHERE article_tags.article_id = articles.id
+				ORDER BY tags.name
+			) AS tags
+		FROM articles
+		JOIN authors ON articles.author_id = authors.id
+		LEFT JOIN categories ON articles.category_id = categories.id
+		{{whereFilters}}
+		{{orderBy}}
+		{{limitAndOffset}}
+	`
+
+	whereFilters, params := buildArticleWhereFilters(filter)
+	query = strings.ReplaceAll(query, "{{whereFilters}}", whereFilters)
+
 	sortBy := "articles.created_at"
 	sortDirection := "DESC"
-	if filter.SortBy != "" && filter.SortDirection != "" {
+	if filter.SortBy != "" {
 		sortBy = filter.SortBy
-		sortDirection = filter.SortDirection
+		if filter.SortDirection != "" {
+			sortDirection = filter.SortDirection
+		}
+	} else if len(filter.Ids) > 0 {
+		// keep the order the ids were given in, e.g. by search relevance
+		params = append(params, pq.Array(utils.UUIDStrings(filter.Ids)))
+		sortBy = fmt.Sprintf("ARRAY_POSITION($%d::uuid[], articles.id)", len(params))
+		sortDirection = "ASC"
 	}
 
 	sort := sortBy + " " + sortDirection
@@ -121,18 +343,188 @@ func (r ArticleRepo) List(ctx context.Context, filter ArticleFilter) ([]*model.A
 	var articles = []*model.Article{}
 	for rows.Next() {
 		var article model.Article
+		var categoryID uuid.NullUUID
+		var categoryName sql.NullString
 		err = rows.Scan(
 			&article.ID,
 			&article.Title,
+			&article.Slug,
 			&article.Body,
 			&article.CreatedAt,
+			&article.UpdatedAt,
+			&article.Status,
+			&article.PublishedAt,
+			&article.PublishAt,
+			&article.DeletedAt,
 			&article.Author.ID,
 			&article.Author.Name,
+			&article.Author.Handle,
+			&categoryID,
+			&categoryName,
+			pq.Array(&article.Tags),
 		)
 		if err != nil {
 			log.Errorf(ctx, err, "[ArticleRepo][List] Scan failed")
 			return nil, apperror.ErrScanRecordFailed
 		}
+		article.Category = articleCategory(categoryID, categoryName)
+
+		articles = append(articles, &article)
+	}
+
+	return articles, nil
+}
+
+// ListDueForPublishing skips rows locked by another transaction, so several schedulers never pick the
+// same article. It must be called within a transaction for the locks to be held.
+func (r ArticleRepo) ListDueForPublishing(ctx context.Context, now time.Time, limit int) ([]*model.Article, error) {
+	conn := transaction.GetClientOrTxn(ctx, db_client.GetDB)
+	query := `
+		SELECT
+			articles.id,
+			articles.title,
+			articles.slug,
+			articles.body,
+			articles.created_at,
+			articles.updated_at,
+			articles.status,
+			articles.published_at,
+			articles.publish_at,
+			articles.deleted_at,
+			authors.id AS author_id,
+			authors.name AS author_name,
+			authors.handle AS author_handle,
+			categories.id AS category_id,
+			categories.name AS category_name,
+			ARRAY(
+				SELECT tags.name
+				FROM article_tags
+				JOIN tags ON article_tags.tag_id = tags.id
+				WHERE article_tags.article_id = articles.id
+				ORDER BY tags.name
+			) AS tags
+		FROM articles
+		JOIN authors ON articles.author_id = authors.id
+		LEFT JOIN categories ON articles.category_id = categories.id
+		WHERE articles.status = $1
+			AND articles.deleted_at IS NULL
+			AND articles.publish_at <= $2
+		ORDER BY articles.publish_at ASC
+		LIMIT $3
+		FOR UPDATE OF articles SKIP LOCKED
+	`
+
+	rows, err := conn.Query(ctx, query, model.ArticleStatusDraft, now, limit)
+	if err != nil {
+		log.Errorf(ctx, err, "[ArticleRepo][ListDueForPublishing] Query failed")
+		return nil, apperror.ErrGetRecordFailed
+	}
+
+	var articles = []*model.Article{}
+	for rows.Next() {
+		var article model.Article
+		var categoryID uuid.NullUUID
+		var categoryName sql.NullString
+		err = rows.Scan(
+			&article.ID,
+			&article.Title,
+			&article.Slug,
+			&article.Body,
+			&article.CreatedAt,
+			&article.UpdatedAt,
+			&article.Status,
+			&article.PublishedAt,
+			&article.PublishAt,
+			&article.DeletedAt,
+			&article.Author.ID,
+			&article.Author.Name,
+			&article.Author.Handle,
+			&categoryID,
+			&categoryName,
+			pq.Array(&article.Tags),
+		)
+		if err != nil {
+			log.Errorf(ctx, err, "[ArticleRepo][ListDueForPublishing] Scan failed")
+			return nil, apperror.ErrScanRecordFailed
+		}
+		article.Category = articleCategory(categoryID, categoryName)
+
+		articles = append(articles, &article)
+	}
+
+	return articles, nil
+}
+
+// ListForIndexing also returns unpublished and deleted articles, so the index can drop them
+func (r ArticleRepo) ListForIndexing(ctx context.Context, afterID uuid.UUID, changedSince time.Time, limit int) ([]*model.Article, error) {
+	conn := transaction.GetClientOrTxn(ctx, db_client.GetDB)
+	query := `
+		SELECT
+			articles.id,
+			articles.title,
+			articles.slug,
+			articles.body,
+			articles.created_at,
+			articles.updated_at,
+			articles.status,
+			articles.published_at,
+			articles.publish_at,
+			articles.deleted_at,
+			authors.id AS author_id,
+			authors.name AS author_name,
+			authors.handle AS author_handle,
+			categories.id AS category_id,
+			categories.name AS category_name,
+			ARRAY(
+				SELECT tags.name
+				FROM article_tags
+				JOIN tags ON article_tags.tag_id = tags.id
+				WHERE article_tags.article_id = articles.id
+				ORDER BY tags.name
+			) AS tags
+		FROM articles
+		JOIN authors ON articles.author_id = authors.id
+		LEFT JOIN categories ON articles.category_id = categories.id
+		WHERE articles.id > $1
+			AND (articles.updated_at >= $2 OR authors.updated_at >= $2)
+		ORDER BY articles.id ASC
+		LIMIT $3
+	`
+
+	rows, err := conn.Query(ctx, query, afterID, changedSince, limit)
+	if err != nil {
+		log.Errorf(ctx, err, "[ArticleRepo][ListForIndexing] Query failed")
+		return nil, apperror.ErrGetRecordFailed
+	}
+
+	var articles = []*model.Article{}
+	for rows.Next() {
+		var article model.Article
+		var categoryID uuid.NullUUID
+		var categoryName sql.NullString
+		err = rows.Scan(
+			&article.ID,
+			&article.Title,
+			&article.Slug,
+			&article.Body,
+			&article.CreatedAt,
+			&article.UpdatedAt,
+			&article.Status,
+			&article.PublishedAt,
+			&article.PublishAt,
+			&article.DeletedAt,
+			&article.Author.ID,
+			&article.Author.Name,
+			&article.Author.Handle,
+			&categoryID,
+			&categoryName,
+			pq.Array(&article.Tags),
+		)
+		if err != nil {
+			log.Errorf(ctx, err, "[ArticleRepo][ListForIndexing] Scan failed")
+			return nil, apperror.ErrScanRecordFailed
+		}
+		article.Category = articleCategory(categoryID, categoryName)
 
 		articles = append(articles, &article)
 	}
@@ -149,9 +541,118 @@ func (r ArticleRepo) GetRecordsCount(ctx context.Context, filter ArticleFilter)
 		{{whereFilters}}
 	`
 
+	whereFilters, params := buildArticleWhereFilters(filter)
+	query = strings.ReplaceAll(query, "{{whereFilters}}", whereFilters)
+
+	rows, err := conn.Query(ctx, query, params...)
+	if err != nil {
+		log.Errorf(ctx, err, "[ArticleRepo][GetRecordsCount] Query failed")
+		return 0, apperror.ErrGetRecordFailed
+	}
+
+	var rowsCount int64
+	for rows.Next() {
+		err = rows.Scan(&rowsCount)
+		if err != nil {
+			log.Errorf(ctx, err, "[ArticleRepo][GetRecordsCount] Scan failed")
+			return 0, apperror.ErrScanRecordFailed
+		}
+	}
+
+	return rowsCount, nil
+}
+
+var articleFacetQueries = map[string]string{
+	model.FacetAuthor: `
+		SELECT authors.id::text, authors.name, COUNT(*)
+		FROM articles
+		JOIN authors ON articles.author_id = authors.id
+		{{whereFilters}}
+		GROUP BY authors.id, authors.name
+		ORDER BY COUNT(*) DESC, authors.name ASC
+		{{limit}}
+	`,
+	model.FacetMonth: `
+		SELECT to_char(articles.created_at AT TIME ZONE 'UTC', 'YYYY-MM') AS month, '', COUNT(*)
+		FROM articles
+		JOIN authors ON articles.author_id = authors.id
+		{{whereFilters}}
+		GROUP BY month
+		ORDER BY month DESC
+	`,
+	model.FacetTag: `
+		SELECT tags.name, '', COUNT(*)
+		FROM articles
+		JOIN authors ON articles.author_id = authors.id
+		JOIN article_tags ON article_tags.article_id = articles.id
+		JOIN tags ON article_tags.tag_id = tags.id
+		{{whereFilters}}
+		GROUP BY tags.name
+		ORDER BY COUNT(*) DESC, tags.name ASC
+		{{limit}}
+	`,
+}
+
+func (r ArticleRepo) CountFacets(ctx context.Context, filter ArticleFilter, facets []string) (model.ArticleFacets, error) {
+	conn := transaction.GetClientOrTxn(ctx, db_client.GetDB)
+	whereFilters, params := buildArticleWhereFilters(filter)
+
+	result := model.ArticleFacets{}
+	for _, facet := range facets {
+		query, ok := articleFacetQueries[facet]
+		if !ok {
+			continue
+		}
+
+		facetParams := params
+		if strings.Contains(query, "{{limit}}") {
+			facetParams = append(slices.Clone(params), model.FacetSize)
+			query = strings.ReplaceAll(query, "{{limit}}", fmt.Sprintf("LIMIT $%d", len(facetParams)))
+		}
+		query = strings.ReplaceAll(query, "{{whereFilters}}", whereFilters)
+
+		rows, err := conn.Query(ctx, query, facetParams...)
+		if err != nil {
+			log.Errorf(ctx, err, "[ArticleRepo][CountFacets] Query failed, facet: %s", facet)
+			return nil, apperror.ErrGetRecordFailed
+		}
+
+		buckets := []model.FacetBucket{}
+		for rows.Next() {
+			var bucket model.FacetBucket
+			err = rows.Scan(&bucket.Value, &bucket.Label, &bucket.Count)
+			if err != nil {
+				log.Errorf(ctx, err, "[ArticleRepo][CountFacets] Scan failed, facet: %s", facet)
+				return nil, apperror.ErrScanRecordFailed
+			}
+			buckets = append(buckets, bucket)
+		}
+		result[facet] = buckets
+	}
+
+	return result, nil
+}
+
+func articleCategory(id uuid.NullUUID, name sql.NullString) *model.Category {
+	if !id.Valid {
+		return nil
+	}
+	return &model.Category{ID: id.UUID, Name: name.String}
+}
+
+func buildArticleWhereFilters(filter ArticleFilter) (string, []interface{}) {
 	var params []interface{}
 	var whereFilters []string
 
+	if !filter.IncludeDeleted {
+		whereFilters = append(whereFilters, "articles.deleted_at IS NULL")
+	}
+
+	if filter.Status != "" {
+		params = append(params, filter.Status)
+		whereFilters = append(whereFilters, fmt.Sprintf("articles.status = $%d", len(params)))
+	}
+
 	if len(filter.Ids) > 0 {
 		idsQuery := "articles.id IN ("
 		ids := []string{}
@@ -163,32 +664,73 @@ func (r ArticleRepo) GetRecordsCount(ctx context.Context, filter ArticleFilter)
 		whereFilters = append(whereFilters, idsQuery)
 	}
 
+	if filter.AuthorID != uuid.Nil {
+		params = append(params, filter.AuthorID)
+		whereFilters = append(whereFilters, fmt.Sprintf("articles.author_id = $%d", len(params)))
+	}
+
+	if len(filter.AuthorIDs) > 0 {
+		params = append(params, pq.Array(utils.UUIDStrings(filter.AuthorIDs)))
+		whereFilters = append(whereFilters, fmt.Sprintf("articles.author_id = ANY($%d::uuid[])", len(params)))
+	}
+
+	if len(filter.ExcludeAuthorIDs) > 0 {
+		params = append(params, pq.Array(utils.UUIDStrings(filter.ExcludeAuthorIDs)))
+		whereFilters = append(whereFilters, fmt.Sprintf("articles.author_id <> ALL($%d::uuid[])", len(params)))
+	}
+
+	if !filter.CreatedFrom.IsZero() {
+		params = append(params, filter.CreatedFrom)
+		whereFilters = append(whereFilters, fmt.Sprintf("articles.created_at >= $%d", len(params)))
+	}
+
+	if !filter.CreatedTo.IsZero() {
+		params = append(params, filter.CreatedTo)
+		whereFilters = append(whereFilters, fmt.Sprintf("articles.created_at <= $%d", len(params)))
+	}
+
 	if filter.AuthorName != "" {
 		params = append(params, "%"+filter.AuthorName+"%")
 		whereFilters = append(whereFilters, fmt.Sprintf("LOWER(authors.name) LIKE LOWER($%d)", len(params)))
 	}
 
-	if len(whereFilters) != 0 {
-		filters := "WHERE " + strings.Join(whereFilters, " AND ")
-		query = strings.ReplaceAll(query, "{{whereFilters}}", filters)
-	} else {
-		query = strings.ReplaceAll(query, "{{whereFilters}}", "")
+	// an article filed under a subcategory also belongs to every category above it
+	if filter.CategoryID != uuid.Nil {
+		params = append(params, filter.CategoryID)
+		whereFilters = append(whereFilters, fmt.Sprintf(`articles.category_id IN (
+			WITH RECURSIVE category_tree AS (
+				SELECT categories.id
+				FROM categories
+				WHERE categories.id = $%d
+				UNION
+				SELECT categories.id
+				FROM categories
+				JOIN category_tree ON categories.parent_id = category_tree.id
+			)
+			SELECT category_tree.id FROM category_tree
+		)`, len(params)))
 	}
 
-	rows, err := conn.Query(ctx, query, params...)
-	if err != nil {
-		log.Errorf(ctx, err, "[ArticleRepo][GetRecordsCount] Query failed")
-		return 0, apperror.ErrGetRecordFailed
-	}
+	if len(filter.Tags) > 0 {
+		params = append(params, pq.Array(filter.Tags))
+		tagsSubquery := fmt.Sprintf(`
+			FROM article_tags
+			JOIN tags ON article_tags.tag_id = tags.id
+			WHERE article_tags.article_id = articles.id AND tags.name = ANY($%d)
+		`, len(params))
 
-	var rowsCount int64
-	for rows.Next() {
-		err = rows.Scan(&rowsCount)
-		if err != nil {
-			log.Errorf(ctx, err, "[ArticleRepo][GetRecordsCount] Scan failed")
-			return 0, apperror.ErrScanRecordFailed
+		// an article has each tag once, so it matches all tags when it matches as many rows as tags
+		if filter.MatchAllTags {
+			params = append(params, len(filter.Tags))
+			whereFilters = append(whereFilters, fmt.Sprintf("(SELECT COUNT(*) %s) = $%d", tagsSubquery, len(params)))
+		} else {
+			whereFilters = append(whereFilters, fmt.Sprintf("EXISTS (SELECT 1 %s)", tagsSubquery))
 		}
 	}
 
-	return rowsCount, nil
+	if len(whereFilters) == 0 {
+		return "", params
+	}
+
+	return "WHERE " + strings.Join(whereFilters, " AND "), params
 }
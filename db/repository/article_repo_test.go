@@ -4,13 +4,18 @@ import (
 	"article-service/apperror"
 	"article-service/db/db_client"
 	"article-service/factory"
+	"article-service/model"
+	"article-service/utils"
 	"context"
 	"errors"
 	"regexp"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -20,16 +25,21 @@ func Test_Article_Create_Success(t *testing.T) {
 	article := factory.SampleArticle1
 	query := regexp.QuoteMeta(`
 		INSERT INTO articles
-			(id, title, body, author_id, created_at)
-		VALUES ($1, $2, $3, $4, $5)
+			(id, title, slug, body, author_id, created_at, updated_at, status, published_at, category_id)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
 	`)
 
 	mock.ExpectExec(query).WithArgs(
 		article.ID,
 		article.Title,
+		article.Slug,
 		article.Body,
 		article.Author.ID,
 		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		article.Status,
+		article.PublishedAt,
+		article.CategoryID(),
 	).WillReturnResult(
 		sqlmock.NewResult(0, 1),
 	)
@@ -46,17 +56,22 @@ func Test_Article_Create_ReturnErr_WhenExecFailed(t *testing.T) {
 	article := factory.SampleArticle1
 	query := regexp.QuoteMeta(`
 		INSERT INTO articles
-			(id, title, body, author_id, created_at)
-		VALUES ($1, $2, $3, $4, $5)
+			(id, title, slug, body, author_id, created_at, updated_at, status, published_at, category_id)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
 	`)
 
 	stubErr := errors.New("db error")
 	mock.ExpectExec(query).WithArgs(
 		article.ID,
 		article.Title,
+		article.Slug,
 		article.Body,
 		article.Author.ID,
 		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		article.Status,
+		article.PublishedAt,
+		article.CategoryID(),
 	).WillReturnError(
 		stubErr,
 	)
@@ -67,22 +82,40 @@ func Test_Article_Create_ReturnErr_WhenExecFailed(t *testing.T) {
 	assert.Equal(t, apperror.ErrCreateRecordFailed, err)
 }
 
+func Test_Article_Create_ReturnErr_WhenSlugTaken(t *testing.T) {
+	mock := db_client.InitDatabaseMock()
+
+	article := factory.SampleArticle1
+	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO articles`)).
+		WillReturnError(&pq.Error{Code: "23505"})
+
+	repo := GetArticleRepository()
+	err := repo.Create(context.Background(), &article)
+
+	assert.Equal(t, apperror.ErrArticleSlugTaken, err)
+}
+
 func Test_Article_Create_ReturnErr_WhenNoRowsAffected(t *testing.T) {
 	mock := db_client.InitDatabaseMock()
 
 	article := factory.SampleArticle1
 	query := regexp.QuoteMeta(`
 		INSERT INTO articles
-			(id, title, body, author_id, created_at)
-		VALUES ($1, $2, $3, $4, $5)
+			(id, title, slug, body, author_id, created_at, updated_at, status, published_at, category_id)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
 	`)
 
 	mock.ExpectExec(query).WithArgs(
 		article.ID,
 		article.Title,
+		article.Slug,
 		article.Body,
 		article.Author.ID,
 		sqlmock.AnyArg(),
+		sqlmock.AnyArg(),
+		article.Status,
+		article.PublishedAt,
+		article.CategoryID(),
 	).WillReturnResult(
 		sqlmock.NewResult(0, 0),
 	)
@@ -101,12 +134,30 @@ func Test_Article_List_Success_WithEmptyFilter(t *testing.T) {
 		SELECT
 			articles.id,
 			articles.title,
+			articles.slug,
 			articles.body,
 			articles.created_at,
+			articles.updated_at,
+			articles.status,
+			articles.published_at,
+			articles.publish_at,
+			articles.deleted_at,
 			authors.id AS author_id,
-			authors.name AS author_name
+			authors.name AS author_name,
+			authors.handle AS author_handle,
+			categories.id AS category_id,
+			categories.name AS category_name,
+			ARRAY(
+				SELECT tags.name
+				FROM article_tags
+				JOIN tags ON article_tags.tag_id = tags.id
+				WHERE article_tags.article_id = articles.id
+				ORDER BY tags.name
+			) AS tags
 		FROM articles
 		JOIN authors ON articles.author_id = authors.id
+		LEFT JOIN categories ON articles.category_id = categories.id
+		WHERE articles.deleted_at IS NULL
 		ORDER BY articles.created_at DESC
 		LIMIT $1 OFFSET $2
 	`)
@@ -114,10 +165,20 @@ func Test_Article_List_Success_WithEmptyFilter(t *testing.T) {
 	columns := []string{
 		"id",
 		"title",
+		"slug",
 		"body",
 		"created_at",
+		"updated_at",
+		"status",
+		"published_at",
+		"publish_at",
+		"deleted_at",
 		"author_id",
 		"author_name",
+		"author_handle",
+		"category_id",
+		"category_name",
+		"tags",
 	}
 
 	mock.ExpectQuery(query).WithArgs(20, 0).
@@ -125,10 +186,20 @@ func Test_Article_List_Success_WithEmptyFilter(t *testing.T) {
 			sqlmock.NewRows(columns).AddRow(
 				article.ID,
 				article.Title,
+				article.Slug,
 				article.Body,
 				article.CreatedAt,
+				article.UpdatedAt,
+				article.Status,
+				article.PublishedAt,
+				article.PublishAt,
+				article.DeletedAt,
 				article.Author.ID,
 				article.Author.Name,
+				article.Author.Handle,
+				nil,
+				nil,
+				tagsArray(article.Tags),
 			),
 		)
 
@@ -148,13 +219,30 @@ func Test_Article_List_Success_WithFilledFilter(t *testing.T) {
 		SELECT
 			articles.id,
 			articles.title,
+			articles.slug,
 			articles.body,
 			articles.created_at,
+			articles.updated_at,
+			articles.status,
+			articles.published_at,
+			articles.publish_at,
+			articles.deleted_at,
 			authors.id AS author_id,
-			authors.name AS author_name
+			authors.name AS author_name,
+			authors.handle AS author_handle,
+			categories.id AS category_id,
+			categories.name AS category_name,
+			ARRAY(
+				SELECT tags.name
+				FROM article_tags
+				JOIN tags ON article_tags.tag_id = tags.id
+				WHERE article_tags.article_id = articles.id
+				ORDER BY tags.name
+			) AS tags
 		FROM articles
 		JOIN authors ON articles.author_id = authors.id
-		WHERE articles.id IN ($1) AND LOWER(authors.name) LIKE LOWER($2)
+		LEFT JOIN categories ON articles.category_id = categories.id
+		WHERE articles.deleted_at IS NULL AND articles.id IN ($1) AND LOWER(authors.name) LIKE LOWER($2)
 		ORDER BY title asc
 		LIMIT $3 OFFSET $4
 	`)
@@ -162,10 +250,20 @@ func Test_Article_List_Success_WithFilledFilter(t *testing.T) {
 	columns := []string{
 		"id",
 		"title",
+		"slug",
 		"body",
 		"created_at",
+		"updated_at",
+		"status",
+		"published_at",
+		"publish_at",
+		"deleted_at",
 		"author_id",
 		"author_name",
+		"author_handle",
+		"category_id",
+		"category_name",
+		"tags",
 	}
 
 	mock.ExpectQuery(query).WithArgs(article.ID, "%"+article.Author.Name+"%", 10, 20).
@@ -173,10 +271,20 @@ func Test_Article_List_Success_WithFilledFilter(t *testing.T) {
 			sqlmock.NewRows(columns).AddRow(
 				article.ID,
 				article.Title,
+				article.Slug,
 				article.Body,
 				article.CreatedAt,
+				article.UpdatedAt,
+				article.Status,
+				article.PublishedAt,
+				article.PublishAt,
+				article.DeletedAt,
 				article.Author.ID,
 				article.Author.Name,
+				article.Author.Handle,
+				nil,
+				nil,
+				tagsArray(article.Tags),
 			),
 		)
 
@@ -195,6 +303,96 @@ func Test_Article_List_Success_WithFilledFilter(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func Test_Article_List_Success_KeepIdsOrder_WhenNotSorted(t *testing.T) {
+	mock := db_client.InitDatabaseMock()
+
+	article1 := factory.SampleArticle1
+	article2 := factory.SampleArticle2
+	query := regexp.QuoteMeta(`
+		WHERE articles.deleted_at IS NULL AND articles.id IN ($1, $2)
+		ORDER BY ARRAY_POSITION($3::uuid[], articles.id) ASC
+		LIMIT $4 OFFSET $5
+	`)
+
+	columns := []string{
+		"id",
+		"title",
+		"slug",
+		"body",
+		"created_at",
+		"updated_at",
+		"status",
+		"published_at",
+		"publish_at",
+		"deleted_at",
+		"author_id",
+		"author_name",
+		"author_handle",
+		"category_id",
+		"category_name",
+		"tags",
+	}
+
+	rows := sqlmock.NewRows(columns)
+	for _, article := range []model.Article{article2, article1} {
+		rows.AddRow(
+			article.ID,
+			article.Title,
+			article.Slug,
+			article.Body,
+			article.CreatedAt,
+			article.UpdatedAt,
+			article.Status,
+			article.PublishedAt,
+			article.PublishAt,
+			article.DeletedAt,
+			article.Author.ID,
+			article.Author.Name,
+			article.Author.Handle,
+			nil,
+			nil,
+			tagsArray(article.Tags),
+		)
+	}
+
+	ids := []string{article2.ID.String(), article1.ID.String()}
+	mock.ExpectQuery(query).
+		WithArgs(article2.ID, article1.ID, pq.Array(ids), 2, 0).
+		WillReturnRows(rows)
+
+	repo := GetArticleRepository()
+	filter := ArticleFilter{
+		Ids:   []uuid.UUID{article2.ID, article1.ID},
+		Limit: 2,
+	}
+	articles, err := repo.List(context.Background(), filter)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []*model.Article{&article2, &article1}, articles)
+}
+
+func Test_Article_List_Success_SortDescending_WhenDirectionIsMissing(t *testing.T) {
+	mock := db_client.InitDatabaseMock()
+
+	article := factory.SampleArticle1
+	query := regexp.QuoteMeta(`
+		WHERE articles.deleted_at IS NULL AND articles.id IN ($1)
+		ORDER BY title DESC
+		LIMIT $2 OFFSET $3
+	`)
+
+	mock.ExpectQuery(query).WithArgs(article.ID, 10, 0).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	repo := GetArticleRepository()
+	filter := ArticleFilter{Ids: []uuid.UUID{article.ID}, SortBy: "title", Limit: 10}
+	articles, err := repo.List(context.Background(), filter)
+
+	assert.Nil(t, err)
+	assert.Empty(t, articles)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
 func Test_Article_List_ReturnErr_WhenQueryFailed(t *testing.T) {
 	mock := db_client.InitDatabaseMock()
 
@@ -202,12 +400,30 @@ func Test_Article_List_ReturnErr_WhenQueryFailed(t *testing.T) {
 		SELECT
 			articles.id,
 			articles.title,
+			articles.slug,
 			articles.body,
 			articles.created_at,
+			articles.updated_at,
+			articles.status,
+			articles.published_at,
+			articles.publish_at,
+			articles.deleted_at,
 			authors.id AS author_id,
-			authors.name AS author_name
+			authors.name AS author_name,
+			authors.handle AS author_handle,
+			categories.id AS category_id,
+			categories.name AS category_name,
+			ARRAY(
+				SELECT tags.name
+				FROM article_tags
+				JOIN tags ON article_tags.tag_id = tags.id
+				WHERE article_tags.article_id = articles.id
+				ORDER BY tags.name
+			) AS tags
 		FROM articles
 		JOIN authors ON articles.author_id = authors.id
+		LEFT JOIN categories ON articles.category_id = categories.id
+		WHERE articles.deleted_at IS NULL
 		ORDER BY articles.created_at DESC
 		LIMIT $1 OFFSET $2
 	`)
@@ -233,12 +449,30 @@ func Test_Article_List_ReturnErr_WhenScanFailed(t *testing.T) {
 		SELECT
 			articles.id,
 			articles.title,
+			articles.slug,
 			articles.body,
 			articles.created_at,
+			articles.updated_at,
+			articles.status,
+			articles.published_at,
+			articles.publish_at,
+			articles.deleted_at,
 			authors.id AS author_id,
-			authors.name AS author_name
+			authors.name AS author_name,
+			authors.handle AS author_handle,
+			categories.id AS category_id,
+			categories.name AS category_name,
+			ARRAY(
+				SELECT tags.name
+				FROM article_tags
+				JOIN tags ON article_tags.tag_id = tags.id
+				WHERE article_tags.article_id = articles.id
+				ORDER BY tags.name
+			) AS tags
 		FROM articles
 		JOIN authors ON articles.author_id = authors.id
+		LEFT JOIN categories ON articles.category_id = categories.id
+		WHERE articles.deleted_at IS NULL
 		ORDER BY articles.created_at DESC
 		LIMIT $1 OFFSET $2
 	`)
@@ -247,20 +481,40 @@ func Test_Article_List_ReturnErr_WhenScanFailed(t *testing.T) {
 	columns := []string{
 		"id",
 		"title",
+		"slug",
 		"body",
 		"created_at",
+		"updated_at",
+		"status",
+		"published_at",
+		"publish_at",
+		"deleted_at",
 		"author_id",
 		"author_name",
+		"author_handle",
+		"category_id",
+		"category_name",
+		"tags",
 	}
 	mock.ExpectQuery(query).
 		WithArgs(20, 0).
 		WillReturnRows(sqlmock.NewRows(columns).AddRow(
 			article.ID,
 			article.Title,
+			article.Slug,
 			article.Body,
 			"invalid-datetime",
+			article.UpdatedAt,
+			article.Status,
+			article.PublishedAt,
+			article.PublishAt,
+			article.DeletedAt,
 			article.Author.ID,
 			article.Author.Name,
+			article.Author.Handle,
+			nil,
+			nil,
+			tagsArray(article.Tags),
 		))
 
 	repo := GetArticleRepository()
@@ -277,12 +531,30 @@ func Test_Article_List_Success_WhenResultIsEmpty(t *testing.T) {
 		SELECT
 			articles.id,
 			articles.title,
+			articles.slug,
 			articles.body,
 			articles.created_at,
+			articles.updated_at,
+			articles.status,
+			articles.published_at,
+			articles.publish_at,
+			articles.deleted_at,
 			authors.id AS author_id,
-			authors.name AS author_name
+			authors.name AS author_name,
+			authors.handle AS author_handle,
+			categories.id AS category_id,
+			categories.name AS category_name,
+			ARRAY(
+				SELECT tags.name
+				FROM article_tags
+				JOIN tags ON article_tags.tag_id = tags.id
+				WHERE article_tags.article_id = articles.id
+				ORDER BY tags.name
+			) AS tags
 		FROM articles
 		JOIN authors ON articles.author_id = authors.id
+		LEFT JOIN categories ON articles.category_id = categories.id
+		WHERE articles.deleted_at IS NULL
 		ORDER BY articles.created_at DESC
 		LIMIT $1 OFFSET $2
 	`)
@@ -290,10 +562,20 @@ func Test_Article_List_Success_WhenResultIsEmpty(t *testing.T) {
 	columns := []string{
 		"id",
 		"title",
+		"slug",
 		"body",
 		"created_at",
+		"updated_at",
+		"status",
+		"published_at",
+		"publish_at",
+		"deleted_at",
 		"author_id",
 		"author_name",
+		"author_handle",
+		"category_id",
+		"category_name",
+		"tags",
 	}
 
 	mock.ExpectQuery(query).
@@ -314,6 +596,7 @@ func Test_Article_GetRecordsCount_Success_WithEmptyFilter(t *testing.T) {
 		SELECT COUNT(*)
 		FROM articles
 		JOIN authors ON articles.author_id = authors.id
+		WHERE articles.deleted_at IS NULL
 	`)
 
 	columns := []string{"count"}
@@ -335,7 +618,7 @@ func Test_Article_GetRecordsCount_Success_WithFilledFilter(t *testing.T) {
 		SELECT COUNT(*)
 		FROM articles
 		JOIN authors ON articles.author_id = authors.id
-		WHERE articles.id IN ($1) AND LOWER(authors.name) LIKE LOWER($2)
+		WHERE articles.deleted_at IS NULL AND articles.id IN ($1) AND LOWER(authors.name) LIKE LOWER($2)
 	`)
 
 	columns := []string{"count"}
@@ -354,6 +637,159 @@ func Test_Article_GetRecordsCount_Success_WithFilledFilter(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func Test_Article_GetRecordsCount_Success_WithCategoryFilter(t *testing.T) {
+	mock := db_client.InitDatabaseMock()
+
+	categoryID := utils.GenerateUUID()
+	query := regexp.QuoteMeta(`
+		SELECT COUNT(*)
+		FROM articles
+		JOIN authors ON articles.author_id = authors.id
+		WHERE articles.deleted_at IS NULL AND articles.category_id IN (
+			WITH RECURSIVE category_tree AS (
+				SELECT categories.id
+				FROM categories
+				WHERE categories.id = $1
+				UNION
+				SELECT categories.id
+				FROM categories
+				JOIN category_tree ON categories.parent_id = category_tree.id
+			)
+			SELECT category_tree.id FROM category_tree
+		)
+	`)
+
+	columns := []string{"count"}
+
+	mock.ExpectQuery(query).WithArgs(categoryID).
+		WillReturnRows(sqlmock.NewRows(columns).AddRow(3))
+
+	repo := GetArticleRepository()
+	filter := ArticleFilter{
+		CategoryID: categoryID,
+	}
+	recordsCount, err := repo.GetRecordsCount(context.Background(), filter)
+
+	assert.Equal(t, int64(3), recordsCount)
+	assert.Nil(t, err)
+}
+
+func Test_Article_GetRecordsCount_Success_WithAnyTagsFilter(t *testing.T) {
+	mock := db_client.InitDatabaseMock()
+
+	query := regexp.QuoteMeta(`
+		SELECT COUNT(*)
+		FROM articles
+		JOIN authors ON articles.author_id = authors.id
+		WHERE articles.deleted_at IS NULL AND EXISTS (SELECT 1
+			FROM article_tags
+			JOIN tags ON article_tags.tag_id = tags.id
+			WHERE article_tags.article_id = articles.id AND tags.name = ANY($1)
+		)
+	`)
+
+	columns := []string{"count"}
+
+	mock.ExpectQuery(query).WithArgs(pq.Array([]string{"puisi", "keluarga"})).
+		WillReturnRows(sqlmock.NewRows(columns).AddRow(2))
+
+	repo := GetArticleRepository()
+	filter := ArticleFilter{
+		Tags: []string{"puisi", "keluarga"},
+	}
+	recordsCount, err := repo.GetRecordsCount(context.Background(), filter)
+
+	assert.Equal(t, int64(2), recordsCount)
+	assert.Nil(t, err)
+}
+
+func Test_Article_GetRecordsCount_Success_WithAllTagsFilter(t *testing.T) {
+	mock := db_client.InitDatabaseMock()
+
+	query := regexp.QuoteMeta(`
+		SELECT COUNT(*)
+		FROM articles
+		JOIN authors ON articles.author_id = authors.id
+		WHERE articles.deleted_at IS NULL AND (SELECT COUNT(*)
+			FROM article_tags
+			JOIN tags ON article_tags.tag_id = tags.id
+			WHERE article_tags.article_id = articles.id AND tags.name = ANY($1)
+		) = $2
+	`)
+
+	columns := []string{"count"}
+
+	mock.ExpectQuery(query).WithArgs(pq.Array([]string{"puisi", "keluarga"}), 2).
+		WillReturnRows(sqlmock.NewRows(columns).AddRow(1))
+
+	repo := GetArticleRepository()
+	filter := ArticleFilter{
+		Tags:         []string{"puisi", "keluarga"},
+		MatchAllTags: true,
+	}
+	recordsCount, err := repo.GetRecordsCount(context.Background(), filter)
+
+	assert.Equal(t, int64(1), recordsCount)
+	assert.Nil(t, err)
+}
+
+func Test_Article_GetRecordsCount_Success_WithAuthorIDFilter(t *testing.T) {
+	mock := db_client.InitDatabaseMock()
+
+	article := factory.SampleArticle1
+	query := regexp.QuoteMeta(`
+		SELECT COUNT(*)
+		FROM articles
+		JOIN authors ON articles.author_id = authors.id
+		WHERE articles.deleted_at IS NULL AND articles.author_id = $1
+	`)
+
+	columns := []string{"count"}
+
+	mock.ExpectQuery(query).WithArgs(article.Author.ID).
+		WillReturnRows(sqlmock.NewRows(columns).AddRow(1))
+
+	repo := GetArticleRepository()
+	filter := ArticleFilter{AuthorID: article.Author.ID}
+	recordsCount, err := repo.GetRecordsCount(context.Background(), filter)
+
+	assert.Equal(t, int64(1), recordsCount)
+	assert.Nil(t, err)
+}
+
+func Test_Article_GetRecordsCount_Success_WithAuthorIDsAndCreatedRangeFilter(t *testing.T) {
+	mock := db_client.InitDatabaseMock()
+
+	chandra, phang := factory.SampleAuthorChandra.ID, factory.SampleAuthorPhang.ID
+	createdFrom := time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC)
+	createdTo := time.Date(2025, 7, 31, 23, 59, 59, 0, time.UTC)
+	query := regexp.QuoteMeta(`
+		SELECT COUNT(*)
+		FROM articles
+		JOIN authors ON articles.author_id = authors.id
+		WHERE articles.deleted_at IS NULL AND articles.author_id = ANY($1::uuid[]) AND articles.author_id <> ALL($2::uuid[]) AND articles.created_at >= $3 AND articles.created_at <= $4
+	`)
+
+	columns := []string{"count"}
+
+	mock.ExpectQuery(query).
+		WithArgs(pq.Array([]string{chandra.String()}), pq.Array([]string{phang.String()}), createdFrom, createdTo).
+		WillReturnRows(sqlmock.NewRows(columns).AddRow(1))
+
+	repo := GetArticleRepository()
+	filter := ArticleFilter{
+		AuthorIDs:        []uuid.UUID{chandra},
+		ExcludeAuthorIDs: []uuid.UUID{phang},
+		CreatedFrom:      createdFrom,
+		CreatedTo:        createdTo,
+	}
+	recordsCount, err := repo.GetRecordsCount(context.Background(), filter)
+
+	assert.Equal(t, int64(1), recordsCount)
+	assert.Nil(t, err)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
 func Test_Article_GetRecordsCount_ReturnErr_WhenQueryFailed(t *testing.T) {
 	mock := db_client.InitDatabaseMock()
 
@@ -361,6 +797,7 @@ func Test_Article_GetRecordsCount_ReturnErr_WhenQueryFailed(t *testing.T) {
 		SELECT COUNT(*)
 		FROM articles
 		JOIN authors ON articles.author_id = authors.id
+		WHERE articles.deleted_at IS NULL
 	`)
 
 	stubErr := errors.New("db error")
@@ -380,6 +817,7 @@ func Test_Article_GetRecordsCount_ReturnErr_WhenScanFailed(t *testing.T) {
 		SELECT COUNT(*)
 		FROM articles
 		JOIN authors ON articles.author_id = authors.id
+		WHERE articles.deleted_at IS NULL
 	`)
 
 	columns := []string{"count"}
@@ -399,6 +837,7 @@ func Test_Article_GetRecordsCount_Success_WhenResultIsEmpty(t *testing.T) {
 		SELECT COUNT(*)
 		FROM articles
 		JOIN authors ON articles.author_id = authors.id
+		WHERE articles.deleted_at IS NULL
 	`)
 
 	columns := []string{"count"}
@@ -411,3 +850,939 @@ func Test_Article_GetRecordsCount_Success_WhenResultIsEmpty(t *testing.T) {
 	assert.Equal(t, int64(0), recordsCount)
 	assert.Nil(t, err)
 }
+
+func Test_Article_Get_Success(t *testing.T) {
+	mock := db_client.InitDatabaseMock()
+
+	article := factory.SampleArticle1
+	query := regexp.QuoteMeta(`
+		SELECT
+			articles.id,
+			articles.title,
+			articles.slug,
+			articles.body,
+			articles.created_at,
+			articles.updated_at,
+			articles.status,
+			articles.published_at,
+			articles.publish_at,
+			articles.deleted_at,
+			authors.id AS author_id,
+			authors.name AS author_name,
+			authors.handle AS author_handle,
+			categories.id AS category_id,
+			categories.name AS category_name,
+			ARRAY(
+				SELECT tags.name
+				FROM article_tags
+				JOIN tags ON article_tags.tag_id = tags.id
+				WHERE article_tags.article_id = articles.id
+				ORDER BY tags.name
+			) AS tags
+		FROM articles
+		JOIN authors ON articles.author_id = authors.id
+		LEFT JOIN categories ON articles.category_id = categories.id
+		WHERE articles.id = $1
+	`)
+
+	columns := []string{
+		"id",
+		"title",
+		"slug",
+		"body",
+		"created_at",
+		"updated_at",
+		"status",
+		"published_at",
+		"publish_at",
+		"deleted_at",
+		"author_id",
+		"author_name",
+		"author_handle",
+		"category_id",
+		"category_name",
+		"tags",
+	}
+
+	mock.ExpectQuery(query).WithArgs(article.ID).
+		WillReturnRows(
+			sqlmock.NewRows(columns).AddRow(
+				article.ID,
+				article.Title,
+				article.Slug,
+				article.Body,
+				article.CreatedAt,
+				article.UpdatedAt,
+				article.Status,
+				article.PublishedAt,
+				article.PublishAt,
+				article.DeletedAt,
+				article.Author.ID,
+				article.Author.Name,
+				article.Author.Handle,
+				nil,
+				nil,
+				tagsArray(article.Tags),
+			),
+		)
+
+	repo := GetArticleRepository()
+	result, err := repo.Get(context.Background(), article.ID)
+
+	assert.Equal(t, &article, result)
+	assert.Nil(t, err)
+}
+
+func Test_Article_Get_Success_WithCategory(t *testing.T) {
+	mock := db_client.InitDatabaseMock()
+
+	article := factory.SampleArticle1
+	category := factory.SampleCategoryLocal
+	article.Category = &model.Category{ID: category.ID, Name: category.Name}
+	query := regexp.QuoteMeta(`
+		LEFT JOIN categories ON articles.category_id = categories.id
+		WHERE articles.id = $1
+	`)
+
+	columns := []string{
+		"id",
+		"title",
+		"slug",
+		"body",
+		"created_at",
+		"updated_at",
+		"status",
+		"published_at",
+		"publish_at",
+		"deleted_at",
+		"author_id",
+		"author_name",
+		"author_handle",
+		"category_id",
+		"category_name",
+		"tags",
+	}
+
+	mock.ExpectQuery(query).WithArgs(article.ID).
+		WillReturnRows(
+			sqlmock.NewRows(columns).AddRow(
+				article.ID,
+				article.Title,
+				article.Slug,
+				article.Body,
+				article.CreatedAt,
+				article.UpdatedAt,
+				article.Status,
+				article.PublishedAt,
+				article.PublishAt,
+				article.DeletedAt,
+				article.Author.ID,
+				article.Author.Name,
+				article.Author.Handle,
+				category.ID,
+				category.Name,
+				tagsArray(article.Tags),
+			),
+		)
+
+	repo := GetArticleRepository()
+	result, err := repo.Get(context.Background(), article.ID)
+
+	assert.Equal(t, &article, result)
+	assert.Nil(t, err)
+}
+
+func Test_Article_Get_ReturnErr_WhenQueryFailed(t *testing.T) {
+	mock := db_client.InitDatabaseMock()
+
+	article := factory.SampleArticle1
+	query := regexp.QuoteMeta(`
+		SELECT
+			articles.id,
+			articles.title,
+			articles.slug,
+			articles.body,
+			articles.created_at,
+			articles.updated_at,
+			articles.status,
+			articles.published_at,
+			articles.publish_at,
+			articles.deleted_at,
+			authors.id AS author_id,
+			authors.name AS author_name,
+			authors.handle AS author_handle,
+			categories.id AS category_id,
+			categories.name AS category_name,
+			ARRAY(
+				SELECT tags.name
+				FROM article_tags
+				JOIN tags ON article_tags.tag_id = tags.id
+				WHERE article_tags.article_id = articles.id
+				ORDER BY tags.name
+			) AS tags
+		FROM articles
+		JOIN authors ON articles.author_id = authors.id
+		LEFT JOIN categories ON articles.category_id = categories.id
+		WHERE articles.id = $1
+	`)
+
+	stubErr := errors.New("db error")
+	mock.ExpectQuery(query).WithArgs(article.ID).WillReturnError(stubErr)
+
+	repo := GetArticleRepository()
+	result, err := repo.Get(context.Background(), article.ID)
+
+	assert.Nil(t, result)
+	assert.Equal(t, apperror.ErrGetRecordFailed, err)
+}
+
+func Test_Article_Get_ReturnErr_WhenScanFailed(t *testing.T) {
+	mock := db_client.InitDatabaseMock()
+
+	article := factory.SampleArticle1
+	query := regexp.QuoteMeta(`
+		SELECT
+			articles.id,
+			articles.title,
+			articles.slug,
+			articles.body,
+			articles.created_at,
+			articles.updated_at,
+			articles.status,
+			articles.published_at,
+			articles.publish_at,
+			articles.deleted_at,
+			authors.id AS author_id,
+			authors.name AS author_name,
+			authors.handle AS author_handle,
+			categories.id AS category_id,
+			categories.name AS category_name,
+			ARRAY(
+				SELECT tags.name
+				FROM article_tags
+				JOIN tags ON article_tags.tag_id = tags.id
+				WHERE article_tags.article_id = articles.id
+				ORDER BY tags.name
+			) AS tags
+		FROM articles
+		JOIN authors ON articles.author_id = authors.id
+		LEFT JOIN categories ON articles.category_id = categories.id
+		WHERE articles.id = $1
+	`)
+
+	columns := []string{
+		"id",
+		"title",
+		"slug",
+		"body",
+		"created_at",
+		"updated_at",
+		"status",
+		"published_at",
+		"publish_at",
+		"deleted_at",
+		"author_id",
+		"author_name",
+		"author_handle",
+		"category_id",
+		"category_name",
+		"tags",
+	}
+
+	mock.ExpectQuery(query).WithArgs(article.ID).
+		WillReturnRows(
+			sqlmock.NewRows(columns).AddRow(
+				article.ID,
+				article.Title,
+				article.Slug,
+				article.Body,
+				"invalid-datetime",
+				article.UpdatedAt,
+				article.Status,
+				article.PublishedAt,
+				article.PublishAt,
+				article.DeletedAt,
+				article.Author.ID,
+				article.Author.Name,
+				article.Author.Handle,
+				nil,
+				nil,
+				tagsArray(article.Tags),
+			),
+		)
+
+	repo := GetArticleRepository()
+	result, err := repo.Get(context.Background(), article.ID)
+
+	assert.Nil(t, result)
+	assert.Equal(t, apperror.ErrScanRecordFailed, err)
+}
+
+func Test_Article_Get_ReturnErr_WhenRecordNotFound(t *testing.T) {
+	mock := db_client.InitDatabaseMock()
+
+	article := factory.SampleArticle1
+	query := regexp.QuoteMeta(`
+		SELECT
+			articles.id,
+			articles.title,
+			articles.slug,
+			articles.body,
+			articles.created_at,
+			articles.updated_at,
+			articles.status,
+			articles.published_at,
+			articles.publish_at,
+			articles.deleted_at,
+			authors.id AS author_id,
+			authors.name AS author_name,
+			authors.handle AS author_handle,
+			categories.id AS category_id,
+			categories.name AS category_name,
+			ARRAY(
+				SELECT tags.name
+				FROM article_tags
+				JOIN tags ON article_tags.tag_id = tags.id
+				WHERE article_tags.article_id = articles.id
+				ORDER BY tags.name
+			) AS tags
+		FROM articles
+		JOIN authors ON articles.author_id = authors.id
+		LEFT JOIN categories ON articles.category_id = categories.id
+		WHERE articles.id = $1
+	`)
+
+	columns := []string{
+		"id",
+		"title",
+		"slug",
+		"body",
+		"created_at",
+		"updated_at",
+		"status",
+		"published_at",
+		"publish_at",
+		"deleted_at",
+		"author_id",
+		"author_name",
+		"author_handle",
+		"category_id",
+		"category_name",
+		"tags",
+	}
+
+	mock.ExpectQuery(query).WithArgs(article.ID).WillReturnRows(sqlmock.NewRows(columns))
+
+	repo := GetArticleRepository()
+	result, err := repo.Get(context.Background(), article.ID)
+
+	assert.Nil(t, result)
+	assert.Equal(t, apperror.ErrObjectNotExists, err)
+}
+
+func Test_Article_GetForUpdate_Success(t *testing.T) {
+	mock := db_client.InitDatabaseMock()
+
+	article := factory.SampleArticle1
+	query := regexp.QuoteMeta(`
+		LEFT JOIN categories ON articles.category_id = categories.id
+		WHERE articles.id = $1
+		FOR UPDATE OF articles
+	`)
+
+	columns := []string{
+		"id",
+		"title",
+		"slug",
+		"body",
+		"created_at",
+		"updated_at",
+		"status",
+		"published_at",
+		"publish_at",
+		"deleted_at",
+		"author_id",
+		"author_name",
+		"author_handle",
+		"category_id",
+		"category_name",
+		"tags",
+	}
+
+	mock.ExpectQuery(query).WithArgs(article.ID).
+		WillReturnRows(
+			sqlmock.NewRows(columns).AddRow(
+				article.ID,
+				article.Title,
+				article.Slug,
+				article.Body,
+				article.CreatedAt,
+				article.UpdatedAt,
+				article.Status,
+				article.PublishedAt,
+				article.PublishAt,
+				article.DeletedAt,
+				article.Author.ID,
+				article.Author.Name,
+				article.Author.Handle,
+				nil,
+				nil,
+				tagsArray(article.Tags),
+			),
+		)
+
+	repo := GetArticleRepository()
+	result, err := repo.GetForUpdate(context.Background(), article.ID)
+
+	assert.Nil(t, err)
+	assert.Equal(t, &article, result)
+}
+
+func Test_Article_GetForUpdate_ReturnErr_WhenQueryFailed(t *testing.T) {
+	mock := db_client.InitDatabaseMock()
+
+	query := regexp.QuoteMeta(`FOR UPDATE OF articles`)
+	mock.ExpectQuery(query).WillReturnError(errors.New("db error"))
+
+	repo := GetArticleRepository()
+	result, err := repo.GetForUpdate(context.Background(), factory.SampleArticle1.ID)
+
+	assert.Nil(t, result)
+	assert.Equal(t, apperror.ErrGetRecordFailed, err)
+}
+
+func Test_Article_Update_Success(t *testing.T) {
+	mock := db_client.InitDatabaseMock()
+
+	article := factory.SampleArticle1
+	query := regexp.QuoteMeta(`
+		UPDATE articles
+		SET title = $1, slug = $2, body = $3, author_id = $4, category_id = $5, updated_at = $6
+		WHERE id = $7
+	`)
+
+	mock.ExpectExec(query).WithArgs(
+		article.Title,
+		article.Slug,
+		article.Body,
+		article.Author.ID,
+		article.CategoryID(),
+		utils.AnyTime{},
+		article.ID,
+	).WillReturnResult(
+		sqlmock.NewResult(0, 1),
+	)
+
+	repo := GetArticleRepository()
+	err := repo.Update(context.Background(), &article)
+
+	assert.Nil(t, err)
+	assert.True(t, article.UpdatedAt.After(factory.SampleArticle1.UpdatedAt))
+}
+
+func Test_Article_Update_ReturnErr_WhenExecFailed(t *testing.T) {
+	mock := db_client.InitDatabaseMock()
+
+	article := factory.SampleArticle1
+	query := regexp.QuoteMeta(`
+		UPDATE articles
+		SET title = $1, slug = $2, body = $3, author_id = $4, category_id = $5, updated_at = $6
+		WHERE id = $7
+	`)
+
+	stubErr := errors.New("db error")
+	mock.ExpectExec(query).WithArgs(
+		article.Title,
+		article.Slug,
+		article.Body,
+		article.Author.ID,
+		article.CategoryID(),
+		utils.AnyTime{},
+		article.ID,
+	).WillReturnError(
+		stubErr,
+	)
+
+	repo := GetArticleRepository()
+	err := repo.Update(context.Background(), &article)
+
+	assert.Equal(t, apperror.ErrUpdateRecordFailed, err)
+}
+
+func Test_Article_Update_ReturnErr_WhenNoRowsAffected(t *testing.T) {
+	mock := db_client.InitDatabaseMock()
+
+	article := factory.SampleArticle1
+	query := regexp.QuoteMeta(`
+		UPDATE articles
+		SET title = $1, slug = $2, body = $3, author_id = $4, category_id = $5, updated_at = $6
+		WHERE id = $7
+	`)
+
+	mock.ExpectExec(query).WithArgs(
+		article.Title,
+		article.Slug,
+		article.Body,
+		article.Author.ID,
+		article.CategoryID(),
+		utils.AnyTime{},
+		article.ID,
+	).WillReturnResult(
+		sqlmock.NewResult(0, 0),
+	)
+
+	repo := GetArticleRepository()
+	err := repo.Update(context.Background(), &article)
+
+	assert.Equal(t, apperror.ErrNoAffectedRows, err)
+}
+
+func Test_Article_List_Success_WhenIncludeDeleted(t *testing.T) {
+	mock := db_client.InitDatabaseMock()
+
+	article := factory.SampleArticle1
+	deletedAt := article.CreatedAt
+	article.DeletedAt = &deletedAt
+	query := regexp.QuoteMeta(`
+		FROM articles
+		JOIN authors ON articles.author_id = authors.id
+		LEFT JOIN categories ON articles.category_id = categories.id
+		ORDER BY articles.created_at DESC
+		LIMIT $1 OFFSET $2
+	`)
+
+	columns := []string{
+		"id",
+		"title",
+		"slug",
+		"body",
+		"created_at",
+		"updated_at",
+		"status",
+		"published_at",
+		"publish_at",
+		"deleted_at",
+		"author_id",
+		"author_name",
+		"author_handle",
+		"category_id",
+		"category_name",
+		"tags",
+	}
+
+	mock.ExpectQuery(query).WithArgs(20, 0).
+		WillReturnRows(
+			sqlmock.NewRows(columns).AddRow(
+				article.ID,
+				article.Title,
+				article.Slug,
+				article.Body,
+				article.CreatedAt,
+				article.UpdatedAt,
+				article.Status,
+				article.PublishedAt,
+				article.PublishAt,
+				article.DeletedAt,
+				article.Author.ID,
+				article.Author.Name,
+				article.Author.Handle,
+				nil,
+				nil,
+				tagsArray(article.Tags),
+			),
+		)
+
+	repo := GetArticleRepository()
+	filter := ArticleFilter{IncludeDeleted: true}
+	articles, err := repo.List(context.Background(), filter)
+
+	assert.Equal(t, &article, articles[0])
+	assert.Nil(t, err)
+}
+
+func Test_Article_Delete_Success(t *testing.T) {
+	mock := db_client.InitDatabaseMock()
+
+	article := factory.SampleArticle1
+	query := regexp.QuoteMeta(`
+		UPDATE articles
+		SET deleted_at = $1, updated_at = $1
+		WHERE id = $2 AND deleted_at IS NULL
+	`)
+
+	mock.ExpectExec(query).WithArgs(utils.AnyTime{}, article.ID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	repo := GetArticleRepository()
+	err := repo.Delete(context.Background(), article.ID)
+
+	assert.Nil(t, err)
+}
+
+func Test_Article_Delete_ReturnErr_WhenExecFailed(t *testing.T) {
+	mock := db_client.InitDatabaseMock()
+
+	article := factory.SampleArticle1
+	query := regexp.QuoteMeta(`
+		UPDATE articles
+		SET deleted_at = $1, updated_at = $1
+		WHERE id = $2 AND deleted_at IS NULL
+	`)
+
+	stubErr := errors.New("db error")
+	mock.ExpectExec(query).WithArgs(utils.AnyTime{}, article.ID).
+		WillReturnError(stubErr)
+
+	repo := GetArticleRepository()
+	err := repo.Delete(context.Background(), article.ID)
+
+	assert.Equal(t, apperror.ErrUpdateRecordFailed, err)
+}
+
+func Test_Article_Delete_ReturnErr_WhenNoRowsAffected(t *testing.T) {
+	mock := db_client.InitDatabaseMock()
+
+	article := factory.SampleArticle1
+	query := regexp.QuoteMeta(`
+		UPDATE articles
+		SET deleted_at = $1, updated_at = $1
+		WHERE id = $2 AND deleted_at IS NULL
+	`)
+
+	mock.ExpectExec(query).WithArgs(utils.AnyTime{}, article.ID).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	repo := GetArticleRepository()
+	err := repo.Delete(context.Background(), article.ID)
+
+	assert.Equal(t, apperror.ErrNoAffectedRows, err)
+}
+
+func Test_Article_Restore_Success(t *testing.T) {
+	mock := db_client.InitDatabaseMock()
+
+	article := factory.SampleArticle1
+	query := regexp.QuoteMeta(`
+		UPDATE articles
+		SET deleted_at = NULL, updated_at = $1
+		WHERE id = $2 AND deleted_at IS NOT NULL
+	`)
+
+	mock.ExpectExec(query).WithArgs(utils.AnyTime{}, article.ID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	repo := GetArticleRepository()
+	err := repo.Restore(context.Background(), article.ID)
+
+	assert.Nil(t, err)
+}
+
+func Test_Article_Restore_ReturnErr_WhenNoRowsAffected(t *testing.T) {
+	mock := db_client.InitDatabaseMock()
+
+	article := factory.SampleArticle1
+	query := regexp.QuoteMeta(`
+		UPDATE articles
+		SET deleted_at = NULL, updated_at = $1
+		WHERE id = $2 AND deleted_at IS NOT NULL
+	`)
+
+	mock.ExpectExec(query).WithArgs(utils.AnyTime{}, article.ID).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	repo := GetArticleRepository()
+	err := repo.Restore(context.Background(), article.ID)
+
+	assert.Equal(t, apperror.ErrNoAffectedRows, err)
+}
+
+func Test_Article_UpdateStatus_Success(t *testing.T) {
+	mock := db_client.InitDatabaseMock()
+
+	article := factory.SampleArticle1
+	article.Status = model.ArticleStatusArchived
+	query := regexp.QuoteMeta(`
+		UPDATE articles
+		SET status = $1, published_at = $2, publish_at = $3, updated_at = $4
+		WHERE id = $5
+	`)
+
+	mock.ExpectExec(query).WithArgs(
+		article.Status,
+		article.PublishedAt,
+		article.PublishAt,
+		utils.AnyTime{},
+		article.ID,
+	).WillReturnResult(
+		sqlmock.NewResult(0, 1),
+	)
+
+	repo := GetArticleRepository()
+	err := repo.UpdateStatus(context.Background(), &article)
+
+	assert.Nil(t, err)
+	assert.True(t, article.UpdatedAt.After(factory.SampleArticle1.UpdatedAt))
+}
+
+func Test_Article_UpdateStatus_ReturnErr_WhenNoRowsAffected(t *testing.T) {
+	mock := db_client.InitDatabaseMock()
+
+	article := factory.SampleArticle1
+	query := regexp.QuoteMeta(`
+		UPDATE articles
+		SET status = $1, published_at = $2, publish_at = $3, updated_at = $4
+		WHERE id = $5
+	`)
+
+	mock.ExpectExec(query).WillReturnResult(sqlmock.NewResult(0, 0))
+
+	repo := GetArticleRepository()
+	err := repo.UpdateStatus(context.Background(), &article)
+
+	assert.Equal(t, apperror.ErrNoAffectedRows, err)
+}
+
+func Test_Article_GetRecordsCount_Success_WithStatusFilter(t *testing.T) {
+	mock := db_client.InitDatabaseMock()
+
+	query := regexp.QuoteMeta(`
+		SELECT COUNT(*)
+		FROM articles
+		JOIN authors ON articles.author_id = authors.id
+		WHERE articles.deleted_at IS NULL AND articles.status = $1
+	`)
+
+	columns := []string{"count"}
+	mock.ExpectQuery(query).WithArgs(model.ArticleStatusPublished).
+		WillReturnRows(sqlmock.NewRows(columns).AddRow(2))
+
+	repo := GetArticleRepository()
+	filter := ArticleFilter{Status: model.ArticleStatusPublished}
+	recordsCount, err := repo.GetRecordsCount(context.Background(), filter)
+
+	assert.Equal(t, int64(2), recordsCount)
+	assert.Nil(t, err)
+}
+
+func Test_Article_CountFacets_Success(t *testing.T) {
+	mock := db_client.InitDatabaseMock()
+
+	author := factory.SampleAuthorChandra
+	mock.ExpectQuery(regexp.QuoteMeta(`
+		SELECT authors.id::text, authors.name, COUNT(*)
+		FROM articles
+		JOIN authors ON articles.author_id = authors.id
+		WHERE articles.deleted_at IS NULL AND articles.status = $1
+		GROUP BY authors.id, authors.name
+		ORDER BY COUNT(*) DESC, authors.name ASC
+		LIMIT $2
+	`)).WithArgs(model.ArticleStatusPublished, model.FacetSize).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "count"}).AddRow(author.ID.String(), author.Name, 2))
+
+	mock.ExpectQuery(regexp.QuoteMeta(`
+		SELECT to_char(articles.created_at AT TIME ZONE 'UTC', 'YYYY-MM') AS month, '', COUNT(*)
+		FROM articles
+		JOIN authors ON articles.author_id = authors.id
+		WHERE articles.deleted_at IS NULL AND articles.status = $1
+		GROUP BY month
+		ORDER BY month DESC
+	`)).WithArgs(model.ArticleStatusPublished).
+		WillReturnRows(sqlmock.NewRows([]string{"month", "label", "count"}).AddRow("2025-07", "", 2).AddRow("2025-06", "", 1))
+
+	mock.ExpectQuery(regexp.QuoteMeta(`
+		SELECT tags.name, '', COUNT(*)
+		FROM articles
+		JOIN authors ON articles.author_id = authors.id
+		JOIN article_tags ON article_tags.article_id = articles.id
+		JOIN tags ON article_tags.tag_id = tags.id
+		WHERE articles.deleted_at IS NULL AND articles.status = $1
+		GROUP BY tags.name
+		ORDER BY COUNT(*) DESC, tags.name ASC
+		LIMIT $2
+	`)).WithArgs(model.ArticleStatusPublished, model.FacetSize).
+		WillReturnRows(sqlmock.NewRows([]string{"name", "label", "count"}))
+
+	repo := GetArticleRepository()
+	filter := ArticleFilter{Status: model.ArticleStatusPublished, Limit: 10, Offset: 20}
+	facets, err := repo.CountFacets(context.Background(), filter, []string{model.FacetAuthor, model.FacetMonth, model.FacetTag})
+
+	assert.Nil(t, err)
+	assert.Equal(t, model.ArticleFacets{
+		model.FacetAuthor: {{Value: author.ID.String(), Label: author.Name, Count: 2}},
+		model.FacetMonth:  {{Value: "2025-07", Count: 2}, {Value: "2025-06", Count: 1}},
+		model.FacetTag:    {},
+	}, facets)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func Test_Article_CountFacets_ReturnErr_WhenQueryFailed(t *testing.T) {
+	mock := db_client.InitDatabaseMock()
+
+	mock.ExpectQuery(regexp.QuoteMeta(`GROUP BY tags.name`)).WillReturnError(errors.New("db error"))
+
+	repo := GetArticleRepository()
+	facets, err := repo.CountFacets(context.Background(), ArticleFilter{}, []string{model.FacetTag})
+
+	assert.Nil(t, facets)
+	assert.Equal(t, apperror.ErrGetRecordFailed, err)
+}
+
+func Test_Article_ListDueForPublishing_Success(t *testing.T) {
+	mock := db_client.InitDatabaseMock()
+
+	article := factory.SampleArticle1
+	article.Status = model.ArticleStatusDraft
+	article.PublishedAt = nil
+	publishAt := article.CreatedAt.Add(time.Hour)
+	article.PublishAt = &publishAt
+
+	query := regexp.QuoteMeta(`
+		FROM articles
+		JOIN authors ON articles.author_id = authors.id
+		LEFT JOIN categories ON articles.category_id = categories.id
+		WHERE articles.status = $1
+			AND articles.deleted_at IS NULL
+			AND articles.publish_at <= $2
+		ORDER BY articles.publish_at ASC
+		LIMIT $3
+		FOR UPDATE OF articles SKIP LOCKED
+	`)
+
+	columns := []string{
+		"id",
+		"title",
+		"slug",
+		"body",
+		"created_at",
+		"updated_at",
+		"status",
+		"published_at",
+		"publish_at",
+		"deleted_at",
+		"author_id",
+		"author_name",
+		"author_handle",
+		"category_id",
+		"category_name",
+		"tags",
+	}
+
+	now := time.Now()
+	mock.ExpectQuery(query).WithArgs(model.ArticleStatusDraft, now, 50).
+		WillReturnRows(
+			sqlmock.NewRows(columns).AddRow(
+				article.ID,
+				article.Title,
+				article.Slug,
+				article.Body,
+				article.CreatedAt,
+				article.UpdatedAt,
+				article.Status,
+				article.PublishedAt,
+				article.PublishAt,
+				article.DeletedAt,
+				article.Author.ID,
+				article.Author.Name,
+				article.Author.Handle,
+				nil,
+				nil,
+				tagsArray(article.Tags),
+			),
+		)
+
+	repo := GetArticleRepository()
+	articles, err := repo.ListDueForPublishing(context.Background(), now, 50)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []*model.Article{&article}, articles)
+}
+
+func Test_Article_ListDueForPublishing_ReturnErr_WhenQueryFailed(t *testing.T) {
+	mock := db_client.InitDatabaseMock()
+
+	query := regexp.QuoteMeta(`FOR UPDATE OF articles SKIP LOCKED`)
+	mock.ExpectQuery(query).WillReturnError(errors.New("db error"))
+
+	repo := GetArticleRepository()
+	articles, err := repo.ListDueForPublishing(context.Background(), time.Now(), 50)
+
+	assert.Nil(t, articles)
+	assert.Equal(t, apperror.ErrGetRecordFailed, err)
+}
+
+func Test_Article_ListForIndexing_Success(t *testing.T) {
+	mock := db_client.InitDatabaseMock()
+
+	article := factory.SampleArticle1
+	query := regexp.QuoteMeta(`
+		WHERE articles.id > $1
+			AND (articles.updated_at >= $2 OR authors.updated_at >= $2)
+		ORDER BY articles.id ASC
+		LIMIT $3
+	`)
+
+	columns := []string{
+		"id",
+		"title",
+		"slug",
+		"body",
+		"created_at",
+		"updated_at",
+		"status",
+		"published_at",
+		"publish_at",
+		"deleted_at",
+		"author_id",
+		"author_name",
+		"author_handle",
+		"category_id",
+		"category_name",
+		"tags",
+	}
+
+	mock.ExpectQuery(query).WithArgs(uuid.Nil, time.Time{}, 500).
+		WillReturnRows(
+			sqlmock.NewRows(columns).AddRow(
+				article.ID,
+				article.Title,
+				article.Slug,
+				article.Body,
+				article.CreatedAt,
+				article.UpdatedAt,
+				article.Status,
+				article.PublishedAt,
+				article.PublishAt,
+				article.DeletedAt,
+				article.Author.ID,
+				article.Author.Name,
+				article.Author.Handle,
+				nil,
+				nil,
+				tagsArray(article.Tags),
+			),
+		)
+
+	repo := GetArticleRepository()
+	articles, err := repo.ListForIndexing(context.Background(), uuid.Nil, time.Time{}, 500)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []*model.Article{&article}, articles)
+}
+
+func Test_Article_ListForIndexing_ReturnErr_WhenQueryFailed(t *testing.T) {
+	mock := db_client.InitDatabaseMock()
+
+	query := regexp.QuoteMeta(`ORDER BY articles.id ASC`)
+	mock.ExpectQuery(query).WillReturnError(errors.New("db error"))
+
+	repo := GetArticleRepository()
+	articles, err := repo.ListForIndexing(context.Background(), uuid.Nil, time.Time{}, 500)
+
+	assert.Nil(t, articles)
+	assert.Equal(t, apperror.ErrGetRecordFailed, err)
+}
+
+// tagsArray formats tags the way Postgres returns a text array
+func tagsArray(tags []string) string {
+	return "{" + strings.Join(tags, ",") + "}"
+}
@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+
+	"article-service/model"
+
+	"github.com/google/uuid"
+)
+
+//go:generate mockgen -source=article_revision_repo.go -destination=./mock_repository/article_revision_repo_mock.go
+type IArticleRevisionRepository interface {
+	Create(ctx context.Context, revision *model.ArticleRevision) error
+	Get(ctx context.Context, articleID uuid.UUID, revision int) (*model.ArticleRevision, error)
+	List(ctx context.Context, articleID uuid.UUID) ([]*model.ArticleRevision, error)
+}
@@ -0,0 +1,159 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"article-service/apperror"
+	"article-service/db/db_client"
+	"article-service/db/transaction"
+	"article-service/infrastructure/log"
+	"article-service/model"
+
+	"github.com/google/uuid"
+)
+
+type ArticleRevisionRepo struct {
+}
+
+func GetArticleRevisionRepository() IArticleRevisionRepository {
+	return ArticleRevisionRepo{}
+}
+
+// the article row is locked by the caller's update, so concurrent writers get consecutive numbers
+func (r ArticleRevisionRepo) Create(ctx context.Context, revision *model.ArticleRevision) error {
+	conn := transaction.GetClientOrTxn(ctx, db_client.GetDB)
+
+	query := `
+		INSERT INTO article_revisions
+			(id, article_id, revision, title, body, author_id, created_at)
+		SELECT $1, $2, COALESCE(MAX(revision), 0) + 1, $3, $4, $5, $6
+		FROM article_revisions
+		WHERE article_id = $2
+		RETURNING revision
+	`
+
+	now := time.Now()
+	rows, err := conn.Query(
+		ctx,
+		query,
+		&revision.ID,
+		&revision.ArticleID,
+		&revision.Title,
+		&revision.Body,
+		&revision.AuthorID,
+		now,
+	)
+	if err != nil {
+		log.Errorf(ctx, err, "[ArticleRevisionRepo][Create] Query failed")
+		return apperror.ErrCreateRecordFailed
+	}
+
+	var revisionNumber int
+	for rows.Next() {
+		err = rows.Scan(&revisionNumber)
+		if err != nil {
+			log.Errorf(ctx, err, "[ArticleRevisionRepo][Create] Scan failed")
+			return apperror.ErrScanRecordFailed
+		}
+	}
+
+	if revisionNumber == 0 {
+		log.Errorf(ctx, err, "[ArticleRevisionRepo][Create] No affected rows")
+		return apperror.ErrNoAffectedRows
+	}
+
+	revision.Revision = revisionNumber
+	revision.CreatedAt = now
+	return nil
+}
+
+func (r ArticleRevisionRepo) Get(ctx context.Context, articleID uuid.UUID, revision int) (*model.ArticleRevision, error) {
+	conn := transaction.GetClientOrTxn(ctx, db_client.GetDB)
+	query := `
+		SELECT
+			article_revisions.id,
+			article_revisions.article_id,
+			article_revisions.revision,
+			article_revisions.title,
+			article_revisions.body,
+			article_revisions.author_id,
+			article_revisions.created_at
+		FROM article_revisions
+		WHERE article_id = $1 AND revision = $2
+	`
+
+	rows, err := conn.Query(ctx, query, articleID, revision)
+	if err != nil {
+		log.Errorf(ctx, err, "[ArticleRevisionRepo][Get] Query failed")
+		return nil, apperror.ErrGetRecordFailed
+	}
+
+	var articleRevision = model.ArticleRevision{}
+	for rows.Next() {
+		err = rows.Scan(
+			&articleRevision.ID,
+			&articleRevision.ArticleID,
+			&articleRevision.Revision,
+			&articleRevision.Title,
+			&articleRevision.Body,
+			&articleRevision.AuthorID,
+			&articleRevision.CreatedAt,
+		)
+		if err != nil {
+			log.Errorf(ctx, err, "[ArticleRevisionRepo][Get] Scan failed")
+			return nil, apperror.ErrScanRecordFailed
+		}
+	}
+
+	if articleRevision.ID == uuid.Nil {
+		return nil, apperror.ErrObjectNotExists
+	}
+
+	return &articleRevision, nil
+}
+
+func (r ArticleRevisionRepo) List(ctx context.Context, articleID uuid.UUID) ([]*model.ArticleRevision, error) {
+	conn := transaction.GetClientOrTxn(ctx, db_client.GetDB)
+	query := `
+		SELECT
+			article_revisions.id,
+			article_revisions.article_id,
+			article_revisions.revision,
+			article_revisions.title,
+			article_revisions.body,
+			article_revisions.author_id,
+			article_revisions.created_at
+		FROM article_revisions
+		WHERE article_id = $1
+		ORDER BY revision DESC
+	`
+
+	rows, err := conn.Query(ctx, query, articleID)
+	if err != nil {
+		log.Errorf(ctx, err, "[ArticleRevisionRepo][List] Query failed")
+		return nil, apperror.ErrGetRecordFailed
+	}
+
+	var revisions = []*model.ArticleRevision{}
+	for rows.Next() {
+		var articleRevision model.ArticleRevision
+		err = rows.Scan(
+			&articleRevision.ID,
+			&articleRevision.ArticleID,
+			&articleRevision.Revision,
+			&articleRevision.Title,
+			&articleRevision.Body,
+			&articleRevision.AuthorID,
+			&articleRevision.CreatedAt,
+		)
+		if err != nil {
+			log.Errorf(ctx, err, "[ArticleRevisionRepo][List] Scan failed")
+			return nil, apperror.ErrScanRecordFailed
+		}
+
+		revisions = append(revisions, &articleRevision)
+	}
+
+	return revisions, nil
+}
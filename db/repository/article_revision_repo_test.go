@@ -0,0 +1,141 @@
+package repository
+
+import (
+	"article-service/apperror"
+	"article-service/db/db_client"
+	"article-service/factory"
+	"article-service/model"
+	"article-service/utils"
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ArticleRevision_Create_Success(t *testing.T) {
+	mock := db_client.InitDatabaseMock()
+
+	revision := factory.SampleArticle1Revision1
+	revision.Revision = 0
+	query := regexp.QuoteMeta(`
+		INSERT INTO article_revisions
+			(id, article_id, revision, title, body, author_id, created_at)
+		SELECT $1, $2, COALESCE(MAX(revision), 0) + 1, $3, $4, $5, $6
+		FROM article_revisions
+		WHERE article_id = $2
+		RETURNING revision
+	`)
+
+	mock.ExpectQuery(query).WithArgs(
+		revision.ID,
+		revision.ArticleID,
+		revision.Title,
+		revision.Body,
+		revision.AuthorID,
+		utils.AnyTime{},
+	).WillReturnRows(sqlmock.NewRows([]string{"revision"}).AddRow(2))
+
+	repo := GetArticleRevisionRepository()
+	err := repo.Create(context.Background(), &revision)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 2, revision.Revision)
+}
+
+func Test_ArticleRevision_Create_ReturnErr_WhenQueryFailed(t *testing.T) {
+	mock := db_client.InitDatabaseMock()
+
+	revision := factory.SampleArticle1Revision1
+	query := regexp.QuoteMeta(`INSERT INTO article_revisions`)
+	mock.ExpectQuery(query).WillReturnError(errors.New("db error"))
+
+	repo := GetArticleRevisionRepository()
+	err := repo.Create(context.Background(), &revision)
+
+	assert.Equal(t, apperror.ErrCreateRecordFailed, err)
+}
+
+func Test_ArticleRevision_Get_Success(t *testing.T) {
+	mock := db_client.InitDatabaseMock()
+
+	revision := factory.SampleArticle1Revision1
+	query := regexp.QuoteMeta(`
+		SELECT
+			article_revisions.id,
+			article_revisions.article_id,
+			article_revisions.revision,
+			article_revisions.title,
+			article_revisions.body,
+			article_revisions.author_id,
+			article_revisions.created_at
+		FROM article_revisions
+		WHERE article_id = $1 AND revision = $2
+	`)
+
+	columns := []string{"id", "article_id", "revision", "title", "body", "author_id", "created_at"}
+	mock.ExpectQuery(query).WithArgs(revision.ArticleID, revision.Revision).
+		WillReturnRows(sqlmock.NewRows(columns).AddRow(
+			revision.ID,
+			revision.ArticleID,
+			revision.Revision,
+			revision.Title,
+			revision.Body,
+			revision.AuthorID,
+			revision.CreatedAt,
+		))
+
+	repo := GetArticleRevisionRepository()
+	result, err := repo.Get(context.Background(), revision.ArticleID, revision.Revision)
+
+	assert.Nil(t, err)
+	assert.Equal(t, &revision, result)
+}
+
+func Test_ArticleRevision_Get_ReturnErr_WhenRecordNotFound(t *testing.T) {
+	mock := db_client.InitDatabaseMock()
+
+	revision := factory.SampleArticle1Revision1
+	query := regexp.QuoteMeta(`WHERE article_id = $1 AND revision = $2`)
+
+	columns := []string{"id", "article_id", "revision", "title", "body", "author_id", "created_at"}
+	mock.ExpectQuery(query).WithArgs(revision.ArticleID, 5).
+		WillReturnRows(sqlmock.NewRows(columns))
+
+	repo := GetArticleRevisionRepository()
+	result, err := repo.Get(context.Background(), revision.ArticleID, 5)
+
+	assert.Nil(t, result)
+	assert.Equal(t, apperror.ErrObjectNotExists, err)
+}
+
+func Test_ArticleRevision_List_Success(t *testing.T) {
+	mock := db_client.InitDatabaseMock()
+
+	revision := factory.SampleArticle1Revision1
+	query := regexp.QuoteMeta(`
+		FROM article_revisions
+		WHERE article_id = $1
+		ORDER BY revision DESC
+	`)
+
+	columns := []string{"id", "article_id", "revision", "title", "body", "author_id", "created_at"}
+	mock.ExpectQuery(query).WithArgs(revision.ArticleID).
+		WillReturnRows(sqlmock.NewRows(columns).AddRow(
+			revision.ID,
+			revision.ArticleID,
+			revision.Revision,
+			revision.Title,
+			revision.Body,
+			revision.AuthorID,
+			revision.CreatedAt,
+		))
+
+	repo := GetArticleRevisionRepository()
+	result, err := repo.List(context.Background(), revision.ArticleID)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []*model.ArticleRevision{&revision}, result)
+}
@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+//go:generate mockgen -source=article_slug_repo.go -destination=./mock_repository/article_slug_repo_mock.go
+type IArticleSlugRepository interface {
+	Create(ctx context.Context, articleID uuid.UUID, slug string) error
+	GetArticleID(ctx context.Context, slug string) (uuid.UUID, error)
+	ListTaken(ctx context.Context, base string, articleID uuid.UUID) ([]string, error)
+}
@@ -0,0 +1,106 @@
+package repository
+
+import (
+	"context"
+
+	"article-service/apperror"
+	"article-service/db/db_client"
+	"article-service/db/transaction"
+	"article-service/infrastructure/log"
+
+	"github.com/google/uuid"
+)
+
+type ArticleSlugRepo struct {
+}
+
+func GetArticleSlugRepository() IArticleSlugRepository {
+	return ArticleSlugRepo{}
+}
+
+// a slug that ever belonged to another article is never reused
+func (r ArticleSlugRepo) Create(ctx context.Context, articleID uuid.UUID, slug string) error {
+	conn := transaction.GetClientOrTxn(ctx, db_client.GetDB)
+
+	query := `
+		INSERT INTO article_slugs
+			(slug, article_id)
+		VALUES ($1, $2)
+		ON CONFLICT (slug) DO UPDATE SET created_at = NOW()
+		WHERE article_slugs.article_id = EXCLUDED.article_id
+	`
+
+	res, err := conn.Exec(ctx, query, slug, articleID)
+	if err != nil {
+		log.Errorf(ctx, err, "[ArticleSlugRepo][Create] Exec failed")
+		return apperror.ErrCreateRecordFailed
+	}
+
+	affected, _ := res.RowsAffected()
+	if affected == 0 {
+		log.Errorf(ctx, apperror.ErrArticleSlugTaken, "[ArticleSlugRepo][Create] Slug belongs to another article, slug: %s", slug)
+		return apperror.ErrArticleSlugTaken
+	}
+
+	return nil
+}
+
+func (r ArticleSlugRepo) GetArticleID(ctx context.Context, slug string) (uuid.UUID, error) {
+	conn := transaction.GetClientOrTxn(ctx, db_client.GetDB)
+	query := `
+		SELECT article_slugs.article_id
+		FROM article_slugs
+		WHERE article_slugs.slug = $1
+	`
+
+	rows, err := conn.Query(ctx, query, slug)
+	if err != nil {
+		log.Errorf(ctx, err, "[ArticleSlugRepo][GetArticleID] Query failed")
+		return uuid.Nil, apperror.ErrGetRecordFailed
+	}
+
+	var articleID uuid.UUID
+	for rows.Next() {
+		err = rows.Scan(&articleID)
+		if err != nil {
+			log.Errorf(ctx, err, "[ArticleSlugRepo][GetArticleID] Scan failed")
+			return uuid.Nil, apperror.ErrScanRecordFailed
+		}
+	}
+
+	if articleID == uuid.Nil {
+		return uuid.Nil, apperror.ErrObjectNotExists
+	}
+
+	return articleID, nil
+}
+
+// slugs only contain letters, digits and "-", so base needs no LIKE escaping
+func (r ArticleSlugRepo) ListTaken(ctx context.Context, base string, articleID uuid.UUID) ([]string, error) {
+	conn := transaction.GetClientOrTxn(ctx, db_client.GetDB)
+	query := `
+		SELECT article_slugs.slug
+		FROM article_slugs
+		WHERE (article_slugs.slug = $1 OR article_slugs.slug LIKE $2)
+			AND article_slugs.article_id <> $3
+	`
+
+	rows, err := conn.Query(ctx, query, base, base+"-%", articleID)
+	if err != nil {
+		log.Errorf(ctx, err, "[ArticleSlugRepo][ListTaken] Query failed")
+		return nil, apperror.ErrGetRecordFailed
+	}
+
+	var slugs []string
+	for rows.Next() {
+		var slug string
+		err = rows.Scan(&slug)
+		if err != nil {
+			log.Errorf(ctx, err, "[ArticleSlugRepo][ListTaken] Scan failed")
+			return nil, apperror.ErrScanRecordFailed
+		}
+		slugs = append(slugs, slug)
+	}
+
+	return slugs, nil
+}
@@ -0,0 +1,121 @@
+package repository
+
+import (
+	"article-service/apperror"
+	"article-service/db/db_client"
+	"article-service/factory"
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ArticleSlug_Create_Success(t *testing.T) {
+	mock := db_client.InitDatabaseMock()
+
+	article := factory.SampleArticle1
+	mock.ExpectExec(regexp.QuoteMeta(`
+		INSERT INTO article_slugs
+			(slug, article_id)
+		VALUES ($1, $2)
+		ON CONFLICT (slug) DO UPDATE SET created_at = NOW()
+		WHERE article_slugs.article_id = EXCLUDED.article_id
+	`)).WithArgs(article.Slug, article.ID).WillReturnResult(sqlmock.NewResult(0, 1))
+
+	repo := GetArticleSlugRepository()
+	err := repo.Create(context.Background(), article.ID, article.Slug)
+
+	assert.Nil(t, err)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func Test_ArticleSlug_Create_ReturnErr_WhenSlugBelongsToAnotherArticle(t *testing.T) {
+	mock := db_client.InitDatabaseMock()
+
+	article := factory.SampleArticle1
+	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO article_slugs`)).
+		WithArgs(article.Slug, article.ID).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	repo := GetArticleSlugRepository()
+	err := repo.Create(context.Background(), article.ID, article.Slug)
+
+	assert.Equal(t, apperror.ErrArticleSlugTaken, err)
+}
+
+func Test_ArticleSlug_Create_ReturnErr_WhenExecFailed(t *testing.T) {
+	mock := db_client.InitDatabaseMock()
+
+	article := factory.SampleArticle1
+	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO article_slugs`)).WillReturnError(errors.New("db error"))
+
+	repo := GetArticleSlugRepository()
+	err := repo.Create(context.Background(), article.ID, article.Slug)
+
+	assert.Equal(t, apperror.ErrCreateRecordFailed, err)
+}
+
+func Test_ArticleSlug_GetArticleID_Success(t *testing.T) {
+	mock := db_client.InitDatabaseMock()
+
+	article := factory.SampleArticle1
+	rows := sqlmock.NewRows([]string{"article_id"}).AddRow(article.ID)
+	mock.ExpectQuery(regexp.QuoteMeta(`
+		SELECT article_slugs.article_id
+		FROM article_slugs
+		WHERE article_slugs.slug = $1
+	`)).WithArgs("old-slug").WillReturnRows(rows)
+
+	repo := GetArticleSlugRepository()
+	articleID, err := repo.GetArticleID(context.Background(), "old-slug")
+
+	assert.Nil(t, err)
+	assert.Equal(t, article.ID, articleID)
+}
+
+func Test_ArticleSlug_GetArticleID_ReturnErr_WhenNotFound(t *testing.T) {
+	mock := db_client.InitDatabaseMock()
+
+	rows := sqlmock.NewRows([]string{"article_id"})
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT article_slugs.article_id`)).WithArgs("missing").WillReturnRows(rows)
+
+	repo := GetArticleSlugRepository()
+	articleID, err := repo.GetArticleID(context.Background(), "missing")
+
+	assert.Equal(t, apperror.ErrObjectNotExists, err)
+	assert.Equal(t, uuid.Nil, articleID)
+}
+
+func Test_ArticleSlug_ListTaken_Success(t *testing.T) {
+	mock := db_client.InitDatabaseMock()
+
+	article := factory.SampleArticle1
+	rows := sqlmock.NewRows([]string{"slug"}).AddRow("ibu").AddRow("ibu-2")
+	mock.ExpectQuery(regexp.QuoteMeta(`
+		SELECT article_slugs.slug
+		FROM article_slugs
+		WHERE (article_slugs.slug = $1 OR article_slugs.slug LIKE $2)
+			AND article_slugs.article_id <> $3
+	`)).WithArgs("ibu", "ibu-%", article.ID).WillReturnRows(rows)
+
+	repo := GetArticleSlugRepository()
+	slugs, err := repo.ListTaken(context.Background(), "ibu", article.ID)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"ibu", "ibu-2"}, slugs)
+}
+
+func Test_ArticleSlug_ListTaken_ReturnErr_WhenQueryFailed(t *testing.T) {
+	mock := db_client.InitDatabaseMock()
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT article_slugs.slug`)).WillReturnError(errors.New("db error"))
+
+	repo := GetArticleSlugRepository()
+	_, err := repo.ListTaken(context.Background(), "ibu", factory.SampleArticle1.ID)
+
+	assert.Equal(t, apperror.ErrGetRecordFailed, err)
+}
@@ -9,5 +9,17 @@ import (
 
 //go:generate mockgen -source=author_repo.go -destination=./mock_repository/author_repo_mock.go
 type IAuthorRepository interface {
+	Create(ctx context.Context, author *model.Author) error
 	Get(ctx context.Context, id uuid.UUID) (*model.Author, error)
+	GetByHandle(ctx context.Context, handle string) (*model.Author, error)
+	List(ctx context.Context, filter AuthorFilter) ([]*model.Author, error)
+	GetRecordsCount(ctx context.Context, filter AuthorFilter) (int64, error)
+	Update(ctx context.Context, author *model.Author) error
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+type AuthorFilter struct {
+	Name   string
+	Limit  int
+	Offset int
 }
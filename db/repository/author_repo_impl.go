@@ -6,7 +6,10 @@ import (
 	"article-service/db/transaction"
 	"article-service/infrastructure/log"
 	"article-service/model"
+	"article-service/utils"
 	"context"
+	"fmt"
+	"strings"
 
 	"github.com/google/uuid"
 )
@@ -18,12 +21,53 @@ func GetAuthorRepository() IAuthorRepository {
 	return AuthorRepo{}
 }
 
+func (r AuthorRepo) Create(ctx context.Context, author *model.Author) error {
+	conn := transaction.GetClientOrTxn(ctx, db_client.GetDB)
+
+	query := `
+		INSERT INTO authors
+			(id, name, handle, bio, avatar_url, website)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`
+
+	res, err := conn.Exec(
+		ctx,
+		query,
+		&author.ID,
+		&author.Name,
+		&author.Handle,
+		&author.Bio,
+		&author.AvatarURL,
+		&author.Website,
+	)
+	if err != nil {
+		if db_client.IsUniqueViolation(err) {
+			log.Errorf(ctx, err, "[AuthorRepo][Create] Handle is already taken")
+			return apperror.ErrAuthorHandleTaken
+		}
+		log.Errorf(ctx, err, "[AuthorRepo][Create] Exec failed")
+		return apperror.ErrCreateRecordFailed
+	}
+
+	affected, _ := res.RowsAffected()
+	if affected == 0 {
+		log.Errorf(ctx, err, "[AuthorRepo][Create] No affected rows")
+		return apperror.ErrNoAffectedRows
+	}
+
+	return nil
+}
+
 func (r AuthorRepo) Get(ctx context.Context, id uuid.UUID) (*model.Author, error) {
 	conn := transaction.GetClientOrTxn(ctx, db_client.GetDB)
 	query := `
 		SELECT
 			authors.id,
-			authors.name
+			authors.name,
+			authors.handle,
+			authors.bio,
+			authors.avatar_url,
+			authors.website
 		FROM authors
 		WHERE id = $1
 	`
@@ -39,6 +83,10 @@ func (r AuthorRepo) Get(ctx context.Context, id uuid.UUID) (*model.Author, error
 		err = rows.Scan(
 			&author.ID,
 			&author.Name,
+			&author.Handle,
+			&author.Bio,
+			&author.AvatarURL,
+			&author.Website,
 		)
 		if err != nil {
 			log.Errorf(ctx, err, "[AuthorRepo][Get] Scan failed")
@@ -52,3 +100,207 @@ func (r AuthorRepo) Get(ctx context.Context, id uuid.UUID) (*model.Author, error
 
 	return &author, nil
 }
+
+func (r AuthorRepo) GetByHandle(ctx context.Context, handle string) (*model.Author, error) {
+	conn := transaction.GetClientOrTxn(ctx, db_client.GetDB)
+	query := `
+		SELECT
+			authors.id,
+			authors.name,
+			authors.handle,
+			authors.bio,
+			authors.avatar_url,
+			authors.website
+		FROM authors
+		WHERE handle = $1
+	`
+
+	rows, err := conn.Query(ctx, query, handle)
+	if err != nil {
+		log.Errorf(ctx, err, "[AuthorRepo][GetByHandle] Query failed")
+		return nil, apperror.ErrGetRecordFailed
+	}
+
+	var author = model.Author{}
+	for rows.Next() {
+		err = rows.Scan(
+			&author.ID,
+			&author.Name,
+			&author.Handle,
+			&author.Bio,
+			&author.AvatarURL,
+			&author.Website,
+		)
+		if err != nil {
+			log.Errorf(ctx, err, "[AuthorRepo][GetByHandle] Scan failed")
+			return nil, apperror.ErrScanRecordFailed
+		}
+	}
+
+	if author.ID == uuid.Nil {
+		return nil, apperror.ErrObjectNotExists
+	}
+
+	return &author, nil
+}
+
+func (r AuthorRepo) List(ctx context.Context, filter AuthorFilter) ([]*model.Author, error) {
+	conn := transaction.GetClientOrTxn(ctx, db_client.GetDB)
+	query := `
+		SELECT
+			authors.id,
+			authors.name,
+			authors.handle,
+			authors.bio,
+			authors.avatar_url,
+			authors.website
+		FROM authors
+		{{whereFilters}}
+		ORDER BY authors.name ASC, authors.id ASC
+		{{limitAndOffset}}
+	`
+
+	whereFilters, params := buildAuthorWhereFilters(filter)
+	query = strings.ReplaceAll(query, "{{whereFilters}}", whereFilters)
+
+	limit := utils.SetLimit(filter.Limit)
+	params = append(params, limit, filter.Offset)
+	limitOffsetQuery := fmt.Sprintf("LIMIT $%d OFFSET $%d", len(params)-1, len(params))
+	query = strings.ReplaceAll(query, "{{limitAndOffset}}", limitOffsetQuery)
+
+	rows, err := conn.Query(ctx, query, params...)
+	if err != nil {
+		log.Errorf(ctx, err, "[AuthorRepo][List] Query failed")
+		return nil, apperror.ErrGetRecordFailed
+	}
+
+	var authors = []*model.Author{}
+	for rows.Next() {
+		var author model.Author
+		err = rows.Scan(
+			&author.ID,
+			&author.Name,
+			&author.Handle,
+			&author.Bio,
+			&author.AvatarURL,
+			&author.Website,
+		)
+		if err != nil {
+			log.Errorf(ctx, err, "[AuthorRepo][List] Scan failed")
+			return nil, apperror.ErrScanRecordFailed
+		}
+
+		authors = append(authors, &author)
+	}
+
+	return authors, nil
+}
+
+func (r AuthorRepo) GetRecordsCount(ctx context.Context, filter AuthorFilter) (int64, error) {
+	conn := transaction.GetClientOrTxn(ctx, db_client.GetDB)
+	query := `
+		SELECT COUNT(*)
+		FROM authors
+		{{whereFilters}}
+	`
+
+	whereFilters, params := buildAuthorWhereFilters(filter)
+	query = strings.ReplaceAll(query, "{{whereFilters}}", whereFilters)
+
+	rows, err := conn.Query(ctx, query, params...)
+	if err != nil {
+		log.Errorf(ctx, err, "[AuthorRepo][GetRecordsCount] Query failed")
+		return 0, apperror.ErrGetRecordFailed
+	}
+
+	var rowsCount int64
+	for rows.Next() {
+		err = rows.Scan(&rowsCount)
+		if err != nil {
+			log.Errorf(ctx, err, "[AuthorRepo][GetRecordsCount] Scan failed")
+			return 0, apperror.ErrScanRecordFailed
+		}
+	}
+
+	return rowsCount, nil
+}
+
+func (r AuthorRepo) Update(ctx context.Context, author *model.Author) error {
+	conn := transaction.GetClientOrTxn(ctx, db_client.GetDB)
+
+	query := `
+		UPDATE authors
+		SET name = $1, handle = $2, bio = $3, avatar_url = $4, website = $5, updated_at = NOW()
+		WHERE id = $6
+	`
+
+	res, err := conn.Exec(
+		ctx,
+		query,
+		&author.Name,
+		&author.Handle,
+		&author.Bio,
+		&author.AvatarURL,
+		&author.Website,
+		&author.ID,
+	)
+	if err != nil {
+		if db_client.IsUniqueViolation(err) {
+			log.Errorf(ctx, err, "[AuthorRepo][Update] Handle is already taken")
+			return apperror.ErrAuthorHandleTaken
+		}
+		log.Errorf(ctx, err, "[AuthorRepo][Update] Exec failed")
+		return apperror.ErrUpdateRecordFailed
+	}
+
+	affected, _ := res.RowsAffected()
+	if affected == 0 {
+		log.Errorf(ctx, err, "[AuthorRepo][Update] No affected rows")
+		return apperror.ErrObjectNotExists
+	}
+
+	return nil
+}
+
+func (r AuthorRepo) Delete(ctx context.Context, id uuid.UUID) error {
+	conn := transaction.GetClientOrTxn(ctx, db_client.GetDB)
+
+	query := `
+		DELETE FROM authors
+		WHERE id = $1
+	`
+
+	res, err := conn.Exec(ctx, query, id)
+	if err != nil {
+		if db_client.IsForeignKeyViolation(err) {
+			log.Errorf(ctx, err, "[AuthorRepo][Delete] Author is still referenced by articles")
+			return apperror.ErrAuthorHasArticles
+		}
+		log.Errorf(ctx, err, "[AuthorRepo][Delete] Exec failed")
+		return apperror.ErrDeleteRecordFailed
+	}
+
+	affected, _ := res.RowsAffected()
+	if affected == 0 {
+		log.Errorf(ctx, err, "[AuthorRepo][Delete] No affected rows")
+		return apperror.ErrObjectNotExists
+	}
+
+	return nil
+}
+
+func buildAuthorWhereFilters(filter AuthorFilter) (string, []interface{}) {
+	var params []interface{}
+	var whereFilters []string
+
+	if filter.Name != "" {
+		params = append(params, "%"+filter.Name+"%")
+		whereFilters = append(whereFilters, fmt.Sprintf("LOWER(authors.name) LIKE LOWER($%d)", len(params)))
+	}
+
+	if len(whereFilters) == 0 {
+		return "", params
+	}
+
+	return "WHERE " + strings.Join(whereFilters, " AND "), params
+}
@@ -4,12 +4,14 @@ import (
 	"article-service/apperror"
 	"article-service/db/db_client"
 	"article-service/factory"
+	"article-service/model"
 	"context"
 	"errors"
 	"regexp"
 	"testing"
 
 	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/lib/pq"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -20,17 +22,25 @@ func Test_Author_Get_Success(t *testing.T) {
 	query := regexp.QuoteMeta(`
 		SELECT
 			authors.id,
-			authors.name
+			authors.name,
+			authors.handle,
+			authors.bio,
+			authors.avatar_url,
+			authors.website
 		FROM authors
 		WHERE id = $1
 	`)
 
-	columns := []string{"id", "name"}
+	columns := []string{"id", "name", "handle", "bio", "avatar_url", "website"}
 	mock.ExpectQuery(query).WithArgs(author.ID).
 		WillReturnRows(
 			sqlmock.NewRows(columns).AddRow(
 				author.ID,
 				author.Name,
+				author.Handle,
+				author.Bio,
+				author.AvatarURL,
+				author.Website,
 			),
 		)
 
@@ -48,7 +58,11 @@ func Test_Author_Get_ReturnErr_WhenQueryFailed(t *testing.T) {
 	query := regexp.QuoteMeta(`
 		SELECT
 			authors.id,
-			authors.name
+			authors.name,
+			authors.handle,
+			authors.bio,
+			authors.avatar_url,
+			authors.website
 		FROM authors
 		WHERE id = $1
 	`)
@@ -71,17 +85,25 @@ func Test_Author_Get_ReturnErr_WhenScanFailed(t *testing.T) {
 	query := regexp.QuoteMeta(`
 		SELECT
 			authors.id,
-			authors.name
+			authors.name,
+			authors.handle,
+			authors.bio,
+			authors.avatar_url,
+			authors.website
 		FROM authors
 		WHERE id = $1
 	`)
 
-	columns := []string{"id", "name"}
+	columns := []string{"id", "name", "handle", "bio", "avatar_url", "website"}
 	mock.ExpectQuery(query).WithArgs(author.ID).
 		WillReturnRows(
 			sqlmock.NewRows(columns).AddRow(
 				"invalid-uuid",
 				author.Name,
+				author.Handle,
+				author.Bio,
+				author.AvatarURL,
+				author.Website,
 			),
 		)
 
@@ -99,12 +121,16 @@ func Test_Author_Get_ReturnErr_WhenRecordNotFound(t *testing.T) {
 	query := regexp.QuoteMeta(`
 		SELECT
 			authors.id,
-			authors.name
+			authors.name,
+			authors.handle,
+			authors.bio,
+			authors.avatar_url,
+			authors.website
 		FROM authors
 		WHERE id = $1
 	`)
 
-	columns := []string{"id", "name"}
+	columns := []string{"id", "name", "handle", "bio", "avatar_url", "website"}
 	mock.ExpectQuery(query).WithArgs(author.ID).
 		WillReturnRows(sqlmock.NewRows(columns))
 
@@ -114,3 +140,303 @@ func Test_Author_Get_ReturnErr_WhenRecordNotFound(t *testing.T) {
 	assert.Empty(t, result)
 	assert.Equal(t, apperror.ErrObjectNotExists, err)
 }
+
+func Test_Author_Create_Success(t *testing.T) {
+	mock := db_client.InitDatabaseMock()
+
+	author := factory.SampleAuthorChandra
+	query := regexp.QuoteMeta(`
+		INSERT INTO authors
+			(id, name, handle, bio, avatar_url, website)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`)
+
+	mock.ExpectExec(query).WithArgs(author.ID, author.Name, author.Handle, author.Bio, author.AvatarURL, author.Website).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	repo := GetAuthorRepository()
+	err := repo.Create(context.Background(), &author)
+
+	assert.Nil(t, err)
+}
+
+func Test_Author_Create_ReturnErr_WhenExecFailed(t *testing.T) {
+	mock := db_client.InitDatabaseMock()
+
+	author := factory.SampleAuthorChandra
+	query := regexp.QuoteMeta(`
+		INSERT INTO authors
+			(id, name, handle, bio, avatar_url, website)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`)
+
+	stubErr := errors.New("db error")
+	mock.ExpectExec(query).WithArgs(author.ID, author.Name, author.Handle, author.Bio, author.AvatarURL, author.Website).
+		WillReturnError(stubErr)
+
+	repo := GetAuthorRepository()
+	err := repo.Create(context.Background(), &author)
+
+	assert.Equal(t, apperror.ErrCreateRecordFailed, err)
+}
+
+func Test_Author_Create_ReturnErr_WhenHandleTaken(t *testing.T) {
+	mock := db_client.InitDatabaseMock()
+
+	author := factory.SampleAuthorChandra
+	query := regexp.QuoteMeta(`
+		INSERT INTO authors
+			(id, name, handle, bio, avatar_url, website)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`)
+
+	mock.ExpectExec(query).WithArgs(author.ID, author.Name, author.Handle, author.Bio, author.AvatarURL, author.Website).
+		WillReturnError(&pq.Error{Code: "23505"})
+
+	repo := GetAuthorRepository()
+	err := repo.Create(context.Background(), &author)
+
+	assert.Equal(t, apperror.ErrAuthorHandleTaken, err)
+}
+
+func Test_Author_GetByHandle_Success(t *testing.T) {
+	mock := db_client.InitDatabaseMock()
+
+	author := factory.SampleAuthorChandra
+	query := regexp.QuoteMeta(`
+		SELECT
+			authors.id,
+			authors.name,
+			authors.handle,
+			authors.bio,
+			authors.avatar_url,
+			authors.website
+		FROM authors
+		WHERE handle = $1
+	`)
+
+	columns := []string{"id", "name", "handle", "bio", "avatar_url", "website"}
+	mock.ExpectQuery(query).WithArgs(author.Handle).
+		WillReturnRows(sqlmock.NewRows(columns).AddRow(author.ID, author.Name, author.Handle, author.Bio, author.AvatarURL, author.Website))
+
+	repo := GetAuthorRepository()
+	result, err := repo.GetByHandle(context.Background(), author.Handle)
+
+	assert.Equal(t, &author, result)
+	assert.Nil(t, err)
+}
+
+func Test_Author_GetByHandle_ReturnErr_WhenRecordNotFound(t *testing.T) {
+	mock := db_client.InitDatabaseMock()
+
+	query := regexp.QuoteMeta(`
+		FROM authors
+		WHERE handle = $1
+	`)
+
+	columns := []string{"id", "name", "handle", "bio", "avatar_url", "website"}
+	mock.ExpectQuery(query).WithArgs("unknown").WillReturnRows(sqlmock.NewRows(columns))
+
+	repo := GetAuthorRepository()
+	result, err := repo.GetByHandle(context.Background(), "unknown")
+
+	assert.Nil(t, result)
+	assert.Equal(t, apperror.ErrObjectNotExists, err)
+}
+
+func Test_Author_List_Success_WithEmptyFilter(t *testing.T) {
+	mock := db_client.InitDatabaseMock()
+
+	author := factory.SampleAuthorChandra
+	query := regexp.QuoteMeta(`
+		SELECT
+			authors.id,
+			authors.name,
+			authors.handle,
+			authors.bio,
+			authors.avatar_url,
+			authors.website
+		FROM authors
+		ORDER BY authors.name ASC, authors.id ASC
+		LIMIT $1 OFFSET $2
+	`)
+
+	columns := []string{"id", "name", "handle", "bio", "avatar_url", "website"}
+	mock.ExpectQuery(query).WithArgs(20, 0).
+		WillReturnRows(sqlmock.NewRows(columns).AddRow(author.ID, author.Name, author.Handle, author.Bio, author.AvatarURL, author.Website))
+
+	repo := GetAuthorRepository()
+	authors, err := repo.List(context.Background(), AuthorFilter{})
+
+	assert.Equal(t, []*model.Author{&author}, authors)
+	assert.Nil(t, err)
+}
+
+func Test_Author_List_Success_WithFilledFilter(t *testing.T) {
+	mock := db_client.InitDatabaseMock()
+
+	author := factory.SampleAuthorChandra
+	query := regexp.QuoteMeta(`
+		SELECT
+			authors.id,
+			authors.name,
+			authors.handle,
+			authors.bio,
+			authors.avatar_url,
+			authors.website
+		FROM authors
+		WHERE LOWER(authors.name) LIKE LOWER($1)
+		ORDER BY authors.name ASC, authors.id ASC
+		LIMIT $2 OFFSET $3
+	`)
+
+	columns := []string{"id", "name", "handle", "bio", "avatar_url", "website"}
+	mock.ExpectQuery(query).WithArgs("%chan%", 10, 10).
+		WillReturnRows(sqlmock.NewRows(columns).AddRow(author.ID, author.Name, author.Handle, author.Bio, author.AvatarURL, author.Website))
+
+	repo := GetAuthorRepository()
+	filter := AuthorFilter{Name: "chan", Limit: 10, Offset: 10}
+	authors, err := repo.List(context.Background(), filter)
+
+	assert.Equal(t, []*model.Author{&author}, authors)
+	assert.Nil(t, err)
+}
+
+func Test_Author_List_ReturnErr_WhenQueryFailed(t *testing.T) {
+	mock := db_client.InitDatabaseMock()
+
+	query := regexp.QuoteMeta(`
+		SELECT
+			authors.id,
+			authors.name,
+			authors.handle,
+			authors.bio,
+			authors.avatar_url,
+			authors.website
+		FROM authors
+		ORDER BY authors.name ASC, authors.id ASC
+		LIMIT $1 OFFSET $2
+	`)
+
+	stubErr := errors.New("db error")
+	mock.ExpectQuery(query).WithArgs(20, 0).WillReturnError(stubErr)
+
+	repo := GetAuthorRepository()
+	authors, err := repo.List(context.Background(), AuthorFilter{})
+
+	assert.Empty(t, authors)
+	assert.Equal(t, apperror.ErrGetRecordFailed, err)
+}
+
+func Test_Author_GetRecordsCount_Success(t *testing.T) {
+	mock := db_client.InitDatabaseMock()
+
+	query := regexp.QuoteMeta(`
+		SELECT COUNT(*)
+		FROM authors
+		WHERE LOWER(authors.name) LIKE LOWER($1)
+	`)
+
+	columns := []string{"count"}
+	mock.ExpectQuery(query).WithArgs("%chan%").
+		WillReturnRows(sqlmock.NewRows(columns).AddRow(1))
+
+	repo := GetAuthorRepository()
+	recordsCount, err := repo.GetRecordsCount(context.Background(), AuthorFilter{Name: "chan"})
+
+	assert.Equal(t, int64(1), recordsCount)
+	assert.Nil(t, err)
+}
+
+func Test_Author_Update_Success(t *testing.T) {
+	mock := db_client.InitDatabaseMock()
+
+	author := factory.SampleAuthorChandra
+	query := regexp.QuoteMeta(`
+		UPDATE authors
+		SET name = $1, handle = $2, bio = $3, avatar_url = $4, website = $5, updated_at = NOW()
+		WHERE id = $6
+	`)
+
+	mock.ExpectExec(query).WithArgs(author.Name, author.Handle, author.Bio, author.AvatarURL, author.Website, author.ID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	repo := GetAuthorRepository()
+	err := repo.Update(context.Background(), &author)
+
+	assert.Nil(t, err)
+}
+
+func Test_Author_Update_ReturnErr_WhenRecordNotFound(t *testing.T) {
+	mock := db_client.InitDatabaseMock()
+
+	author := factory.SampleAuthorChandra
+	query := regexp.QuoteMeta(`
+		UPDATE authors
+		SET name = $1, handle = $2, bio = $3, avatar_url = $4, website = $5, updated_at = NOW()
+		WHERE id = $6
+	`)
+
+	mock.ExpectExec(query).WithArgs(author.Name, author.Handle, author.Bio, author.AvatarURL, author.Website, author.ID).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	repo := GetAuthorRepository()
+	err := repo.Update(context.Background(), &author)
+
+	assert.Equal(t, apperror.ErrObjectNotExists, err)
+}
+
+func Test_Author_Delete_Success(t *testing.T) {
+	mock := db_client.InitDatabaseMock()
+
+	author := factory.SampleAuthorChandra
+	query := regexp.QuoteMeta(`
+		DELETE FROM authors
+		WHERE id = $1
+	`)
+
+	mock.ExpectExec(query).WithArgs(author.ID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	repo := GetAuthorRepository()
+	err := repo.Delete(context.Background(), author.ID)
+
+	assert.Nil(t, err)
+}
+
+func Test_Author_Delete_ReturnErr_WhenAuthorHasArticles(t *testing.T) {
+	mock := db_client.InitDatabaseMock()
+
+	author := factory.SampleAuthorChandra
+	query := regexp.QuoteMeta(`
+		DELETE FROM authors
+		WHERE id = $1
+	`)
+
+	stubErr := &pq.Error{Code: "23503"}
+	mock.ExpectExec(query).WithArgs(author.ID).
+		WillReturnError(stubErr)
+
+	repo := GetAuthorRepository()
+	err := repo.Delete(context.Background(), author.ID)
+
+	assert.Equal(t, apperror.ErrAuthorHasArticles, err)
+}
+
+func Test_Author_Delete_ReturnErr_WhenRecordNotFound(t *testing.T) {
+	mock := db_client.InitDatabaseMock()
+
+	author := factory.SampleAuthorChandra
+	query := regexp.QuoteMeta(`
+		DELETE FROM authors
+		WHERE id = $1
+	`)
+
+	mock.ExpectExec(query).WithArgs(author.ID).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	repo := GetAuthorRepository()
+	err := repo.Delete(context.Background(), author.ID)
+
+	assert.Equal(t, apperror.ErrObjectNotExists, err)
+}
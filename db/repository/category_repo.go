@@ -0,0 +1,21 @@
+package repository
+
+import (
+	"context"
+
+	"article-service/model"
+
+	"github.com/google/uuid"
+)
+
+//go:generate mockgen -source=category_repo.go -destination=./mock_repository/category_repo_mock.go
+type ICategoryRepository interface {
+	Create(ctx context.Context, category *model.Category) error
+	Get(ctx context.Context, id uuid.UUID) (*model.Category, error)
+	List(ctx context.Context) ([]*model.Category, error)
+	Update(ctx context.Context, category *model.Category) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	LockTree(ctx context.Context) error
+	IsDescendant(ctx context.Context, id uuid.UUID, ancestorID uuid.UUID) (bool, error)
+	ListSubtreeIDs(ctx context.Context, id uuid.UUID) ([]uuid.UUID, error)
+}
@@ -0,0 +1,280 @@
+package repository
+
+import (
+	"context"
+
+	"article-service/apperror"
+	"article-service/db/db_client"
+	"article-service/db/transaction"
+	"article-service/infrastructure/log"
+	"article-service/model"
+
+	"github.com/google/uuid"
+)
+
+type CategoryRepo struct {
+}
+
+func GetCategoryRepository() ICategoryRepository {
+	return CategoryRepo{}
+}
+
+func (r CategoryRepo) Create(ctx context.Context, category *model.Category) error {
+	conn := transaction.GetClientOrTxn(ctx, db_client.GetDB)
+
+	query := `
+		INSERT INTO categories
+			(id, parent_id, name)
+		VALUES ($1, $2, $3)
+	`
+
+	res, err := conn.Exec(
+		ctx,
+		query,
+		&category.ID,
+		category.ParentID,
+		&category.Name,
+	)
+	if err != nil {
+		if db_client.IsUniqueViolation(err) {
+			log.Errorf(ctx, err, "[CategoryRepo][Create] Name is already taken")
+			return apperror.ErrCategoryNameTaken
+		}
+		if db_client.IsForeignKeyViolation(err) {
+			log.Errorf(ctx, err, "[CategoryRepo][Create] Parent does not exist")
+			return apperror.ErrCategoryParentNotFound
+		}
+		log.Errorf(ctx, err, "[CategoryRepo][Create] Exec failed")
+		return apperror.ErrCreateRecordFailed
+	}
+
+	affected, _ := res.RowsAffected()
+	if affected == 0 {
+		log.Errorf(ctx, err, "[CategoryRepo][Create] No affected rows")
+		return apperror.ErrNoAffectedRows
+	}
+
+	return nil
+}
+
+func (r CategoryRepo) Get(ctx context.Context, id uuid.UUID) (*model.Category, error) {
+	conn := transaction.GetClientOrTxn(ctx, db_client.GetDB)
+	query := `
+		SELECT
+			categories.id,
+			categories.parent_id,
+			categories.name
+		FROM categories
+		WHERE id = $1
+	`
+
+	rows, err := conn.Query(ctx, query, id)
+	if err != nil {
+		log.Errorf(ctx, err, "[CategoryRepo][Get] Query failed")
+		return nil, apperror.ErrGetRecordFailed
+	}
+
+	var category = model.Category{}
+	for rows.Next() {
+		err = rows.Scan(
+			&category.ID,
+			&category.ParentID,
+			&category.Name,
+		)
+		if err != nil {
+			log.Errorf(ctx, err, "[CategoryRepo][Get] Scan failed")
+			return nil, apperror.ErrScanRecordFailed
+		}
+	}
+
+	if category.ID == uuid.Nil {
+		return nil, apperror.ErrObjectNotExists
+	}
+
+	return &category, nil
+}
+
+func (r CategoryRepo) List(ctx context.Context) ([]*model.Category, error) {
+	conn := transaction.GetClientOrTxn(ctx, db_client.GetDB)
+	query := `
+		SELECT
+			categories.id,
+			categories.parent_id,
+			categories.name
+		FROM categories
+		ORDER BY categories.name ASC, categories.id ASC
+	`
+
+	rows, err := conn.Query(ctx, query)
+	if err != nil {
+		log.Errorf(ctx, err, "[CategoryRepo][List] Query failed")
+		return nil, apperror.ErrGetRecordFailed
+	}
+
+	var categories = []*model.Category{}
+	for rows.Next() {
+		var category model.Category
+		err = rows.Scan(
+			&category.ID,
+			&category.ParentID,
+			&category.Name,
+		)
+		if err != nil {
+			log.Errorf(ctx, err, "[CategoryRepo][List] Scan failed")
+			return nil, apperror.ErrScanRecordFailed
+		}
+
+		categories = append(categories, &category)
+	}
+
+	return categories, nil
+}
+
+func (r CategoryRepo) Update(ctx context.Context, category *model.Category) error {
+	conn := transaction.GetClientOrTxn(ctx, db_client.GetDB)
+
+	query := `
+		UPDATE categories
+		SET parent_id = $1, name = $2
+		WHERE id = $3
+	`
+
+	res, err := conn.Exec(
+		ctx,
+		query,
+		category.ParentID,
+		&category.Name,
+		&category.ID,
+	)
+	if err != nil {
+		if db_client.IsUniqueViolation(err) {
+			log.Errorf(ctx, err, "[CategoryRepo][Update] Name is already taken")
+			return apperror.ErrCategoryNameTaken
+		}
+		if db_client.IsForeignKeyViolation(err) {
+			log.Errorf(ctx, err, "[CategoryRepo][Update] Parent does not exist")
+			return apperror.ErrCategoryParentNotFound
+		}
+		log.Errorf(ctx, err, "[CategoryRepo][Update] Exec failed")
+		return apperror.ErrUpdateRecordFailed
+	}
+
+	affected, _ := res.RowsAffected()
+	if affected == 0 {
+		log.Errorf(ctx, err, "[CategoryRepo][Update] No affected rows")
+		return apperror.ErrObjectNotExists
+	}
+
+	return nil
+}
+
+func (r CategoryRepo) Delete(ctx context.Context, id uuid.UUID) error {
+	conn := transaction.GetClientOrTxn(ctx, db_client.GetDB)
+
+	query := `
+		DELETE FROM categories
+		WHERE id = $1
+	`
+
+	res, err := conn.Exec(ctx, query, id)
+	if err != nil {
+		if db_client.IsForeignKeyViolation(err) {
+			log.Errorf(ctx, err, "[CategoryRepo][Delete] Category is still referenced by subcategories or articles")
+			return apperror.ErrCategoryInUse
+		}
+		log.Errorf(ctx, err, "[CategoryRepo][Delete] Exec failed")
+		return apperror.ErrDeleteRecordFailed
+	}
+
+	affected, _ := res.RowsAffected()
+	if affected == 0 {
+		log.Errorf(ctx, err, "[CategoryRepo][Delete] No affected rows")
+		return apperror.ErrObjectNotExists
+	}
+
+	return nil
+}
+
+// LockTree serializes category moves until the transaction ends. Locking only the moved
+// category and its new parent is not enough, two moves of unrelated rows can still close a cycle.
+func (r CategoryRepo) LockTree(ctx context.Context) error {
+	conn := transaction.GetClientOrTxn(ctx, db_client.GetDB)
+
+	query := `SELECT pg_advisory_xact_lock(hashtext('categories_tree'))`
+
+	_, err := conn.Exec(ctx, query)
+	if err != nil {
+		log.Errorf(ctx, err, "[CategoryRepo][LockTree] Exec failed")
+		return apperror.ErrUpdateRecordFailed
+	}
+
+	return nil
+}
+
+func (r CategoryRepo) IsDescendant(ctx context.Context, id uuid.UUID, ancestorID uuid.UUID) (bool, error) {
+	conn := transaction.GetClientOrTxn(ctx, db_client.GetDB)
+	query := `
+		WITH RECURSIVE category_tree AS (
+			SELECT categories.id
+			FROM categories
+			WHERE categories.id = $1
+			UNION
+			SELECT categories.id
+			FROM categories
+			JOIN category_tree ON categories.parent_id = category_tree.id
+		)
+		SELECT EXISTS (SELECT 1 FROM category_tree WHERE category_tree.id = $2)
+	`
+
+	rows, err := conn.Query(ctx, query, ancestorID, id)
+	if err != nil {
+		log.Errorf(ctx, err, "[CategoryRepo][IsDescendant] Query failed")
+		return false, apperror.ErrGetRecordFailed
+	}
+
+	var isDescendant bool
+	for rows.Next() {
+		err = rows.Scan(&isDescendant)
+		if err != nil {
+			log.Errorf(ctx, err, "[CategoryRepo][IsDescendant] Scan failed")
+			return false, apperror.ErrScanRecordFailed
+		}
+	}
+
+	return isDescendant, nil
+}
+
+func (r CategoryRepo) ListSubtreeIDs(ctx context.Context, id uuid.UUID) ([]uuid.UUID, error) {
+	conn := transaction.GetClientOrTxn(ctx, db_client.GetDB)
+	query := `
+		WITH RECURSIVE category_tree AS (
+			SELECT categories.id
+			FROM categories
+			WHERE categories.id = $1
+			UNION
+			SELECT categories.id
+			FROM categories
+			JOIN category_tree ON categories.parent_id = category_tree.id
+		)
+		SELECT category_tree.id FROM category_tree
+	`
+
+	rows, err := conn.Query(ctx, query, id)
+	if err != nil {
+		log.Errorf(ctx, err, "[CategoryRepo][ListSubtreeIDs] Query failed")
+		return nil, apperror.ErrGetRecordFailed
+	}
+
+	var ids []uuid.UUID
+	for rows.Next() {
+		var categoryID uuid.UUID
+		err = rows.Scan(&categoryID)
+		if err != nil {
+			log.Errorf(ctx, err, "[CategoryRepo][ListSubtreeIDs] Scan failed")
+			return nil, apperror.ErrScanRecordFailed
+		}
+		ids = append(ids, categoryID)
+	}
+
+	return ids, nil
+}
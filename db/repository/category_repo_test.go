@@ -0,0 +1,308 @@
+package repository
+
+import (
+	"article-service/apperror"
+	"article-service/db/db_client"
+	"article-service/factory"
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Category_Create_Success(t *testing.T) {
+	mock := db_client.InitDatabaseMock()
+
+	category := factory.SampleCategoryLocal
+	query := regexp.QuoteMeta(`
+		INSERT INTO categories
+			(id, parent_id, name)
+		VALUES ($1, $2, $3)
+	`)
+
+	mock.ExpectExec(query).WithArgs(
+		category.ID,
+		*category.ParentID,
+		category.Name,
+	).WillReturnResult(sqlmock.NewResult(0, 1))
+
+	repo := GetCategoryRepository()
+	err := repo.Create(context.Background(), &category)
+
+	assert.Nil(t, err)
+}
+
+func Test_Category_Create_ReturnErr_WhenNameTaken(t *testing.T) {
+	mock := db_client.InitDatabaseMock()
+
+	category := factory.SampleCategoryNews
+	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO categories`)).
+		WillReturnError(&pq.Error{Code: "23505"})
+
+	repo := GetCategoryRepository()
+	err := repo.Create(context.Background(), &category)
+
+	assert.Equal(t, apperror.ErrCategoryNameTaken, err)
+}
+
+func Test_Category_Create_ReturnErr_WhenParentNotFound(t *testing.T) {
+	mock := db_client.InitDatabaseMock()
+
+	category := factory.SampleCategoryLocal
+	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO categories`)).
+		WillReturnError(&pq.Error{Code: "23503"})
+
+	repo := GetCategoryRepository()
+	err := repo.Create(context.Background(), &category)
+
+	assert.Equal(t, apperror.ErrCategoryParentNotFound, err)
+}
+
+func Test_Category_Get_Success(t *testing.T) {
+	mock := db_client.InitDatabaseMock()
+
+	category := factory.SampleCategoryLocal
+	query := regexp.QuoteMeta(`
+		SELECT
+			categories.id,
+			categories.parent_id,
+			categories.name
+		FROM categories
+		WHERE id = $1
+	`)
+
+	columns := []string{"id", "parent_id", "name"}
+	mock.ExpectQuery(query).WithArgs(category.ID).
+		WillReturnRows(sqlmock.NewRows(columns).AddRow(category.ID, *category.ParentID, category.Name))
+
+	repo := GetCategoryRepository()
+	result, err := repo.Get(context.Background(), category.ID)
+
+	assert.Nil(t, err)
+	assert.Equal(t, &category, result)
+}
+
+func Test_Category_Get_ReturnErr_WhenNotFound(t *testing.T) {
+	mock := db_client.InitDatabaseMock()
+
+	category := factory.SampleCategoryNews
+	columns := []string{"id", "parent_id", "name"}
+	mock.ExpectQuery(regexp.QuoteMeta(`FROM categories`)).WithArgs(category.ID).
+		WillReturnRows(sqlmock.NewRows(columns))
+
+	repo := GetCategoryRepository()
+	result, err := repo.Get(context.Background(), category.ID)
+
+	assert.Nil(t, result)
+	assert.Equal(t, apperror.ErrObjectNotExists, err)
+}
+
+func Test_Category_List_Success(t *testing.T) {
+	mock := db_client.InitDatabaseMock()
+
+	news := factory.SampleCategoryNews
+	local := factory.SampleCategoryLocal
+	query := regexp.QuoteMeta(`
+		FROM categories
+		ORDER BY categories.name ASC, categories.id ASC
+	`)
+
+	columns := []string{"id", "parent_id", "name"}
+	mock.ExpectQuery(query).WillReturnRows(
+		sqlmock.NewRows(columns).
+			AddRow(local.ID, *local.ParentID, local.Name).
+			AddRow(news.ID, nil, news.Name),
+	)
+
+	repo := GetCategoryRepository()
+	result, err := repo.List(context.Background())
+
+	assert.Nil(t, err)
+	assert.Equal(t, &local, result[0])
+	assert.Equal(t, &news, result[1])
+}
+
+func Test_Category_List_ReturnErr_WhenQueryFailed(t *testing.T) {
+	mock := db_client.InitDatabaseMock()
+
+	mock.ExpectQuery(regexp.QuoteMeta(`FROM categories`)).WillReturnError(errors.New("db error"))
+
+	repo := GetCategoryRepository()
+	result, err := repo.List(context.Background())
+
+	assert.Nil(t, result)
+	assert.Equal(t, apperror.ErrGetRecordFailed, err)
+}
+
+func Test_Category_Update_Success(t *testing.T) {
+	mock := db_client.InitDatabaseMock()
+
+	category := factory.SampleCategoryNews
+	query := regexp.QuoteMeta(`
+		UPDATE categories
+		SET parent_id = $1, name = $2
+		WHERE id = $3
+	`)
+
+	mock.ExpectExec(query).WithArgs(nil, category.Name, category.ID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	repo := GetCategoryRepository()
+	err := repo.Update(context.Background(), &category)
+
+	assert.Nil(t, err)
+}
+
+func Test_Category_Update_ReturnErr_WhenNotFound(t *testing.T) {
+	mock := db_client.InitDatabaseMock()
+
+	category := factory.SampleCategoryNews
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE categories`)).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	repo := GetCategoryRepository()
+	err := repo.Update(context.Background(), &category)
+
+	assert.Equal(t, apperror.ErrObjectNotExists, err)
+}
+
+func Test_Category_Delete_Success(t *testing.T) {
+	mock := db_client.InitDatabaseMock()
+
+	category := factory.SampleCategoryLocal
+	query := regexp.QuoteMeta(`
+		DELETE FROM categories
+		WHERE id = $1
+	`)
+
+	mock.ExpectExec(query).WithArgs(category.ID).WillReturnResult(sqlmock.NewResult(0, 1))
+
+	repo := GetCategoryRepository()
+	err := repo.Delete(context.Background(), category.ID)
+
+	assert.Nil(t, err)
+}
+
+func Test_Category_Delete_ReturnErr_WhenInUse(t *testing.T) {
+	mock := db_client.InitDatabaseMock()
+
+	category := factory.SampleCategoryNews
+	mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM categories`)).WithArgs(category.ID).
+		WillReturnError(&pq.Error{Code: "23503"})
+
+	repo := GetCategoryRepository()
+	err := repo.Delete(context.Background(), category.ID)
+
+	assert.Equal(t, apperror.ErrCategoryInUse, err)
+}
+
+func Test_Category_LockTree_Success(t *testing.T) {
+	mock := db_client.InitDatabaseMock()
+
+	mock.ExpectExec(regexp.QuoteMeta(`SELECT pg_advisory_xact_lock(hashtext('categories_tree'))`)).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	repo := GetCategoryRepository()
+	err := repo.LockTree(context.Background())
+
+	assert.Nil(t, err)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func Test_Category_LockTree_ReturnErr_WhenExecFailed(t *testing.T) {
+	mock := db_client.InitDatabaseMock()
+
+	mock.ExpectExec(regexp.QuoteMeta(`SELECT pg_advisory_xact_lock`)).WillReturnError(errors.New("error"))
+
+	repo := GetCategoryRepository()
+	err := repo.LockTree(context.Background())
+
+	assert.Equal(t, apperror.ErrUpdateRecordFailed, err)
+}
+
+func Test_Category_IsDescendant_Success(t *testing.T) {
+	mock := db_client.InitDatabaseMock()
+
+	news := factory.SampleCategoryNews
+	local := factory.SampleCategoryLocal
+	query := regexp.QuoteMeta(`
+		WITH RECURSIVE category_tree AS (
+			SELECT categories.id
+			FROM categories
+			WHERE categories.id = $1
+			UNION
+			SELECT categories.id
+			FROM categories
+			JOIN category_tree ON categories.parent_id = category_tree.id
+		)
+		SELECT EXISTS (SELECT 1 FROM category_tree WHERE category_tree.id = $2)
+	`)
+
+	mock.ExpectQuery(query).WithArgs(news.ID, local.ID).
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+
+	repo := GetCategoryRepository()
+	isDescendant, err := repo.IsDescendant(context.Background(), local.ID, news.ID)
+
+	assert.Nil(t, err)
+	assert.True(t, isDescendant)
+}
+
+func Test_Category_IsDescendant_ReturnErr_WhenQueryFailed(t *testing.T) {
+	mock := db_client.InitDatabaseMock()
+
+	news := factory.SampleCategoryNews
+	local := factory.SampleCategoryLocal
+	mock.ExpectQuery(regexp.QuoteMeta(`WITH RECURSIVE category_tree`)).WillReturnError(errors.New("db error"))
+
+	repo := GetCategoryRepository()
+	isDescendant, err := repo.IsDescendant(context.Background(), local.ID, news.ID)
+
+	assert.False(t, isDescendant)
+	assert.Equal(t, apperror.ErrGetRecordFailed, err)
+}
+
+func Test_Category_ListSubtreeIDs_Success(t *testing.T) {
+	mock := db_client.InitDatabaseMock()
+
+	news := factory.SampleCategoryNews
+	local := factory.SampleCategoryLocal
+	query := regexp.QuoteMeta(`
+		WITH RECURSIVE category_tree AS (
+			SELECT categories.id
+			FROM categories
+			WHERE categories.id = $1
+			UNION
+			SELECT categories.id
+			FROM categories
+			JOIN category_tree ON categories.parent_id = category_tree.id
+		)
+		SELECT category_tree.id FROM category_tree
+	`)
+
+	mock.ExpectQuery(query).WithArgs(news.ID).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(news.ID).AddRow(local.ID))
+
+	repo := GetCategoryRepository()
+	ids, err := repo.ListSubtreeIDs(context.Background(), news.ID)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []uuid.UUID{news.ID, local.ID}, ids)
+}
+
+func Test_Category_ListSubtreeIDs_ReturnErr_WhenQueryFailed(t *testing.T) {
+	mock := db_client.InitDatabaseMock()
+
+	mock.ExpectQuery(regexp.QuoteMeta(`WITH RECURSIVE category_tree`)).WillReturnError(errors.New("db error"))
+
+	repo := GetCategoryRepository()
+	ids, err := repo.ListSubtreeIDs(context.Background(), factory.SampleCategoryNews.ID)
+
+	assert.Nil(t, ids)
+	assert.Equal(t, apperror.ErrGetRecordFailed, err)
+}
@@ -9,8 +9,10 @@ import (
 	model "article-service/model"
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
 )
 
 // MockIArticleRepository is a mock of IArticleRepository interface.
@@ -36,6 +38,21 @@ func (m *MockIArticleRepository) EXPECT() *MockIArticleRepositoryMockRecorder {
 	return m.recorder
 }
 
+// CountFacets mocks base method.
+func (m *MockIArticleRepository) CountFacets(ctx context.Context, filter repository.ArticleFilter, facets []string) (model.ArticleFacets, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountFacets", ctx, filter, facets)
+	ret0, _ := ret[0].(model.ArticleFacets)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountFacets indicates an expected call of CountFacets.
+func (mr *MockIArticleRepositoryMockRecorder) CountFacets(ctx, filter, facets interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountFacets", reflect.TypeOf((*MockIArticleRepository)(nil).CountFacets), ctx, filter, facets)
+}
+
 // Create mocks base method.
 func (m *MockIArticleRepository) Create(ctx context.Context, article *model.Article) error {
 	m.ctrl.T.Helper()
@@ -50,6 +67,50 @@ func (mr *MockIArticleRepositoryMockRecorder) Create(ctx, article interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockIArticleRepository)(nil).Create), ctx, article)
 }
 
+// Delete mocks base method.
+func (m *MockIArticleRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockIArticleRepositoryMockRecorder) Delete(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockIArticleRepository)(nil).Delete), ctx, id)
+}
+
+// Get mocks base method.
+func (m *MockIArticleRepository) Get(ctx context.Context, id uuid.UUID) (*model.Article, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, id)
+	ret0, _ := ret[0].(*model.Article)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockIArticleRepositoryMockRecorder) Get(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockIArticleRepository)(nil).Get), ctx, id)
+}
+
+// GetForUpdate mocks base method.
+func (m *MockIArticleRepository) GetForUpdate(ctx context.Context, id uuid.UUID) (*model.Article, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetForUpdate", ctx, id)
+	ret0, _ := ret[0].(*model.Article)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetForUpdate indicates an expected call of GetForUpdate.
+func (mr *MockIArticleRepositoryMockRecorder) GetForUpdate(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetForUpdate", reflect.TypeOf((*MockIArticleRepository)(nil).GetForUpdate), ctx, id)
+}
+
 // GetRecordsCount mocks base method.
 func (m *MockIArticleRepository) GetRecordsCount(ctx context.Context, filter repository.ArticleFilter) (int64, error) {
 	m.ctrl.T.Helper()
@@ -79,3 +140,75 @@ func (mr *MockIArticleRepositoryMockRecorder) List(ctx, filter interface{}) *gom
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockIArticleRepository)(nil).List), ctx, filter)
 }
+
+// ListDueForPublishing mocks base method.
+func (m *MockIArticleRepository) ListDueForPublishing(ctx context.Context, now time.Time, limit int) ([]*model.Article, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDueForPublishing", ctx, now, limit)
+	ret0, _ := ret[0].([]*model.Article)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDueForPublishing indicates an expected call of ListDueForPublishing.
+func (mr *MockIArticleRepositoryMockRecorder) ListDueForPublishing(ctx, now, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDueForPublishing", reflect.TypeOf((*MockIArticleRepository)(nil).ListDueForPublishing), ctx, now, limit)
+}
+
+// ListForIndexing mocks base method.
+func (m *MockIArticleRepository) ListForIndexing(ctx context.Context, afterID uuid.UUID, changedSince time.Time, limit int) ([]*model.Article, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListForIndexing", ctx, afterID, changedSince, limit)
+	ret0, _ := ret[0].([]*model.Article)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListForIndexing indicates an expected call of ListForIndexing.
+func (mr *MockIArticleRepositoryMockRecorder) ListForIndexing(ctx, afterID, changedSince, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListForIndexing", reflect.TypeOf((*MockIArticleRepository)(nil).ListForIndexing), ctx, afterID, changedSince, limit)
+}
+
+// Restore mocks base method.
+func (m *MockIArticleRepository) Restore(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockIArticleRepositoryMockRecorder) Restore(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockIArticleRepository)(nil).Restore), ctx, id)
+}
+
+// Update mocks base method.
+func (m *MockIArticleRepository) Update(ctx context.Context, article *model.Article) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, article)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockIArticleRepositoryMockRecorder) Update(ctx, article interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockIArticleRepository)(nil).Update), ctx, article)
+}
+
+// UpdateStatus mocks base method.
+func (m *MockIArticleRepository) UpdateStatus(ctx context.Context, article *model.Article) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateStatus", ctx, article)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateStatus indicates an expected call of UpdateStatus.
+func (mr *MockIArticleRepositoryMockRecorder) UpdateStatus(ctx, article interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStatus", reflect.TypeOf((*MockIArticleRepository)(nil).UpdateStatus), ctx, article)
+}
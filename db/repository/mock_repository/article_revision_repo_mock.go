@@ -0,0 +1,81 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: article_revision_repo.go
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	model "article-service/model"
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockIArticleRevisionRepository is a mock of IArticleRevisionRepository interface.
+type MockIArticleRevisionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIArticleRevisionRepositoryMockRecorder
+}
+
+// MockIArticleRevisionRepositoryMockRecorder is the mock recorder for MockIArticleRevisionRepository.
+type MockIArticleRevisionRepositoryMockRecorder struct {
+	mock *MockIArticleRevisionRepository
+}
+
+// NewMockIArticleRevisionRepository creates a new mock instance.
+func NewMockIArticleRevisionRepository(ctrl *gomock.Controller) *MockIArticleRevisionRepository {
+	mock := &MockIArticleRevisionRepository{ctrl: ctrl}
+	mock.recorder = &MockIArticleRevisionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIArticleRevisionRepository) EXPECT() *MockIArticleRevisionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockIArticleRevisionRepository) Create(ctx context.Context, revision *model.ArticleRevision) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, revision)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockIArticleRevisionRepositoryMockRecorder) Create(ctx, revision interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockIArticleRevisionRepository)(nil).Create), ctx, revision)
+}
+
+// Get mocks base method.
+func (m *MockIArticleRevisionRepository) Get(ctx context.Context, articleID uuid.UUID, revision int) (*model.ArticleRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, articleID, revision)
+	ret0, _ := ret[0].(*model.ArticleRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockIArticleRevisionRepositoryMockRecorder) Get(ctx, articleID, revision interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockIArticleRevisionRepository)(nil).Get), ctx, articleID, revision)
+}
+
+// List mocks base method.
+func (m *MockIArticleRevisionRepository) List(ctx context.Context, articleID uuid.UUID) ([]*model.ArticleRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, articleID)
+	ret0, _ := ret[0].([]*model.ArticleRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockIArticleRevisionRepositoryMockRecorder) List(ctx, articleID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockIArticleRevisionRepository)(nil).List), ctx, articleID)
+}
@@ -0,0 +1,80 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: article_slug_repo.go
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockIArticleSlugRepository is a mock of IArticleSlugRepository interface.
+type MockIArticleSlugRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIArticleSlugRepositoryMockRecorder
+}
+
+// MockIArticleSlugRepositoryMockRecorder is the mock recorder for MockIArticleSlugRepository.
+type MockIArticleSlugRepositoryMockRecorder struct {
+	mock *MockIArticleSlugRepository
+}
+
+// NewMockIArticleSlugRepository creates a new mock instance.
+func NewMockIArticleSlugRepository(ctrl *gomock.Controller) *MockIArticleSlugRepository {
+	mock := &MockIArticleSlugRepository{ctrl: ctrl}
+	mock.recorder = &MockIArticleSlugRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIArticleSlugRepository) EXPECT() *MockIArticleSlugRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockIArticleSlugRepository) Create(ctx context.Context, articleID uuid.UUID, slug string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, articleID, slug)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockIArticleSlugRepositoryMockRecorder) Create(ctx, articleID, slug interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockIArticleSlugRepository)(nil).Create), ctx, articleID, slug)
+}
+
+// GetArticleID mocks base method.
+func (m *MockIArticleSlugRepository) GetArticleID(ctx context.Context, slug string) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetArticleID", ctx, slug)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetArticleID indicates an expected call of GetArticleID.
+func (mr *MockIArticleSlugRepositoryMockRecorder) GetArticleID(ctx, slug interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetArticleID", reflect.TypeOf((*MockIArticleSlugRepository)(nil).GetArticleID), ctx, slug)
+}
+
+// ListTaken mocks base method.
+func (m *MockIArticleSlugRepository) ListTaken(ctx context.Context, base string, articleID uuid.UUID) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTaken", ctx, base, articleID)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTaken indicates an expected call of ListTaken.
+func (mr *MockIArticleSlugRepositoryMockRecorder) ListTaken(ctx, base, articleID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTaken", reflect.TypeOf((*MockIArticleSlugRepository)(nil).ListTaken), ctx, base, articleID)
+}
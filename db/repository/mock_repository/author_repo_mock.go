@@ -5,6 +5,7 @@
 package mock_repository
 
 import (
+	repository "article-service/db/repository"
 	model "article-service/model"
 	context "context"
 	reflect "reflect"
@@ -36,6 +37,34 @@ func (m *MockIAuthorRepository) EXPECT() *MockIAuthorRepositoryMockRecorder {
 	return m.recorder
 }
 
+// Create mocks base method.
+func (m *MockIAuthorRepository) Create(ctx context.Context, author *model.Author) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, author)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockIAuthorRepositoryMockRecorder) Create(ctx, author interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockIAuthorRepository)(nil).Create), ctx, author)
+}
+
+// Delete mocks base method.
+func (m *MockIAuthorRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockIAuthorRepositoryMockRecorder) Delete(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockIAuthorRepository)(nil).Delete), ctx, id)
+}
+
 // Get mocks base method.
 func (m *MockIAuthorRepository) Get(ctx context.Context, id uuid.UUID) (*model.Author, error) {
 	m.ctrl.T.Helper()
@@ -50,3 +79,62 @@ func (mr *MockIAuthorRepositoryMockRecorder) Get(ctx, id interface{}) *gomock.Ca
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockIAuthorRepository)(nil).Get), ctx, id)
 }
+
+// GetByHandle mocks base method.
+func (m *MockIAuthorRepository) GetByHandle(ctx context.Context, handle string) (*model.Author, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByHandle", ctx, handle)
+	ret0, _ := ret[0].(*model.Author)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByHandle indicates an expected call of GetByHandle.
+func (mr *MockIAuthorRepositoryMockRecorder) GetByHandle(ctx, handle interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByHandle", reflect.TypeOf((*MockIAuthorRepository)(nil).GetByHandle), ctx, handle)
+}
+
+// GetRecordsCount mocks base method.
+func (m *MockIAuthorRepository) GetRecordsCount(ctx context.Context, filter repository.AuthorFilter) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRecordsCount", ctx, filter)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRecordsCount indicates an expected call of GetRecordsCount.
+func (mr *MockIAuthorRepositoryMockRecorder) GetRecordsCount(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecordsCount", reflect.TypeOf((*MockIAuthorRepository)(nil).GetRecordsCount), ctx, filter)
+}
+
+// List mocks base method.
+func (m *MockIAuthorRepository) List(ctx context.Context, filter repository.AuthorFilter) ([]*model.Author, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, filter)
+	ret0, _ := ret[0].([]*model.Author)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockIAuthorRepositoryMockRecorder) List(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockIAuthorRepository)(nil).List), ctx, filter)
+}
+
+// Update mocks base method.
+func (m *MockIAuthorRepository) Update(ctx context.Context, author *model.Author) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, author)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockIAuthorRepositoryMockRecorder) Update(ctx, author interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockIAuthorRepository)(nil).Update), ctx, author)
+}
@@ -0,0 +1,153 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: category_repo.go
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	model "article-service/model"
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockICategoryRepository is a mock of ICategoryRepository interface.
+type MockICategoryRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockICategoryRepositoryMockRecorder
+}
+
+// MockICategoryRepositoryMockRecorder is the mock recorder for MockICategoryRepository.
+type MockICategoryRepositoryMockRecorder struct {
+	mock *MockICategoryRepository
+}
+
+// NewMockICategoryRepository creates a new mock instance.
+func NewMockICategoryRepository(ctrl *gomock.Controller) *MockICategoryRepository {
+	mock := &MockICategoryRepository{ctrl: ctrl}
+	mock.recorder = &MockICategoryRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockICategoryRepository) EXPECT() *MockICategoryRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockICategoryRepository) Create(ctx context.Context, category *model.Category) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, category)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockICategoryRepositoryMockRecorder) Create(ctx, category interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockICategoryRepository)(nil).Create), ctx, category)
+}
+
+// Delete mocks base method.
+func (m *MockICategoryRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockICategoryRepositoryMockRecorder) Delete(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockICategoryRepository)(nil).Delete), ctx, id)
+}
+
+// Get mocks base method.
+func (m *MockICategoryRepository) Get(ctx context.Context, id uuid.UUID) (*model.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, id)
+	ret0, _ := ret[0].(*model.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockICategoryRepositoryMockRecorder) Get(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockICategoryRepository)(nil).Get), ctx, id)
+}
+
+// IsDescendant mocks base method.
+func (m *MockICategoryRepository) IsDescendant(ctx context.Context, id, ancestorID uuid.UUID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsDescendant", ctx, id, ancestorID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsDescendant indicates an expected call of IsDescendant.
+func (mr *MockICategoryRepositoryMockRecorder) IsDescendant(ctx, id, ancestorID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsDescendant", reflect.TypeOf((*MockICategoryRepository)(nil).IsDescendant), ctx, id, ancestorID)
+}
+
+// List mocks base method.
+func (m *MockICategoryRepository) List(ctx context.Context) ([]*model.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx)
+	ret0, _ := ret[0].([]*model.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockICategoryRepositoryMockRecorder) List(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockICategoryRepository)(nil).List), ctx)
+}
+
+// ListSubtreeIDs mocks base method.
+func (m *MockICategoryRepository) ListSubtreeIDs(ctx context.Context, id uuid.UUID) ([]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSubtreeIDs", ctx, id)
+	ret0, _ := ret[0].([]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSubtreeIDs indicates an expected call of ListSubtreeIDs.
+func (mr *MockICategoryRepositoryMockRecorder) ListSubtreeIDs(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSubtreeIDs", reflect.TypeOf((*MockICategoryRepository)(nil).ListSubtreeIDs), ctx, id)
+}
+
+// LockTree mocks base method.
+func (m *MockICategoryRepository) LockTree(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockTree", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LockTree indicates an expected call of LockTree.
+func (mr *MockICategoryRepositoryMockRecorder) LockTree(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockTree", reflect.TypeOf((*MockICategoryRepository)(nil).LockTree), ctx)
+}
+
+// Update mocks base method.
+func (m *MockICategoryRepository) Update(ctx context.Context, category *model.Category) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, category)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockICategoryRepositoryMockRecorder) Update(ctx, category interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockICategoryRepository)(nil).Update), ctx, category)
+}
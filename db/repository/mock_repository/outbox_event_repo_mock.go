@@ -0,0 +1,95 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: outbox_event_repo.go
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	model "article-service/model"
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockIOutboxEventRepository is a mock of IOutboxEventRepository interface.
+type MockIOutboxEventRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIOutboxEventRepositoryMockRecorder
+}
+
+// MockIOutboxEventRepositoryMockRecorder is the mock recorder for MockIOutboxEventRepository.
+type MockIOutboxEventRepositoryMockRecorder struct {
+	mock *MockIOutboxEventRepository
+}
+
+// NewMockIOutboxEventRepository creates a new mock instance.
+func NewMockIOutboxEventRepository(ctrl *gomock.Controller) *MockIOutboxEventRepository {
+	mock := &MockIOutboxEventRepository{ctrl: ctrl}
+	mock.recorder = &MockIOutboxEventRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIOutboxEventRepository) EXPECT() *MockIOutboxEventRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockIOutboxEventRepository) Create(ctx context.Context, event *model.OutboxEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockIOutboxEventRepositoryMockRecorder) Create(ctx, event interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockIOutboxEventRepository)(nil).Create), ctx, event)
+}
+
+// DeleteFinishedBefore mocks base method.
+func (m *MockIOutboxEventRepository) DeleteFinishedBefore(ctx context.Context, before time.Time, limit int) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFinishedBefore", ctx, before, limit)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteFinishedBefore indicates an expected call of DeleteFinishedBefore.
+func (mr *MockIOutboxEventRepositoryMockRecorder) DeleteFinishedBefore(ctx, before, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFinishedBefore", reflect.TypeOf((*MockIOutboxEventRepository)(nil).DeleteFinishedBefore), ctx, before, limit)
+}
+
+// ListDeliverable mocks base method.
+func (m *MockIOutboxEventRepository) ListDeliverable(ctx context.Context, now time.Time, limit int) ([]*model.OutboxEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeliverable", ctx, now, limit)
+	ret0, _ := ret[0].([]*model.OutboxEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDeliverable indicates an expected call of ListDeliverable.
+func (mr *MockIOutboxEventRepositoryMockRecorder) ListDeliverable(ctx, now, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeliverable", reflect.TypeOf((*MockIOutboxEventRepository)(nil).ListDeliverable), ctx, now, limit)
+}
+
+// UpdateDelivery mocks base method.
+func (m *MockIOutboxEventRepository) UpdateDelivery(ctx context.Context, event *model.OutboxEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDelivery", ctx, event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateDelivery indicates an expected call of UpdateDelivery.
+func (mr *MockIOutboxEventRepositoryMockRecorder) UpdateDelivery(ctx, event interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDelivery", reflect.TypeOf((*MockIOutboxEventRepository)(nil).UpdateDelivery), ctx, event)
+}
@@ -0,0 +1,93 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: reindex_job_repo.go
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	model "article-service/model"
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockIReindexJobRepository is a mock of IReindexJobRepository interface.
+type MockIReindexJobRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIReindexJobRepositoryMockRecorder
+}
+
+// MockIReindexJobRepositoryMockRecorder is the mock recorder for MockIReindexJobRepository.
+type MockIReindexJobRepositoryMockRecorder struct {
+	mock *MockIReindexJobRepository
+}
+
+// NewMockIReindexJobRepository creates a new mock instance.
+func NewMockIReindexJobRepository(ctrl *gomock.Controller) *MockIReindexJobRepository {
+	mock := &MockIReindexJobRepository{ctrl: ctrl}
+	mock.recorder = &MockIReindexJobRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIReindexJobRepository) EXPECT() *MockIReindexJobRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockIReindexJobRepository) Create(ctx context.Context, job *model.ReindexJob) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, job)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockIReindexJobRepositoryMockRecorder) Create(ctx, job interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockIReindexJobRepository)(nil).Create), ctx, job)
+}
+
+// Finish mocks base method.
+func (m *MockIReindexJobRepository) Finish(ctx context.Context, job *model.ReindexJob) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Finish", ctx, job)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Finish indicates an expected call of Finish.
+func (mr *MockIReindexJobRepositoryMockRecorder) Finish(ctx, job interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Finish", reflect.TypeOf((*MockIReindexJobRepository)(nil).Finish), ctx, job)
+}
+
+// GetRunning mocks base method.
+func (m *MockIReindexJobRepository) GetRunning(ctx context.Context) (*model.ReindexJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRunning", ctx)
+	ret0, _ := ret[0].(*model.ReindexJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRunning indicates an expected call of GetRunning.
+func (mr *MockIReindexJobRepositoryMockRecorder) GetRunning(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRunning", reflect.TypeOf((*MockIReindexJobRepository)(nil).GetRunning), ctx)
+}
+
+// UpdateProgress mocks base method.
+func (m *MockIReindexJobRepository) UpdateProgress(ctx context.Context, job *model.ReindexJob) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateProgress", ctx, job)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateProgress indicates an expected call of UpdateProgress.
+func (mr *MockIReindexJobRepositoryMockRecorder) UpdateProgress(ctx, job interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProgress", reflect.TypeOf((*MockIReindexJobRepository)(nil).UpdateProgress), ctx, job)
+}
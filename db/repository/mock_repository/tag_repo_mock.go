@@ -0,0 +1,66 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: tag_repo.go
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	model "article-service/model"
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockITagRepository is a mock of ITagRepository interface.
+type MockITagRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockITagRepositoryMockRecorder
+}
+
+// MockITagRepositoryMockRecorder is the mock recorder for MockITagRepository.
+type MockITagRepositoryMockRecorder struct {
+	mock *MockITagRepository
+}
+
+// NewMockITagRepository creates a new mock instance.
+func NewMockITagRepository(ctrl *gomock.Controller) *MockITagRepository {
+	mock := &MockITagRepository{ctrl: ctrl}
+	mock.recorder = &MockITagRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockITagRepository) EXPECT() *MockITagRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ListWithArticlesCount mocks base method.
+func (m *MockITagRepository) ListWithArticlesCount(ctx context.Context) ([]*model.Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWithArticlesCount", ctx)
+	ret0, _ := ret[0].([]*model.Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWithArticlesCount indicates an expected call of ListWithArticlesCount.
+func (mr *MockITagRepositoryMockRecorder) ListWithArticlesCount(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWithArticlesCount", reflect.TypeOf((*MockITagRepository)(nil).ListWithArticlesCount), ctx)
+}
+
+// SetArticleTags mocks base method.
+func (m *MockITagRepository) SetArticleTags(ctx context.Context, articleID uuid.UUID, tags []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetArticleTags", ctx, articleID, tags)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetArticleTags indicates an expected call of SetArticleTags.
+func (mr *MockITagRepositoryMockRecorder) SetArticleTags(ctx, articleID, tags interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetArticleTags", reflect.TypeOf((*MockITagRepository)(nil).SetArticleTags), ctx, articleID, tags)
+}
@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"article-service/model"
+)
+
+//go:generate mockgen -source=outbox_event_repo.go -destination=./mock_repository/outbox_event_repo_mock.go
+type IOutboxEventRepository interface {
+	Create(ctx context.Context, event *model.OutboxEvent) error
+	ListDeliverable(ctx context.Context, now time.Time, limit int) ([]*model.OutboxEvent, error)
+	UpdateDelivery(ctx context.Context, event *model.OutboxEvent) error
+	DeleteFinishedBefore(ctx context.Context, before time.Time, limit int) (int64, error)
+}
@@ -0,0 +1,171 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"article-service/apperror"
+	"article-service/db/db_client"
+	"article-service/db/transaction"
+	"article-service/infrastructure/log"
+	"article-service/model"
+)
+
+type OutboxEventRepo struct {
+}
+
+func GetOutboxEventRepository() IOutboxEventRepository {
+	return OutboxEventRepo{}
+}
+
+func (r OutboxEventRepo) Create(ctx context.Context, event *model.OutboxEvent) error {
+	conn := transaction.GetClientOrTxn(ctx, db_client.GetDB)
+
+	query := `
+		INSERT INTO outbox_events
+			(id, aggregate_id, event_type, status, available_at, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`
+
+	res, err := conn.Exec(
+		ctx,
+		query,
+		&event.ID,
+		&event.AggregateID,
+		&event.EventType,
+		&event.Status,
+		&event.AvailableAt,
+		&event.CreatedAt,
+	)
+	if err != nil {
+		log.Errorf(ctx, err, "[OutboxEventRepo][Create] Exec failed")
+		return apperror.ErrCreateRecordFailed
+	}
+
+	affected, _ := res.RowsAffected()
+	if affected == 0 {
+		log.Errorf(ctx, err, "[OutboxEventRepo][Create] No affected rows")
+		return apperror.ErrNoAffectedRows
+	}
+
+	return nil
+}
+
+// ListDeliverable only returns the oldest pending event of an aggregate, so events of one aggregate are
+// delivered in order even by several relays
+func (r OutboxEventRepo) ListDeliverable(ctx context.Context, now time.Time, limit int) ([]*model.OutboxEvent, error) {
+	conn := transaction.GetClientOrTxn(ctx, db_client.GetDB)
+	query := `
+		SELECT
+			outbox_events.id,
+			outbox_events.aggregate_id,
+			outbox_events.event_type,
+			outbox_events.status,
+			outbox_events.attempts,
+			outbox_events.last_error,
+			outbox_events.available_at,
+			outbox_events.created_at,
+			outbox_events.delivered_at
+		FROM outbox_events
+		WHERE outbox_events.status = $1
+			AND outbox_events.available_at <= $2
+			AND NOT EXISTS (
+				SELECT 1
+				FROM outbox_events AS earlier_events
+				WHERE earlier_events.aggregate_id = outbox_events.aggregate_id
+					AND earlier_events.status = $1
+					AND earlier_events.id < outbox_events.id
+			)
+		ORDER BY outbox_events.available_at ASC, outbox_events.id ASC
+		LIMIT $3
+		FOR UPDATE SKIP LOCKED
+	`
+
+	rows, err := conn.Query(ctx, query, model.OutboxEventStatusPending, now, limit)
+	if err != nil {
+		log.Errorf(ctx, err, "[OutboxEventRepo][ListDeliverable] Query failed")
+		return nil, apperror.ErrGetRecordFailed
+	}
+
+	var events = []*model.OutboxEvent{}
+	for rows.Next() {
+		var event model.OutboxEvent
+		err = rows.Scan(
+			&event.ID,
+			&event.AggregateID,
+			&event.EventType,
+			&event.Status,
+			&event.Attempts,
+			&event.LastError,
+			&event.AvailableAt,
+			&event.CreatedAt,
+			&event.DeliveredAt,
+		)
+		if err != nil {
+			log.Errorf(ctx, err, "[OutboxEventRepo][ListDeliverable] Scan failed")
+			return nil, apperror.ErrScanRecordFailed
+		}
+
+		events = append(events, &event)
+	}
+
+	return events, nil
+}
+
+func (r OutboxEventRepo) UpdateDelivery(ctx context.Context, event *model.OutboxEvent) error {
+	conn := transaction.GetClientOrTxn(ctx, db_client.GetDB)
+
+	query := `
+		UPDATE outbox_events
+		SET status = $1, attempts = $2, last_error = $3, available_at = $4, delivered_at = $5
+		WHERE id = $6
+	`
+
+	res, err := conn.Exec(
+		ctx,
+		query,
+		&event.Status,
+		&event.Attempts,
+		&event.LastError,
+		&event.AvailableAt,
+		&event.DeliveredAt,
+		&event.ID,
+	)
+	if err != nil {
+		log.Errorf(ctx, err, "[OutboxEventRepo][UpdateDelivery] Exec failed")
+		return apperror.ErrUpdateRecordFailed
+	}
+
+	affected, _ := res.RowsAffected()
+	if affected == 0 {
+		log.Errorf(ctx, err, "[OutboxEventRepo][UpdateDelivery] No affected rows")
+		return apperror.ErrNoAffectedRows
+	}
+
+	return nil
+}
+
+// DeleteFinishedBefore removes delivered and dead events created before the given time, at most limit of them
+func (r OutboxEventRepo) DeleteFinishedBefore(ctx context.Context, before time.Time, limit int) (int64, error) {
+	conn := transaction.GetClientOrTxn(ctx, db_client.GetDB)
+
+	query := `
+		DELETE FROM outbox_events
+		WHERE id IN (
+			SELECT outbox_events.id
+			FROM outbox_events
+			WHERE outbox_events.status IN ($1, $2)
+				AND outbox_events.created_at < $3
+			LIMIT $4
+		)
+	`
+
+	res, err := conn.Exec(ctx, query, model.OutboxEventStatusDelivered, model.OutboxEventStatusDead, before, limit)
+	if err != nil {
+		log.Errorf(ctx, err, "[OutboxEventRepo][DeleteFinishedBefore] Exec failed")
+		return 0, apperror.ErrDeleteRecordFailed
+	}
+
+	affected, _ := res.RowsAffected()
+	return affected, nil
+}
@@ -0,0 +1,186 @@
+package repository
+
+import (
+	"article-service/apperror"
+	"article-service/db/db_client"
+	"article-service/factory"
+	"article-service/model"
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_OutboxEvent_Create_Success(t *testing.T) {
+	mock := db_client.InitDatabaseMock()
+
+	event := factory.SampleOutboxEvent
+	mock.ExpectExec(regexp.QuoteMeta(`
+		INSERT INTO outbox_events
+			(id, aggregate_id, event_type, status, available_at, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`)).WithArgs(event.ID, event.AggregateID, event.EventType, event.Status, event.AvailableAt, event.CreatedAt).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	repo := GetOutboxEventRepository()
+	err := repo.Create(context.Background(), &event)
+
+	assert.Nil(t, err)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func Test_OutboxEvent_Create_ReturnErr_WhenExecFailed(t *testing.T) {
+	mock := db_client.InitDatabaseMock()
+
+	event := factory.SampleOutboxEvent
+	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO outbox_events`)).WillReturnError(errors.New("db error"))
+
+	repo := GetOutboxEventRepository()
+	err := repo.Create(context.Background(), &event)
+
+	assert.Equal(t, apperror.ErrCreateRecordFailed, err)
+}
+
+func Test_OutboxEvent_ListDeliverable_Success(t *testing.T) {
+	mock := db_client.InitDatabaseMock()
+
+	event := factory.SampleOutboxEvent
+	event.Attempts = 1
+	event.LastError = "index to elastic failed"
+
+	query := regexp.QuoteMeta(`
+		FROM outbox_events
+		WHERE outbox_events.status = $1
+			AND outbox_events.available_at <= $2
+			AND NOT EXISTS (
+				SELECT 1
+				FROM outbox_events AS earlier_events
+				WHERE earlier_events.aggregate_id = outbox_events.aggregate_id
+					AND earlier_events.status = $1
+					AND earlier_events.id < outbox_events.id
+			)
+		ORDER BY outbox_events.available_at ASC, outbox_events.id ASC
+		LIMIT $3
+		FOR UPDATE SKIP LOCKED
+	`)
+
+	columns := []string{
+		"id",
+		"aggregate_id",
+		"event_type",
+		"status",
+		"attempts",
+		"last_error",
+		"available_at",
+		"created_at",
+		"delivered_at",
+	}
+
+	now := time.Now()
+	mock.ExpectQuery(query).WithArgs(model.OutboxEventStatusPending, now, 50).
+		WillReturnRows(sqlmock.NewRows(columns).AddRow(
+			event.ID,
+			event.AggregateID,
+			event.EventType,
+			event.Status,
+			event.Attempts,
+			event.LastError,
+			event.AvailableAt,
+			event.CreatedAt,
+			nil,
+		))
+
+	repo := GetOutboxEventRepository()
+	events, err := repo.ListDeliverable(context.Background(), now, 50)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []*model.OutboxEvent{&event}, events)
+}
+
+func Test_OutboxEvent_ListDeliverable_ReturnErr_WhenQueryFailed(t *testing.T) {
+	mock := db_client.InitDatabaseMock()
+
+	mock.ExpectQuery(regexp.QuoteMeta(`FROM outbox_events`)).WillReturnError(errors.New("db error"))
+
+	repo := GetOutboxEventRepository()
+	events, err := repo.ListDeliverable(context.Background(), time.Now(), 50)
+
+	assert.Nil(t, events)
+	assert.Equal(t, apperror.ErrGetRecordFailed, err)
+}
+
+func Test_OutboxEvent_UpdateDelivery_Success(t *testing.T) {
+	mock := db_client.InitDatabaseMock()
+
+	event := factory.SampleOutboxEvent
+	deliveredAt := event.CreatedAt.Add(time.Second)
+	event.Status = model.OutboxEventStatusDelivered
+	event.Attempts = 1
+	event.DeliveredAt = &deliveredAt
+
+	mock.ExpectExec(regexp.QuoteMeta(`
+		UPDATE outbox_events
+		SET status = $1, attempts = $2, last_error = $3, available_at = $4, delivered_at = $5
+		WHERE id = $6
+	`)).WithArgs(event.Status, event.Attempts, event.LastError, event.AvailableAt, event.DeliveredAt, event.ID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	repo := GetOutboxEventRepository()
+	err := repo.UpdateDelivery(context.Background(), &event)
+
+	assert.Nil(t, err)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func Test_OutboxEvent_UpdateDelivery_ReturnErr_WhenNoRowsAffected(t *testing.T) {
+	mock := db_client.InitDatabaseMock()
+
+	event := factory.SampleOutboxEvent
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE outbox_events`)).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	repo := GetOutboxEventRepository()
+	err := repo.UpdateDelivery(context.Background(), &event)
+
+	assert.Equal(t, apperror.ErrNoAffectedRows, err)
+}
+
+func Test_OutboxEvent_DeleteFinishedBefore_Success(t *testing.T) {
+	mock := db_client.InitDatabaseMock()
+
+	before := factory.SampleOutboxEvent.CreatedAt
+	mock.ExpectExec(regexp.QuoteMeta(`
+		DELETE FROM outbox_events
+		WHERE id IN (
+			SELECT outbox_events.id
+			FROM outbox_events
+			WHERE outbox_events.status IN ($1, $2)
+				AND outbox_events.created_at < $3
+			LIMIT $4
+		)
+	`)).WithArgs(model.OutboxEventStatusDelivered, model.OutboxEventStatusDead, before, 100).
+		WillReturnResult(sqlmock.NewResult(0, 3))
+
+	repo := GetOutboxEventRepository()
+	deleted, err := repo.DeleteFinishedBefore(context.Background(), before, 100)
+
+	assert.Nil(t, err)
+	assert.Equal(t, int64(3), deleted)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func Test_OutboxEvent_DeleteFinishedBefore_ReturnErr_WhenExecFailed(t *testing.T) {
+	mock := db_client.InitDatabaseMock()
+
+	mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM outbox_events`)).WillReturnError(errors.New("error"))
+
+	repo := GetOutboxEventRepository()
+	deleted, err := repo.DeleteFinishedBefore(context.Background(), time.Now(), 100)
+
+	assert.Equal(t, int64(0), deleted)
+	assert.Equal(t, apperror.ErrDeleteRecordFailed, err)
+}
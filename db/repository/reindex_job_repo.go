@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+
+	"article-service/model"
+)
+
+//go:generate mockgen -source=reindex_job_repo.go -destination=./mock_repository/reindex_job_repo_mock.go
+type IReindexJobRepository interface {
+	Create(ctx context.Context, job *model.ReindexJob) error
+	GetRunning(ctx context.Context) (*model.ReindexJob, error)
+	UpdateProgress(ctx context.Context, job *model.ReindexJob) error
+	Finish(ctx context.Context, job *model.ReindexJob) error
+}
@@ -0,0 +1,160 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"article-service/apperror"
+	"article-service/db/db_client"
+	"article-service/db/transaction"
+	"article-service/infrastructure/log"
+	"article-service/model"
+
+	"github.com/google/uuid"
+)
+
+type ReindexJobRepo struct {
+}
+
+func GetReindexJobRepository() IReindexJobRepository {
+	return ReindexJobRepo{}
+}
+
+func (r ReindexJobRepo) Create(ctx context.Context, job *model.ReindexJob) error {
+	conn := transaction.GetClientOrTxn(ctx, db_client.GetDB)
+
+	query := `
+		INSERT INTO reindex_jobs
+			(id, index_name, status, total_count, started_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`
+
+	res, err := conn.Exec(
+		ctx,
+		query,
+		&job.ID,
+		&job.IndexName,
+		&job.Status,
+		&job.TotalCount,
+		&job.StartedAt,
+		&job.StartedAt,
+	)
+	if err != nil {
+		log.Errorf(ctx, err, "[ReindexJobRepo][Create] Exec failed")
+		return apperror.ErrCreateRecordFailed
+	}
+
+	affected, _ := res.RowsAffected()
+	if affected == 0 {
+		log.Errorf(ctx, err, "[ReindexJobRepo][Create] No affected rows")
+		return apperror.ErrNoAffectedRows
+	}
+
+	job.UpdatedAt = job.StartedAt
+	return nil
+}
+
+func (r ReindexJobRepo) GetRunning(ctx context.Context) (*model.ReindexJob, error) {
+	conn := transaction.GetClientOrTxn(ctx, db_client.GetDB)
+	query := `
+		SELECT
+			reindex_jobs.id,
+			reindex_jobs.index_name,
+			reindex_jobs.status,
+			reindex_jobs.last_article_id,
+			reindex_jobs.indexed_count,
+			reindex_jobs.total_count,
+			reindex_jobs.started_at,
+			reindex_jobs.updated_at,
+			reindex_jobs.finished_at
+		FROM reindex_jobs
+		WHERE reindex_jobs.status = $1
+	`
+
+	rows, err := conn.Query(ctx, query, model.ReindexJobStatusRunning)
+	if err != nil {
+		log.Errorf(ctx, err, "[ReindexJobRepo][GetRunning] Query failed")
+		return nil, apperror.ErrGetRecordFailed
+	}
+
+	var job = model.ReindexJob{}
+	for rows.Next() {
+		var lastArticleID uuid.NullUUID
+		err = rows.Scan(
+			&job.ID,
+			&job.IndexName,
+			&job.Status,
+			&lastArticleID,
+			&job.IndexedCount,
+			&job.TotalCount,
+			&job.StartedAt,
+			&job.UpdatedAt,
+			&job.FinishedAt,
+		)
+		if err != nil {
+			log.Errorf(ctx, err, "[ReindexJobRepo][GetRunning] Scan failed")
+			return nil, apperror.ErrScanRecordFailed
+		}
+		job.LastArticleID = lastArticleID.UUID
+	}
+
+	if job.ID == uuid.Nil {
+		return nil, apperror.ErrObjectNotExists
+	}
+
+	return &job, nil
+}
+
+func (r ReindexJobRepo) UpdateProgress(ctx context.Context, job *model.ReindexJob) error {
+	conn := transaction.GetClientOrTxn(ctx, db_client.GetDB)
+
+	query := `
+		UPDATE reindex_jobs
+		SET last_article_id = $1, indexed_count = $2, updated_at = $3
+		WHERE id = $4
+	`
+
+	now := time.Now()
+	res, err := conn.Exec(ctx, query, &job.LastArticleID, &job.IndexedCount, now, &job.ID)
+	if err != nil {
+		log.Errorf(ctx, err, "[ReindexJobRepo][UpdateProgress] Exec failed")
+		return apperror.ErrUpdateRecordFailed
+	}
+
+	affected, _ := res.RowsAffected()
+	if affected == 0 {
+		log.Errorf(ctx, err, "[ReindexJobRepo][UpdateProgress] No affected rows")
+		return apperror.ErrNoAffectedRows
+	}
+
+	job.UpdatedAt = now
+	return nil
+}
+
+func (r ReindexJobRepo) Finish(ctx context.Context, job *model.ReindexJob) error {
+	conn := transaction.GetClientOrTxn(ctx, db_client.GetDB)
+
+	query := `
+		UPDATE reindex_jobs
+		SET status = $1, finished_at = $2, updated_at = $2
+		WHERE id = $3
+	`
+
+	now := time.Now()
+	res, err := conn.Exec(ctx, query, model.ReindexJobStatusFinished, now, &job.ID)
+	if err != nil {
+		log.Errorf(ctx, err, "[ReindexJobRepo][Finish] Exec failed")
+		return apperror.ErrUpdateRecordFailed
+	}
+
+	affected, _ := res.RowsAffected()
+	if affected == 0 {
+		log.Errorf(ctx, err, "[ReindexJobRepo][Finish] No affected rows")
+		return apperror.ErrNoAffectedRows
+	}
+
+	job.Status = model.ReindexJobStatusFinished
+	job.FinishedAt = &now
+	job.UpdatedAt = now
+	return nil
+}
@@ -0,0 +1,218 @@
+package repository
+
+import (
+	"article-service/apperror"
+	"article-service/db/db_client"
+	"article-service/factory"
+	"article-service/model"
+	"article-service/utils"
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ReindexJob_Create_Success(t *testing.T) {
+	mock := db_client.InitDatabaseMock()
+
+	job := factory.SampleReindexJob
+	mock.ExpectExec(regexp.QuoteMeta(`
+		INSERT INTO reindex_jobs
+			(id, index_name, status, total_count, started_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`)).WithArgs(job.ID, job.IndexName, job.Status, job.TotalCount, job.StartedAt, job.StartedAt).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	repo := GetReindexJobRepository()
+	err := repo.Create(context.Background(), &job)
+
+	assert.Nil(t, err)
+	assert.Equal(t, job.StartedAt, job.UpdatedAt)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func Test_ReindexJob_Create_ReturnErr_WhenExecFailed(t *testing.T) {
+	mock := db_client.InitDatabaseMock()
+
+	job := factory.SampleReindexJob
+	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO reindex_jobs`)).WillReturnError(errors.New("db error"))
+
+	repo := GetReindexJobRepository()
+	err := repo.Create(context.Background(), &job)
+
+	assert.Equal(t, apperror.ErrCreateRecordFailed, err)
+}
+
+func Test_ReindexJob_GetRunning_Success(t *testing.T) {
+	mock := db_client.InitDatabaseMock()
+
+	job := factory.SampleReindexJob
+	job.LastArticleID = uuid.MustParse("0197da8f-47ed-78b1-7b0f-ea4f4a1af25e")
+	job.IndexedCount = 1
+	job.UpdatedAt = job.StartedAt.Add(time.Minute)
+
+	columns := []string{
+		"id",
+		"index_name",
+		"status",
+		"last_article_id",
+		"indexed_count",
+		"total_count",
+		"started_at",
+		"updated_at",
+		"finished_at",
+	}
+	mock.ExpectQuery(regexp.QuoteMeta(`
+		FROM reindex_jobs
+		WHERE reindex_jobs.status = $1
+	`)).WithArgs(model.ReindexJobStatusRunning).
+		WillReturnRows(sqlmock.NewRows(columns).AddRow(
+			job.ID,
+			job.IndexName,
+			job.Status,
+			job.LastArticleID,
+			job.IndexedCount,
+			job.TotalCount,
+			job.StartedAt,
+			job.UpdatedAt,
+			nil,
+		))
+
+	repo := GetReindexJobRepository()
+	result, err := repo.GetRunning(context.Background())
+
+	assert.Nil(t, err)
+	assert.Equal(t, &job, result)
+}
+
+func Test_ReindexJob_GetRunning_Success_WhenNothingIndexedYet(t *testing.T) {
+	mock := db_client.InitDatabaseMock()
+
+	job := factory.SampleReindexJob
+	job.UpdatedAt = job.StartedAt
+
+	columns := []string{
+		"id",
+		"index_name",
+		"status",
+		"last_article_id",
+		"indexed_count",
+		"total_count",
+		"started_at",
+		"updated_at",
+		"finished_at",
+	}
+	mock.ExpectQuery(regexp.QuoteMeta(`FROM reindex_jobs`)).
+		WillReturnRows(sqlmock.NewRows(columns).AddRow(
+			job.ID,
+			job.IndexName,
+			job.Status,
+			nil,
+			0,
+			job.TotalCount,
+			job.StartedAt,
+			job.UpdatedAt,
+			nil,
+		))
+
+	repo := GetReindexJobRepository()
+	result, err := repo.GetRunning(context.Background())
+
+	assert.Nil(t, err)
+	assert.Equal(t, uuid.Nil, result.LastArticleID)
+}
+
+func Test_ReindexJob_GetRunning_ReturnErr_WhenRecordNotFound(t *testing.T) {
+	mock := db_client.InitDatabaseMock()
+
+	mock.ExpectQuery(regexp.QuoteMeta(`FROM reindex_jobs`)).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	repo := GetReindexJobRepository()
+	result, err := repo.GetRunning(context.Background())
+
+	assert.Nil(t, result)
+	assert.Equal(t, apperror.ErrObjectNotExists, err)
+}
+
+func Test_ReindexJob_GetRunning_ReturnErr_WhenQueryFailed(t *testing.T) {
+	mock := db_client.InitDatabaseMock()
+
+	mock.ExpectQuery(regexp.QuoteMeta(`FROM reindex_jobs`)).WillReturnError(errors.New("db error"))
+
+	repo := GetReindexJobRepository()
+	result, err := repo.GetRunning(context.Background())
+
+	assert.Nil(t, result)
+	assert.Equal(t, apperror.ErrGetRecordFailed, err)
+}
+
+func Test_ReindexJob_UpdateProgress_Success(t *testing.T) {
+	mock := db_client.InitDatabaseMock()
+
+	job := factory.SampleReindexJob
+	job.LastArticleID = uuid.MustParse("0197da8f-47ed-78b1-7b0f-ea4f4a1af25e")
+	job.IndexedCount = 1
+	mock.ExpectExec(regexp.QuoteMeta(`
+		UPDATE reindex_jobs
+		SET last_article_id = $1, indexed_count = $2, updated_at = $3
+		WHERE id = $4
+	`)).WithArgs(job.LastArticleID, job.IndexedCount, utils.AnyTime{}, job.ID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	repo := GetReindexJobRepository()
+	err := repo.UpdateProgress(context.Background(), &job)
+
+	assert.Nil(t, err)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func Test_ReindexJob_UpdateProgress_ReturnErr_WhenNoRowsAffected(t *testing.T) {
+	mock := db_client.InitDatabaseMock()
+
+	job := factory.SampleReindexJob
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE reindex_jobs`)).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	repo := GetReindexJobRepository()
+	err := repo.UpdateProgress(context.Background(), &job)
+
+	assert.Equal(t, apperror.ErrNoAffectedRows, err)
+}
+
+func Test_ReindexJob_Finish_Success(t *testing.T) {
+	mock := db_client.InitDatabaseMock()
+
+	job := factory.SampleReindexJob
+	mock.ExpectExec(regexp.QuoteMeta(`
+		UPDATE reindex_jobs
+		SET status = $1, finished_at = $2, updated_at = $2
+		WHERE id = $3
+	`)).WithArgs(model.ReindexJobStatusFinished, utils.AnyTime{}, job.ID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	repo := GetReindexJobRepository()
+	err := repo.Finish(context.Background(), &job)
+
+	assert.Nil(t, err)
+	assert.Equal(t, model.ReindexJobStatusFinished, job.Status)
+	assert.NotNil(t, job.FinishedAt)
+}
+
+func Test_ReindexJob_Finish_ReturnErr_WhenExecFailed(t *testing.T) {
+	mock := db_client.InitDatabaseMock()
+
+	job := factory.SampleReindexJob
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE reindex_jobs`)).WillReturnError(errors.New("db error"))
+
+	repo := GetReindexJobRepository()
+	err := repo.Finish(context.Background(), &job)
+
+	assert.Equal(t, apperror.ErrUpdateRecordFailed, err)
+	assert.Equal(t, model.ReindexJobStatusRunning, job.Status)
+}
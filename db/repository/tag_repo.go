@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+
+	"article-service/model"
+
+	"github.com/google/uuid"
+)
+
+//go:generate mockgen -source=tag_repo.go -destination=./mock_repository/tag_repo_mock.go
+type ITagRepository interface {
+	SetArticleTags(ctx context.Context, articleID uuid.UUID, tags []string) error
+	ListWithArticlesCount(ctx context.Context) ([]*model.Tag, error)
+}
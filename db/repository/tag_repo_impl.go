@@ -0,0 +1,110 @@
+package repository
+
+import (
+	"context"
+
+	"article-service/apperror"
+	"article-service/db/db_client"
+	"article-service/db/transaction"
+	"article-service/infrastructure/log"
+	"article-service/model"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+type TagRepo struct {
+}
+
+func GetTagRepository() ITagRepository {
+	return TagRepo{}
+}
+
+// SetArticleTags should be called within a transaction
+func (r TagRepo) SetArticleTags(ctx context.Context, articleID uuid.UUID, tags []string) error {
+	conn := transaction.GetClientOrTxn(ctx, db_client.GetDB)
+
+	if len(tags) > 0 {
+		query := `
+			INSERT INTO tags (name)
+			SELECT UNNEST($1::varchar[])
+			ON CONFLICT (name) DO NOTHING
+		`
+
+		_, err := conn.Exec(ctx, query, pq.Array(tags))
+		if err != nil {
+			log.Errorf(ctx, err, "[TagRepo][SetArticleTags] Insert tags failed")
+			return apperror.ErrCreateRecordFailed
+		}
+	}
+
+	query := `
+		DELETE FROM article_tags
+		WHERE article_id = $1
+	`
+
+	_, err := conn.Exec(ctx, query, articleID)
+	if err != nil {
+		log.Errorf(ctx, err, "[TagRepo][SetArticleTags] Delete article tags failed")
+		return apperror.ErrDeleteRecordFailed
+	}
+
+	if len(tags) == 0 {
+		return nil
+	}
+
+	query = `
+		INSERT INTO article_tags (article_id, tag_id)
+		SELECT $1, tags.id
+		FROM tags
+		WHERE tags.name = ANY($2)
+	`
+
+	_, err = conn.Exec(ctx, query, articleID, pq.Array(tags))
+	if err != nil {
+		log.Errorf(ctx, err, "[TagRepo][SetArticleTags] Insert article tags failed")
+		return apperror.ErrCreateRecordFailed
+	}
+
+	return nil
+}
+
+func (r TagRepo) ListWithArticlesCount(ctx context.Context) ([]*model.Tag, error) {
+	conn := transaction.GetClientOrTxn(ctx, db_client.GetDB)
+	query := `
+		SELECT
+			tags.id,
+			tags.name,
+			COUNT(articles.id) AS articles_count
+		FROM tags
+		JOIN article_tags ON article_tags.tag_id = tags.id
+		JOIN articles ON article_tags.article_id = articles.id
+		WHERE articles.status = $1 AND articles.deleted_at IS NULL
+		GROUP BY tags.id, tags.name
+		ORDER BY articles_count DESC, tags.name ASC
+	`
+
+	rows, err := conn.Query(ctx, query, model.ArticleStatusPublished)
+	if err != nil {
+		log.Errorf(ctx, err, "[TagRepo][ListWithArticlesCount] Query failed")
+		return nil, apperror.ErrGetRecordFailed
+	}
+
+	var tags = []*model.Tag{}
+	for rows.Next() {
+		var tag model.Tag
+		err = rows.Scan(
+			&tag.ID,
+			&tag.Name,
+			&tag.ArticlesCount,
+		)
+		if err != nil {
+			log.Errorf(ctx, err, "[TagRepo][ListWithArticlesCount] Scan failed")
+			return nil, apperror.ErrScanRecordFailed
+		}
+
+		tags = append(tags, &tag)
+	}
+
+	return tags, nil
+}
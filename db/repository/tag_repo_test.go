@@ -0,0 +1,137 @@
+package repository
+
+import (
+	"article-service/apperror"
+	"article-service/db/db_client"
+	"article-service/factory"
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Tag_SetArticleTags_Success(t *testing.T) {
+	mock := db_client.InitDatabaseMock()
+
+	article := factory.SampleArticle1
+	mock.ExpectExec(regexp.QuoteMeta(`
+		INSERT INTO tags (name)
+		SELECT UNNEST($1::varchar[])
+		ON CONFLICT (name) DO NOTHING
+	`)).WithArgs(pq.Array(article.Tags)).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta(`
+		DELETE FROM article_tags
+		WHERE article_id = $1
+	`)).WithArgs(article.ID).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta(`
+		INSERT INTO article_tags (article_id, tag_id)
+		SELECT $1, tags.id
+		FROM tags
+		WHERE tags.name = ANY($2)
+	`)).WithArgs(article.ID, pq.Array(article.Tags)).WillReturnResult(sqlmock.NewResult(0, 2))
+
+	repo := GetTagRepository()
+	err := repo.SetArticleTags(context.Background(), article.ID, article.Tags)
+
+	assert.Nil(t, err)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func Test_Tag_SetArticleTags_Success_WhenTagsAreEmpty(t *testing.T) {
+	mock := db_client.InitDatabaseMock()
+
+	article := factory.SampleArticle1
+	mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM article_tags`)).
+		WithArgs(article.ID).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+
+	repo := GetTagRepository()
+	err := repo.SetArticleTags(context.Background(), article.ID, []string{})
+
+	assert.Nil(t, err)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func Test_Tag_SetArticleTags_ReturnErr_WhenInsertTagsFailed(t *testing.T) {
+	mock := db_client.InitDatabaseMock()
+
+	article := factory.SampleArticle1
+	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO tags`)).WillReturnError(errors.New("db error"))
+
+	repo := GetTagRepository()
+	err := repo.SetArticleTags(context.Background(), article.ID, article.Tags)
+
+	assert.Equal(t, apperror.ErrCreateRecordFailed, err)
+}
+
+func Test_Tag_SetArticleTags_ReturnErr_WhenDeleteArticleTagsFailed(t *testing.T) {
+	mock := db_client.InitDatabaseMock()
+
+	article := factory.SampleArticle1
+	mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM article_tags`)).WillReturnError(errors.New("db error"))
+
+	repo := GetTagRepository()
+	err := repo.SetArticleTags(context.Background(), article.ID, []string{})
+
+	assert.Equal(t, apperror.ErrDeleteRecordFailed, err)
+}
+
+func Test_Tag_ListWithArticlesCount_Success(t *testing.T) {
+	mock := db_client.InitDatabaseMock()
+
+	query := regexp.QuoteMeta(`
+		SELECT
+			tags.id,
+			tags.name,
+			COUNT(articles.id) AS articles_count
+		FROM tags
+		JOIN article_tags ON article_tags.tag_id = tags.id
+		JOIN articles ON article_tags.article_id = articles.id
+		WHERE articles.status = $1 AND articles.deleted_at IS NULL
+		GROUP BY tags.id, tags.name
+		ORDER BY articles_count DESC, tags.name ASC
+	`)
+
+	columns := []string{"id", "name", "articles_count"}
+	tagID := factory.SampleArticle1.ID
+	mock.ExpectQuery(query).WithArgs("published").
+		WillReturnRows(sqlmock.NewRows(columns).AddRow(tagID, "keluarga", 2))
+
+	repo := GetTagRepository()
+	tags, err := repo.ListWithArticlesCount(context.Background())
+
+	assert.Nil(t, err)
+	assert.Len(t, tags, 1)
+	assert.Equal(t, "keluarga", tags[0].Name)
+	assert.Equal(t, int64(2), tags[0].ArticlesCount)
+}
+
+func Test_Tag_ListWithArticlesCount_ReturnErr_WhenQueryFailed(t *testing.T) {
+	mock := db_client.InitDatabaseMock()
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT`)).WillReturnError(errors.New("db error"))
+
+	repo := GetTagRepository()
+	tags, err := repo.ListWithArticlesCount(context.Background())
+
+	assert.Nil(t, tags)
+	assert.Equal(t, apperror.ErrGetRecordFailed, err)
+}
+
+func Test_Tag_ListWithArticlesCount_ReturnErr_WhenScanFailed(t *testing.T) {
+	mock := db_client.InitDatabaseMock()
+
+	columns := []string{"id", "name", "articles_count"}
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT`)).
+		WillReturnRows(sqlmock.NewRows(columns).AddRow("invalid-id", "keluarga", 2))
+
+	repo := GetTagRepository()
+	tags, err := repo.ListWithArticlesCount(context.Background())
+
+	assert.Nil(t, tags)
+	assert.Equal(t, apperror.ErrScanRecordFailed, err)
+}
@@ -1,6 +1,22 @@
-INSERT INTO "public"."authors" ("id", "name") VALUES
-    ('0197da8f-47ed-78b1-7b0f-ea4f4a1af25e', 'Chandra'),
-    ('0197da8f-47ed-78b1-7b0f-ea4f4a1af25f', 'Phang');
-INSERT INTO "public"."articles" ("id", "author_id", "title", "body", "created_at") VALUES
-('0197db1c-c6c4-7140-bee3-8efd703f30c8', '0197da8f-47ed-78b1-7b0f-ea4f4a1af25e', 'Satu satu aku sayang ibu', 'Dua dua juga sayang ayah', '2025-07-05 09:00:00+07'),
-('0197db1c-c6c4-7140-bee3-8efd703f30c9', '0197da8f-47ed-78b1-7b0f-ea4f4a1af25f', 'Tiga tiga sayang adik kakak', 'Satu dua tiga, sayang semuanya', '2025-07-05 10:00:00+07');
+INSERT INTO "public"."authors" ("id", "name", "handle") VALUES
+    ('0197da8f-47ed-78b1-7b0f-ea4f4a1af25e', 'Chandra', 'chandra'),
+    ('0197da8f-47ed-78b1-7b0f-ea4f4a1af25f', 'Phang', 'phang');
+INSERT INTO "public"."articles" ("id", "author_id", "title", "slug", "body", "status", "created_at", "updated_at", "published_at") VALUES
+('0197db1c-c6c4-7140-bee3-8efd703f30c8', '0197da8f-47ed-78b1-7b0f-ea4f4a1af25e', 'Satu satu aku sayang ibu', 'satu-satu-aku-sayang-ibu', 'Dua dua juga sayang ayah', 'published', '2025-07-05 09:00:00+07', '2025-07-05 09:00:00+07', '2025-07-05 09:00:00+07'),
+('0197db1c-c6c4-7140-bee3-8efd703f30c9', '0197da8f-47ed-78b1-7b0f-ea4f4a1af25f', 'Tiga tiga sayang adik kakak', 'tiga-tiga-sayang-adik-kakak', 'Satu dua tiga, sayang semuanya', 'published', '2025-07-05 10:00:00+07', '2025-07-05 10:00:00+07', '2025-07-05 10:00:00+07');
+INSERT INTO "public"."article_revisions" ("article_id", "revision", "title", "body", "author_id", "created_at") VALUES
+('0197db1c-c6c4-7140-bee3-8efd703f30c8', 1, 'Satu satu aku sayang ibu', 'Dua dua juga sayang ayah', '0197da8f-47ed-78b1-7b0f-ea4f4a1af25e', '2025-07-05 09:00:00+07'),
+('0197db1c-c6c4-7140-bee3-8efd703f30c9', 1, 'Tiga tiga sayang adik kakak', 'Satu dua tiga, sayang semuanya', '0197da8f-47ed-78b1-7b0f-ea4f4a1af25f', '2025-07-05 10:00:00+07');
+INSERT INTO "public"."tags" ("id", "name") VALUES
+    ('0197dc2a-1f3e-7a10-9c4d-2b7e5f8a1c01', 'puisi'),
+    ('0197dc2a-1f3e-7a10-9c4d-2b7e5f8a1c02', 'keluarga');
+INSERT INTO "public"."article_tags" ("article_id", "tag_id") VALUES
+('0197db1c-c6c4-7140-bee3-8efd703f30c8', '0197dc2a-1f3e-7a10-9c4d-2b7e5f8a1c01'),
+('0197db1c-c6c4-7140-bee3-8efd703f30c8', '0197dc2a-1f3e-7a10-9c4d-2b7e5f8a1c02'),
+('0197db1c-c6c4-7140-bee3-8efd703f30c9', '0197dc2a-1f3e-7a10-9c4d-2b7e5f8a1c02');
+INSERT INTO "public"."article_slugs" ("slug", "article_id", "created_at") VALUES
+('satu-satu-aku-sayang-ibu', '0197db1c-c6c4-7140-bee3-8efd703f30c8', '2025-07-05 09:00:00+07'),
+('tiga-tiga-sayang-adik-kakak', '0197db1c-c6c4-7140-bee3-8efd703f30c9', '2025-07-05 10:00:00+07');
+INSERT INTO "public"."article_search_documents" ("article_id", "title", "body", "author_name", "tags", "created_at") VALUES
+('0197db1c-c6c4-7140-bee3-8efd703f30c8', 'Satu satu aku sayang ibu', 'Dua dua juga sayang ayah', 'Chandra', 'keluarga puisi', '2025-07-05 09:00:00+07'),
+('0197db1c-c6c4-7140-bee3-8efd703f30c9', 'Tiga tiga sayang adik kakak', 'Satu dua tiga, sayang semuanya', 'Phang', 'keluarga', '2025-07-05 10:00:00+07');
@@ -2,6 +2,7 @@ package v1req
 
 import (
 	"context"
+	"time"
 
 	"article-service/apperror"
 	"article-service/infrastructure/log"
@@ -10,22 +11,69 @@ import (
 )
 
 type ListArticlesDTO struct {
-	Query         string
-	AuthorName    string
-	SortBy        string `validate:"omitempty,oneof=created_at title author_name"`
-	SortDirection string `validate:"omitempty,oneof=asc desc"`
-	Limit         int
-	Page          int
+	Query            string
+	Fuzzy            bool
+	AuthorName       string
+	AuthorIds        []string `validate:"omitempty,dive,uuid"`
+	ExcludeAuthorIds []string `validate:"omitempty,dive,uuid"`
+	CreatedFrom      string   `validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`
+	CreatedTo        string   `validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`
+	CategoryId       string   `validate:"omitempty,uuid"`
+	Tags             []string
+	TagsMatch        string `validate:"omitempty,oneof=any all"`
+	IncludeDeleted   bool
+	SortBy           string   `validate:"omitempty,oneof=relevance created_at title author_name"`
+	SortDirection    string   `validate:"omitempty,oneof=asc desc"`
+	Facets           []string `validate:"omitempty,dive,oneof=author month tag"`
+	Limit            int
+	Page             int
+}
+
+type SuggestArticlesDTO struct {
+	Query string `validate:"required,max=100"`
+	Limit int    `validate:"omitempty,min=1,max=10"`
+}
+
+type ListRelatedArticlesDTO struct {
+	Limit int `validate:"omitempty,min=1,max=20"`
 }
 
 type CreateArticleDTO struct {
-	Title    string `json:"title" validate:"required"`
-	Body     string `json:"body" validate:"required"`
-	AuthorId string `json:"authorId" validate:"required,uuid"`
+	Title      string   `json:"title" validate:"required"`
+	Body       string   `json:"body" validate:"required"`
+	AuthorId   string   `json:"authorId" validate:"required,uuid"`
+	CategoryId string   `json:"categoryId" validate:"omitempty,uuid"`
+	Tags       []string `json:"tags" validate:"omitempty,max=10,dive,required,max=30"`
+}
+
+type UpdateArticleDTO struct {
+	Title      string   `json:"title" validate:"required"`
+	Body       string   `json:"body" validate:"required"`
+	AuthorId   string   `json:"authorId" validate:"required,uuid"`
+	CategoryId string   `json:"categoryId" validate:"omitempty,uuid"`
+	Tags       []string `json:"tags" validate:"omitempty,max=10,dive,required,max=30"`
+}
+
+type PatchArticleDTO struct {
+	Title      *string  `json:"title" validate:"omitempty,min=1"`
+	Body       *string  `json:"body" validate:"omitempty,min=1"`
+	AuthorId   *string  `json:"authorId" validate:"omitempty,uuid"`
+	CategoryId *string  `json:"categoryId" validate:"omitempty,len=0|uuid"`
+	Tags       []string `json:"tags" validate:"omitempty,max=10,dive,required,max=30"`
+}
+
+type ScheduleArticleDTO struct {
+	PublishAt time.Time `json:"publishAt" validate:"required,gt"`
+}
+
+type DiffArticleRevisionsDTO struct {
+	From int `validate:"required,min=1"`
+	To   int `validate:"required,min=1"`
 }
 
 func (dto ListArticlesDTO) Validate(ctx context.Context) error {
 	validate := validator.New()
+	validate.RegisterStructValidation(validateCreatedRange, ListArticlesDTO{})
 	if err := validate.Struct(dto); err != nil {
 		err = apperror.TryTranslateValidationErrors(err)
 		log.Errorf(ctx, err, "[V1][ListArticlesDTO] Validation failed. dto: %v", dto)
@@ -35,6 +83,37 @@ func (dto ListArticlesDTO) Validate(ctx context.Context) error {
 	return nil
 }
 
+func validateCreatedRange(sl validator.StructLevel) {
+	dto := sl.Current().Interface().(ListArticlesDTO)
+	from, fromErr := time.Parse(time.RFC3339, dto.CreatedFrom)
+	to, toErr := time.Parse(time.RFC3339, dto.CreatedTo)
+	if fromErr == nil && toErr == nil && to.Before(from) {
+		sl.ReportError(dto.CreatedTo, "CreatedTo", "CreatedTo", "gtefield", "CreatedFrom")
+	}
+}
+
+func (dto SuggestArticlesDTO) Validate(ctx context.Context) error {
+	validate := validator.New()
+	if err := validate.Struct(dto); err != nil {
+		err = apperror.TryTranslateValidationErrors(err)
+		log.Errorf(ctx, err, "[V1][SuggestArticlesDTO] Validation failed. dto: %v", dto)
+		return err
+	}
+
+	return nil
+}
+
+func (dto ListRelatedArticlesDTO) Validate(ctx context.Context) error {
+	validate := validator.New()
+	if err := validate.Struct(dto); err != nil {
+		err = apperror.TryTranslateValidationErrors(err)
+		log.Errorf(ctx, err, "[V1][ListRelatedArticlesDTO] Validation failed. dto: %v", dto)
+		return err
+	}
+
+	return nil
+}
+
 func (dto CreateArticleDTO) Validate(ctx context.Context) error {
 	validate := validator.New()
 	if err := validate.Struct(dto); err != nil {
@@ -45,3 +124,47 @@ func (dto CreateArticleDTO) Validate(ctx context.Context) error {
 
 	return nil
 }
+
+func (dto UpdateArticleDTO) Validate(ctx context.Context) error {
+	validate := validator.New()
+	if err := validate.Struct(dto); err != nil {
+		err = apperror.TryTranslateValidationErrors(err)
+		log.Errorf(ctx, err, "[V1][UpdateArticleDTO] Validation failed. Dto: %v", dto)
+		return err
+	}
+
+	return nil
+}
+
+func (dto PatchArticleDTO) Validate(ctx context.Context) error {
+	validate := validator.New()
+	if err := validate.Struct(dto); err != nil {
+		err = apperror.TryTranslateValidationErrors(err)
+		log.Errorf(ctx, err, "[V1][PatchArticleDTO] Validation failed. Dto: %v", dto)
+		return err
+	}
+
+	return nil
+}
+
+func (dto ScheduleArticleDTO) Validate(ctx context.Context) error {
+	validate := validator.New()
+	if err := validate.Struct(dto); err != nil {
+		err = apperror.TryTranslateValidationErrors(err)
+		log.Errorf(ctx, err, "[V1][ScheduleArticleDTO] Validation failed. Dto: %v", dto)
+		return err
+	}
+
+	return nil
+}
+
+func (dto DiffArticleRevisionsDTO) Validate(ctx context.Context) error {
+	validate := validator.New()
+	if err := validate.Struct(dto); err != nil {
+		err = apperror.TryTranslateValidationErrors(err)
+		log.Errorf(ctx, err, "[V1][DiffArticleRevisionsDTO] Validation failed. Dto: %v", dto)
+		return err
+	}
+
+	return nil
+}
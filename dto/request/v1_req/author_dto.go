@@ -0,0 +1,84 @@
+package v1req
+
+import (
+	"context"
+	"regexp"
+
+	"article-service/apperror"
+	"article-service/infrastructure/log"
+
+	"github.com/go-playground/validator/v10"
+)
+
+var handleRegex = regexp.MustCompile(`^[a-z0-9]+(?:[-_][a-z0-9]+)*$`)
+
+type ListAuthorsDTO struct {
+	Name  string
+	Limit int
+	Page  int
+}
+
+type ListAuthorArticlesDTO struct {
+	Handle        string
+	SortBy        string `validate:"omitempty,oneof=created_at title"`
+	SortDirection string `validate:"omitempty,oneof=asc desc"`
+	Limit         int
+	Page          int
+}
+
+type CreateAuthorDTO struct {
+	Name      string `json:"name" validate:"required,max=100"`
+	Handle    string `json:"handle" validate:"required,max=50,handle"`
+	Bio       string `json:"bio" validate:"max=1000"`
+	AvatarURL string `json:"avatarUrl" validate:"omitempty,max=2048,url"`
+	Website   string `json:"website" validate:"omitempty,max=2048,url"`
+}
+
+type UpdateAuthorDTO struct {
+	Name      *string `json:"name" validate:"omitempty,min=1,max=100"`
+	Handle    *string `json:"handle" validate:"omitempty,max=50,handle"`
+	Bio       *string `json:"bio" validate:"omitempty,max=1000"`
+	AvatarURL *string `json:"avatarUrl" validate:"omitempty,max=2048,len=0|url"`
+	Website   *string `json:"website" validate:"omitempty,max=2048,len=0|url"`
+}
+
+func newAuthorValidator() *validator.Validate {
+	validate := validator.New()
+	validate.RegisterValidation("handle", func(fl validator.FieldLevel) bool {
+		return handleRegex.MatchString(fl.Field().String())
+	})
+	return validate
+}
+
+func (dto ListAuthorArticlesDTO) Validate(ctx context.Context) error {
+	validate := validator.New()
+	if err := validate.Struct(dto); err != nil {
+		err = apperror.TryTranslateValidationErrors(err)
+		log.Errorf(ctx, err, "[V1][ListAuthorArticlesDTO] Validation failed. Dto: %v", dto)
+		return err
+	}
+
+	return nil
+}
+
+func (dto CreateAuthorDTO) Validate(ctx context.Context) error {
+	validate := newAuthorValidator()
+	if err := validate.Struct(dto); err != nil {
+		err = apperror.TryTranslateValidationErrors(err)
+		log.Errorf(ctx, err, "[V1][CreateAuthorDTO] Validation failed. Dto: %v", dto)
+		return err
+	}
+
+	return nil
+}
+
+func (dto UpdateAuthorDTO) Validate(ctx context.Context) error {
+	validate := newAuthorValidator()
+	if err := validate.Struct(dto); err != nil {
+		err = apperror.TryTranslateValidationErrors(err)
+		log.Errorf(ctx, err, "[V1][UpdateAuthorDTO] Validation failed. Dto: %v", dto)
+		return err
+	}
+
+	return nil
+}
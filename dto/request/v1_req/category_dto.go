@@ -0,0 +1,42 @@
+package v1req
+
+import (
+	"context"
+
+	"article-service/apperror"
+	"article-service/infrastructure/log"
+
+	"github.com/go-playground/validator/v10"
+)
+
+type CreateCategoryDTO struct {
+	Name     string `json:"name" validate:"required,max=100"`
+	ParentId string `json:"parentId" validate:"omitempty,uuid"`
+}
+
+type UpdateCategoryDTO struct {
+	Name     *string `json:"name" validate:"omitempty,min=1,max=100"`
+	ParentId *string `json:"parentId" validate:"omitempty,len=0|uuid"`
+}
+
+func (dto CreateCategoryDTO) Validate(ctx context.Context) error {
+	validate := validator.New()
+	if err := validate.Struct(dto); err != nil {
+		err = apperror.TryTranslateValidationErrors(err)
+		log.Errorf(ctx, err, "[V1][CreateCategoryDTO] Validation failed. Dto: %v", dto)
+		return err
+	}
+
+	return nil
+}
+
+func (dto UpdateCategoryDTO) Validate(ctx context.Context) error {
+	validate := validator.New()
+	if err := validate.Struct(dto); err != nil {
+		err = apperror.TryTranslateValidationErrors(err)
+		log.Errorf(ctx, err, "[V1][UpdateCategoryDTO] Validation failed. Dto: %v", dto)
+		return err
+	}
+
+	return nil
+}
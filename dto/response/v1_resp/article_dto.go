@@ -15,26 +15,75 @@ type CreateArticleDTO struct {
 type ListArticlesDTO struct {
 	RecordsCount int64        `json:"recordsCount"`
 	Articles     []ArticleDTO `json:"articles"`
+	Facets       FacetsDTO    `json:"facets,omitempty"`
+	Suggestion   string       `json:"suggestion,omitempty"`
+}
+
+type SuggestArticlesDTO struct {
+	Titles  []TitleSuggestionDTO `json:"titles"`
+	Authors []string             `json:"authors"`
+}
+
+type TitleSuggestionDTO struct {
+	ID    uuid.UUID `json:"id"`
+	Title string    `json:"title"`
+}
+
+type FacetsDTO map[string][]FacetBucketDTO
+
+type FacetBucketDTO struct {
+	Value string `json:"value"`
+	Label string `json:"label,omitempty"`
+	Count int64  `json:"count"`
 }
 
 type ArticleDTO struct {
-	ID        uuid.UUID `json:"id"`
-	Title     string    `json:"title"`
-	Body      string    `json:"body"`
-	CreatedAt time.Time `json:"createdAt"`
-	Author    AuthorDTO `json:"author"`
+	ID          uuid.UUID     `json:"id"`
+	Title       string        `json:"title"`
+	Slug        string        `json:"slug"`
+	Body        string        `json:"body"`
+	Status      string        `json:"status"`
+	CreatedAt   time.Time     `json:"createdAt"`
+	UpdatedAt   time.Time     `json:"updatedAt"`
+	PublishedAt *time.Time    `json:"publishedAt,omitempty"`
+	PublishAt   *time.Time    `json:"publishAt,omitempty"`
+	DeletedAt   *time.Time    `json:"deletedAt,omitempty"`
+	Author      AuthorDTO     `json:"author"`
+	Category    *CategoryDTO  `json:"category,omitempty"`
+	Tags        []string      `json:"tags"`
+	Highlights  *HighlightDTO `json:"highlights,omitempty"`
+}
+
+type HighlightDTO struct {
+	Title []string `json:"title"`
+	Body  []string `json:"body"`
 }
 
 func (dto *ArticleDTO) Convert(article *model.Article) ArticleDTO {
 	respDto := ArticleDTO{
-		ID:        article.ID,
-		Title:     article.Title,
-		Body:      article.Body,
-		CreatedAt: article.CreatedAt,
-		Author: AuthorDTO{
-			ID:   article.Author.ID,
-			Name: article.Author.Name,
-		},
+		ID:          article.ID,
+		Title:       article.Title,
+		Slug:        article.Slug,
+		Body:        article.Body,
+		Status:      string(article.Status),
+		CreatedAt:   article.CreatedAt,
+		UpdatedAt:   article.UpdatedAt,
+		PublishedAt: article.PublishedAt,
+		PublishAt:   article.PublishAt,
+		DeletedAt:   article.DeletedAt,
+		Author:      new(AuthorDTO).Convert(&article.Author),
+		Tags:        article.Tags,
+	}
+	if article.Category != nil {
+		category := new(CategoryDTO).Convert(article.Category)
+		respDto.Category = &category
+	}
+	if respDto.Tags == nil {
+		respDto.Tags = []string{}
+	}
+	if article.Highlight != nil {
+		highlight := new(HighlightDTO).Convert(article.Highlight)
+		respDto.Highlights = &highlight
 	}
 
 	return respDto
@@ -52,3 +101,49 @@ func (dto *ListArticlesDTO) Convert(articles []*model.Article, recordsCount int6
 
 	return responseDTO
 }
+
+func (dto *HighlightDTO) Convert(highlight *model.ArticleHighlight) HighlightDTO {
+	respDto := HighlightDTO{
+		Title: highlight.Title,
+		Body:  highlight.Body,
+	}
+	if respDto.Title == nil {
+		respDto.Title = []string{}
+	}
+	if respDto.Body == nil {
+		respDto.Body = []string{}
+	}
+
+	return respDto
+}
+
+func (dto *FacetsDTO) Convert(facets model.ArticleFacets) FacetsDTO {
+	respDto := FacetsDTO{}
+	for facet, buckets := range facets {
+		respDto[facet] = []FacetBucketDTO{}
+		for _, bucket := range buckets {
+			respDto[facet] = append(respDto[facet], FacetBucketDTO{
+				Value: bucket.Value,
+				Label: bucket.Label,
+				Count: bucket.Count,
+			})
+		}
+	}
+
+	return respDto
+}
+
+func (dto *SuggestArticlesDTO) Convert(suggestions model.ArticleSuggestions) SuggestArticlesDTO {
+	respDto := SuggestArticlesDTO{
+		Titles:  []TitleSuggestionDTO{},
+		Authors: suggestions.Authors,
+	}
+	for _, title := range suggestions.Titles {
+		respDto.Titles = append(respDto.Titles, TitleSuggestionDTO{ID: title.ArticleID, Title: title.Title})
+	}
+	if respDto.Authors == nil {
+		respDto.Authors = []string{}
+	}
+
+	return respDto
+}
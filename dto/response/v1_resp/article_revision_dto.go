@@ -0,0 +1,75 @@
+package v1resp
+
+import (
+	"time"
+
+	"article-service/model"
+
+	"github.com/google/uuid"
+)
+
+type ListArticleRevisionsDTO struct {
+	Revisions []ArticleRevisionDTO `json:"revisions"`
+}
+
+type ArticleRevisionDTO struct {
+	Revision  int       `json:"revision"`
+	Title     string    `json:"title"`
+	Body      string    `json:"body"`
+	AuthorID  uuid.UUID `json:"authorId"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+type ArticleRevisionDiffDTO struct {
+	From  int           `json:"from"`
+	To    int           `json:"to"`
+	Title []DiffLineDTO `json:"title"`
+	Body  []DiffLineDTO `json:"body"`
+}
+
+type DiffLineDTO struct {
+	Op   string `json:"op"`
+	Text string `json:"text"`
+}
+
+func (dto *ArticleRevisionDTO) Convert(revision *model.ArticleRevision) ArticleRevisionDTO {
+	respDto := ArticleRevisionDTO{
+		Revision:  revision.Revision,
+		Title:     revision.Title,
+		Body:      revision.Body,
+		AuthorID:  revision.AuthorID,
+		CreatedAt: revision.CreatedAt,
+	}
+
+	return respDto
+}
+
+func (dto *ListArticleRevisionsDTO) Convert(revisions []*model.ArticleRevision) ListArticleRevisionsDTO {
+	responseDTO := ListArticleRevisionsDTO{
+		Revisions: []ArticleRevisionDTO{},
+	}
+
+	for _, revision := range revisions {
+		revisionDTO := new(ArticleRevisionDTO).Convert(revision)
+		responseDTO.Revisions = append(responseDTO.Revisions, revisionDTO)
+	}
+
+	return responseDTO
+}
+
+func (dto *ArticleRevisionDiffDTO) Convert(diff *model.ArticleRevisionDiff) ArticleRevisionDiffDTO {
+	return ArticleRevisionDiffDTO{
+		From:  diff.From,
+		To:    diff.To,
+		Title: convertDiffLines(diff.Title),
+		Body:  convertDiffLines(diff.Body),
+	}
+}
+
+func convertDiffLines(lines []model.DiffLine) []DiffLineDTO {
+	lineDTOs := []DiffLineDTO{}
+	for _, line := range lines {
+		lineDTOs = append(lineDTOs, DiffLineDTO{Op: string(line.Op), Text: line.Text})
+	}
+	return lineDTOs
+}
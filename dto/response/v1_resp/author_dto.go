@@ -1,8 +1,51 @@
 package v1resp
 
-import "github.com/google/uuid"
+import (
+	"article-service/model"
+
+	"github.com/google/uuid"
+)
+
+type CreateAuthorDTO struct {
+	ID uuid.UUID `json:"id"`
+}
+
+type ListAuthorsDTO struct {
+	RecordsCount int64       `json:"recordsCount"`
+	Authors      []AuthorDTO `json:"authors"`
+}
 
 type AuthorDTO struct {
-	ID   uuid.UUID `json:"id"`
-	Name string    `json:"name"`
+	ID        uuid.UUID `json:"id"`
+	Name      string    `json:"name"`
+	Handle    string    `json:"handle"`
+	Bio       string    `json:"bio,omitempty"`
+	AvatarURL string    `json:"avatarUrl,omitempty"`
+	Website   string    `json:"website,omitempty"`
+}
+
+func (dto *AuthorDTO) Convert(author *model.Author) AuthorDTO {
+	respDto := AuthorDTO{
+		ID:        author.ID,
+		Name:      author.Name,
+		Handle:    author.Handle,
+		Bio:       author.Bio,
+		AvatarURL: author.AvatarURL,
+		Website:   author.Website,
+	}
+
+	return respDto
+}
+
+func (dto *ListAuthorsDTO) Convert(authors []*model.Author, recordsCount int64) ListAuthorsDTO {
+	responseDTO := ListAuthorsDTO{
+		RecordsCount: recordsCount,
+	}
+
+	for _, author := range authors {
+		authorDTO := new(AuthorDTO).Convert(author)
+		responseDTO.Authors = append(responseDTO.Authors, authorDTO)
+	}
+
+	return responseDTO
 }
@@ -0,0 +1,62 @@
+package v1resp
+
+import (
+	"article-service/model"
+
+	"github.com/google/uuid"
+)
+
+type CreateCategoryDTO struct {
+	ID uuid.UUID `json:"id"`
+}
+
+type ListCategoriesDTO struct {
+	Categories []CategoryDTO `json:"categories"`
+}
+
+type CategoryDTO struct {
+	ID       uuid.UUID     `json:"id"`
+	ParentID *uuid.UUID    `json:"parentId,omitempty"`
+	Name     string        `json:"name"`
+	Children []CategoryDTO `json:"children,omitempty"`
+}
+
+func (dto *CategoryDTO) Convert(category *model.Category) CategoryDTO {
+	respDto := CategoryDTO{
+		ID:       category.ID,
+		ParentID: category.ParentID,
+		Name:     category.Name,
+	}
+
+	return respDto
+}
+
+func (dto *ListCategoriesDTO) Convert(categories []*model.Category) ListCategoriesDTO {
+	childrenByParentID := map[uuid.UUID][]*model.Category{}
+	var roots []*model.Category
+	for _, category := range categories {
+		if category.ParentID == nil {
+			roots = append(roots, category)
+			continue
+		}
+		childrenByParentID[*category.ParentID] = append(childrenByParentID[*category.ParentID], category)
+	}
+
+	responseDTO := ListCategoriesDTO{
+		Categories: convertCategoryTree(roots, childrenByParentID),
+	}
+
+	return responseDTO
+}
+
+func convertCategoryTree(categories []*model.Category, childrenByParentID map[uuid.UUID][]*model.Category) []CategoryDTO {
+	categoryDTOs := []CategoryDTO{}
+	for _, category := range categories {
+		categoryDTO := new(CategoryDTO).Convert(category)
+		if children := childrenByParentID[category.ID]; len(children) > 0 {
+			categoryDTO.Children = convertCategoryTree(children, childrenByParentID)
+		}
+		categoryDTOs = append(categoryDTOs, categoryDTO)
+	}
+	return categoryDTOs
+}
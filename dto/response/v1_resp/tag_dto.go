@@ -0,0 +1,35 @@
+package v1resp
+
+import (
+	"article-service/model"
+)
+
+type ListTagsDTO struct {
+	Tags []TagDTO `json:"tags"`
+}
+
+type TagDTO struct {
+	Name          string `json:"name"`
+	ArticlesCount int64  `json:"articlesCount"`
+}
+
+func (dto *TagDTO) Convert(tag *model.Tag) TagDTO {
+	respDto := TagDTO{
+		Name:          tag.Name,
+		ArticlesCount: tag.ArticlesCount,
+	}
+
+	return respDto
+}
+
+func (dto *ListTagsDTO) Convert(tags []*model.Tag) ListTagsDTO {
+	responseDTO := ListTagsDTO{
+		Tags: []TagDTO{},
+	}
+
+	for _, tag := range tags {
+		responseDTO.Tags = append(responseDTO.Tags, new(TagDTO).Convert(tag))
+	}
+
+	return responseDTO
+}
@@ -12,15 +12,32 @@ var SampleAuthorChandra model.Author
 var SampleAuthorPhang model.Author
 var SampleArticle1 model.Article
 var SampleArticle2 model.Article
+var SampleArticle1Revision1 model.ArticleRevision
+var SampleCategoryNews model.Category
+var SampleCategoryLocal model.Category
+var SampleReindexJob model.ReindexJob
+var SampleOutboxEvent model.OutboxEvent
 
 func init() {
 	SampleAuthorChandra = model.Author{
-		ID:   uuid.MustParse("0197da8f-47ed-78b1-7b0f-ea4f4a1af25e"),
-		Name: "Chandra",
+		ID:     uuid.MustParse("0197da8f-47ed-78b1-7b0f-ea4f4a1af25e"),
+		Name:   "Chandra",
+		Handle: "chandra",
 	}
 	SampleAuthorPhang = model.Author{
-		ID:   uuid.MustParse("0197da8f-47ed-78b1-7b0f-ea4f4a1af25f"),
-		Name: "Phang",
+		ID:     uuid.MustParse("0197da8f-47ed-78b1-7b0f-ea4f4a1af25f"),
+		Name:   "Phang",
+		Handle: "phang",
+	}
+
+	SampleCategoryNews = model.Category{
+		ID:   uuid.MustParse("0197dd3b-2a4f-7b21-8d5e-3c8f6a9b2d01"),
+		Name: "News",
+	}
+	SampleCategoryLocal = model.Category{
+		ID:       uuid.MustParse("0197dd3b-2a4f-7b21-8d5e-3c8f6a9b2d02"),
+		ParentID: &SampleCategoryNews.ID,
+		Name:     "Local",
 	}
 
 	parsedTime1, err := time.Parse(time.RFC3339, "2025-07-05T09:00:00+07:00")
@@ -29,11 +46,26 @@ func init() {
 	}
 
 	SampleArticle1 = model.Article{
-		ID:        uuid.MustParse("0197db1c-c6c4-7140-bee3-8efd703f30c8"),
-		Title:     "Satu satu aku sayang ibu",
-		Body:      "Dua dua juga sayang ayah",
+		ID:          uuid.MustParse("0197db1c-c6c4-7140-bee3-8efd703f30c8"),
+		Title:       "Satu satu aku sayang ibu",
+		Slug:        "satu-satu-aku-sayang-ibu",
+		Body:        "Dua dua juga sayang ayah",
+		Status:      model.ArticleStatusPublished,
+		CreatedAt:   parsedTime1,
+		UpdatedAt:   parsedTime1,
+		PublishedAt: &parsedTime1,
+		Author:      SampleAuthorChandra,
+		Tags:        []string{"keluarga", "puisi"},
+	}
+
+	SampleArticle1Revision1 = model.ArticleRevision{
+		ID:        uuid.MustParse("0197db1c-c6c4-7140-bee3-8efd703f40c8"),
+		ArticleID: SampleArticle1.ID,
+		Revision:  1,
+		Title:     SampleArticle1.Title,
+		Body:      SampleArticle1.Body,
+		AuthorID:  SampleAuthorChandra.ID,
 		CreatedAt: parsedTime1,
-		Author:    SampleAuthorChandra,
 	}
 
 	parsedTime2, err := time.Parse(time.RFC3339, "2025-07-05T10:00:00+07:00")
@@ -42,10 +74,34 @@ func init() {
 	}
 
 	SampleArticle2 = model.Article{
-		ID:        uuid.MustParse("0197db1c-c6c4-7140-bee3-8efd703f30c9"),
-		Title:     "Tiga tiga sayang adik kakak",
-		Body:      "Satu dua tiga, sayang semuanya",
-		CreatedAt: parsedTime2,
-		Author:    SampleAuthorPhang,
+		ID:          uuid.MustParse("0197db1c-c6c4-7140-bee3-8efd703f30c9"),
+		Title:       "Tiga tiga sayang adik kakak",
+		Slug:        "tiga-tiga-sayang-adik-kakak",
+		Body:        "Satu dua tiga, sayang semuanya",
+		Status:      model.ArticleStatusPublished,
+		CreatedAt:   parsedTime2,
+		UpdatedAt:   parsedTime2,
+		PublishedAt: &parsedTime2,
+		Author:      SampleAuthorPhang,
+		Tags:        []string{"keluarga"},
+	}
+
+	SampleReindexJob = model.ReindexJob{
+		ID:         uuid.MustParse("019a1c2e-5b7d-7c3a-9f21-4d8e6b0a1c55"),
+		IndexName:  "articles_v1_20251017090000",
+		Status:     model.ReindexJobStatusRunning,
+		TotalCount: 2,
+		StartedAt:  time.Date(2025, 10, 17, 9, 0, 0, 0, time.UTC),
+	}
+
+	outboxCreatedAt := time.Date(2025, 10, 17, 9, 0, 0, 0, time.UTC)
+	SampleOutboxEvent = model.OutboxEvent{
+		ID:          uuid.MustParse("019a1c2e-5b7d-7c3a-9f21-4d8e6b0a1c66"),
+		AggregateID: SampleArticle1.ID,
+		EventType:   model.OutboxEventArticleChanged,
+		Status:      model.OutboxEventStatusPending,
+		LastError:   "",
+		AvailableAt: outboxCreatedAt,
+		CreatedAt:   outboxCreatedAt,
 	}
 }
@@ -20,6 +20,7 @@ type ctxKey string
 // Context keys
 const (
 	requestIDKey = ctxKey("request ID")
+	isAdminKey   = ctxKey("is admin")
 )
 
 func GetReqID(ctx context.Context) string {
@@ -36,6 +37,20 @@ func WithReqID(ctx context.Context, reqID string) context.Context {
 	return context.WithValue(ctx, requestIDKey, reqID)
 }
 
+func IsAdmin(ctx context.Context) bool {
+	if ctx == nil {
+		return false
+	}
+	if isAdmin, ok := ctx.Value(isAdminKey).(bool); ok {
+		return isAdmin
+	}
+	return false
+}
+
+func WithIsAdmin(ctx context.Context, isAdmin bool) context.Context {
+	return context.WithValue(ctx, isAdminKey, isAdmin)
+}
+
 func GenerateRequestID() string {
 	n := time.Now().UnixNano()
 	base36 := strconv.FormatInt(n, 36)
@@ -9,7 +9,8 @@ import (
 )
 
 type ElasticSearch struct {
-	Client *elastic.Client
+	Client    *elastic.Client
+	Highlight configloader.HighlightConfig
 }
 
 var elasticInstance *ElasticSearch
@@ -26,7 +27,8 @@ func InitElasticSearch(ctx context.Context, config configloader.ElasticConfig) {
 	}
 
 	elasticInstance = &ElasticSearch{
-		Client: es,
+		Client:    es,
+		Highlight: config.Highlight,
 	}
 	log.Infof(ctx, "[ElasticSearch] Initializing instance")
 }
@@ -2,15 +2,32 @@ package main
 
 import (
 	"flag"
+	"fmt"
+	"os"
 
 	"article-service/app"
 )
 
+const defaultReindexBatchSize = 500
+
 func main() {
 	var configFilePath string
+	var batchSize int
 	flag.StringVar(&configFilePath, "config", "config.yml", "absolute path to the configuration file")
+	flag.IntVar(&batchSize, "batch-size", defaultReindexBatchSize, "number of articles indexed per batch by the reindex command")
 	flag.Parse()
 
 	application := app.NewApplication()
-	application.InitApplication(configFilePath)
+	switch command := flag.Arg(0); command {
+	case "":
+		application.InitApplication(configFilePath)
+	case "reindex":
+		if batchSize <= 0 {
+			batchSize = defaultReindexBatchSize
+		}
+		application.RunReindex(configFilePath, batchSize)
+	default:
+		fmt.Fprintf(os.Stderr, "unknown command %q, the only command is reindex\n", command)
+		os.Exit(2)
+	}
 }
@@ -8,10 +8,58 @@ import (
 
 const ArticleIndex = "articles"
 
+type ArticleStatus string
+
+const (
+	ArticleStatusDraft     ArticleStatus = "draft"
+	ArticleStatusPublished ArticleStatus = "published"
+	ArticleStatusArchived  ArticleStatus = "archived"
+)
+
+var articleStatusTransitions = map[ArticleStatus][]ArticleStatus{
+	ArticleStatusDraft:     {ArticleStatusPublished, ArticleStatusArchived},
+	ArticleStatusPublished: {ArticleStatusArchived},
+	ArticleStatusArchived:  {ArticleStatusPublished},
+}
+
+func (s ArticleStatus) CanTransitionTo(next ArticleStatus) bool {
+	for _, status := range articleStatusTransitions[s] {
+		if status == next {
+			return true
+		}
+	}
+	return false
+}
+
 type Article struct {
-	ID        uuid.UUID
-	Title     string
-	Body      string
-	CreatedAt time.Time
-	Author    Author
+	ID          uuid.UUID
+	Title       string
+	Slug        string
+	Body        string
+	Status      ArticleStatus
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	PublishedAt *time.Time
+	PublishAt   *time.Time
+	DeletedAt   *time.Time
+	Author      Author
+	Category    *Category
+	Tags        []string
+	Highlight   *ArticleHighlight
+}
+
+type ArticleHighlight struct {
+	Title []string
+	Body  []string
+}
+
+func (a Article) IsPublic() bool {
+	return a.Status == ArticleStatusPublished && a.DeletedAt == nil
+}
+
+func (a Article) CategoryID() *uuid.UUID {
+	if a.Category == nil {
+		return nil
+	}
+	return &a.Category.ID
 }
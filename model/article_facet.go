@@ -0,0 +1,19 @@
+package model
+
+const (
+	FacetAuthor = "author"
+	FacetMonth  = "month"
+	FacetTag    = "tag"
+)
+
+const FacetSize = 10
+
+const FacetMonthLayout = "2006-01"
+
+type ArticleFacets map[string][]FacetBucket
+
+type FacetBucket struct {
+	Value string
+	Label string
+	Count int64
+}
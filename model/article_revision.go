@@ -0,0 +1,37 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ArticleRevision struct {
+	ID        uuid.UUID
+	ArticleID uuid.UUID
+	Revision  int
+	Title     string
+	Body      string
+	AuthorID  uuid.UUID
+	CreatedAt time.Time
+}
+
+type DiffOp string
+
+const (
+	DiffOpEqual  DiffOp = "equal"
+	DiffOpInsert DiffOp = "insert"
+	DiffOpDelete DiffOp = "delete"
+)
+
+type DiffLine struct {
+	Op   DiffOp
+	Text string
+}
+
+type ArticleRevisionDiff struct {
+	From  int
+	To    int
+	Title []DiffLine
+	Body  []DiffLine
+}
@@ -0,0 +1,13 @@
+package model
+
+import "github.com/google/uuid"
+
+type ArticleSuggestions struct {
+	Titles  []TitleSuggestion
+	Authors []string
+}
+
+type TitleSuggestion struct {
+	ArticleID uuid.UUID
+	Title     string
+}
@@ -5,6 +5,10 @@ import (
 )
 
 type Author struct {
-	ID   uuid.UUID
-	Name string
+	ID        uuid.UUID
+	Name      string
+	Handle    string
+	Bio       string
+	AvatarURL string
+	Website   string
 }
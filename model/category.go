@@ -0,0 +1,11 @@
+package model
+
+import (
+	"github.com/google/uuid"
+)
+
+type Category struct {
+	ID       uuid.UUID
+	ParentID *uuid.UUID
+	Name     string
+}
@@ -0,0 +1,32 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type OutboxEventType string
+
+const OutboxEventArticleChanged OutboxEventType = "article_changed"
+
+type OutboxEventStatus string
+
+const (
+	OutboxEventStatusPending   OutboxEventStatus = "pending"
+	OutboxEventStatusDelivered OutboxEventStatus = "delivered"
+	OutboxEventStatusDead      OutboxEventStatus = "dead"
+)
+
+// OutboxEvent is written in the same transaction as the change it announces and delivered after commit
+type OutboxEvent struct {
+	ID          uuid.UUID
+	AggregateID uuid.UUID
+	EventType   OutboxEventType
+	Status      OutboxEventStatus
+	Attempts    int
+	LastError   string
+	AvailableAt time.Time
+	CreatedAt   time.Time
+	DeliveredAt *time.Time
+}
@@ -0,0 +1,27 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ReindexJobStatus string
+
+const (
+	ReindexJobStatusRunning  ReindexJobStatus = "running"
+	ReindexJobStatusFinished ReindexJobStatus = "finished"
+)
+
+// articles are copied in id order, so a crashed job resumes after LastArticleID
+type ReindexJob struct {
+	ID            uuid.UUID
+	IndexName     string
+	Status        ReindexJobStatus
+	LastArticleID uuid.UUID
+	IndexedCount  int64
+	TotalCount    int64
+	StartedAt     time.Time
+	UpdatedAt     time.Time
+	FinishedAt    *time.Time
+}
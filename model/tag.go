@@ -0,0 +1,27 @@
+package model
+
+import (
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+type Tag struct {
+	ID            uuid.UUID
+	Name          string
+	ArticlesCount int64
+}
+
+func NormalizeTags(tags []string) []string {
+	seen := map[string]bool{}
+	var normalized []string
+	for _, tag := range tags {
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if tag == "" || seen[tag] {
+			continue
+		}
+		seen[tag] = true
+		normalized = append(normalized, tag)
+	}
+	return normalized
+}
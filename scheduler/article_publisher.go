@@ -0,0 +1,73 @@
+package scheduler
+
+import (
+	"context"
+	"time"
+
+	"article-service/application"
+	"article-service/configloader"
+	"article-service/infrastructure/log"
+)
+
+const (
+	defaultPublishInterval  = 30 * time.Second
+	defaultPublishBatchSize = 50
+)
+
+type ArticlePublisher struct {
+	svc       application.IArticleService
+	interval  time.Duration
+	batchSize int
+}
+
+func NewArticlePublisher(cfg configloader.SchedulerConfig) ArticlePublisher {
+	publisher := ArticlePublisher{
+		svc:       application.GetArticleService(),
+		interval:  cfg.PublishInterval,
+		batchSize: cfg.PublishBatchSize,
+	}
+	if publisher.interval <= 0 {
+		publisher.interval = defaultPublishInterval
+	}
+	if publisher.batchSize <= 0 {
+		publisher.batchSize = defaultPublishBatchSize
+	}
+
+	return publisher
+}
+
+func (p ArticlePublisher) Run(ctx context.Context) {
+	log.Infof(ctx, "[ArticlePublisher] started, interval: %s, batch size: %d", p.interval, p.batchSize)
+
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		p.publishDue(ctx)
+
+		select {
+		case <-ctx.Done():
+			log.Infof(ctx, "[ArticlePublisher] stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (p ArticlePublisher) publishDue(ctx context.Context) {
+	for ctx.Err() == nil {
+		published, err := p.svc.PublishDueArticles(context.WithoutCancel(ctx), p.batchSize)
+		if err != nil {
+			log.Errorf(ctx, err, "[ArticlePublisher][publishDue] svc.PublishDueArticles is failed")
+			return
+		}
+
+		if published > 0 {
+			log.Infof(ctx, "[ArticlePublisher][publishDue] published %d articles", published)
+		}
+
+		if published < p.batchSize {
+			return
+		}
+	}
+}
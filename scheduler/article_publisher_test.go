@@ -0,0 +1,63 @@
+package scheduler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"article-service/application/mock_application"
+	"article-service/configloader"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_NewArticlePublisher_UseDefaults_WhenConfigIsEmpty(t *testing.T) {
+	publisher := NewArticlePublisher(configloader.SchedulerConfig{})
+
+	assert.Equal(t, defaultPublishInterval, publisher.interval)
+	assert.Equal(t, defaultPublishBatchSize, publisher.batchSize)
+}
+
+func Test_ArticlePublisher_Run_PublishUntilNoFullBatchLeft(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	svc := mock_application.NewMockIArticleService(ctrl)
+	gomock.InOrder(
+		svc.EXPECT().PublishDueArticles(gomock.Any(), 2).Return(2, nil),
+		svc.EXPECT().PublishDueArticles(gomock.Any(), 2).DoAndReturn(func(_ context.Context, _ int) (int, error) {
+			cancel()
+			return 1, nil
+		}),
+	)
+
+	publisher := ArticlePublisher{svc: svc, interval: time.Hour, batchSize: 2}
+	publisher.Run(ctx)
+}
+
+func Test_ArticlePublisher_Run_StopWhenContextCancelled(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	ctx, cancel := context.WithCancel(context.Background())
+
+	svc := mock_application.NewMockIArticleService(ctrl)
+	svc.EXPECT().PublishDueArticles(gomock.Any(), 2).Return(0, nil).MinTimes(1)
+
+	publisher := ArticlePublisher{svc: svc, interval: time.Millisecond, batchSize: 2}
+
+	done := make(chan struct{})
+	go func() {
+		publisher.Run(ctx)
+		close(done)
+	}()
+
+	time.Sleep(10 * time.Millisecond)
+	cancel()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("publisher did not stop after the context was cancelled")
+	}
+}
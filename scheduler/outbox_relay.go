@@ -0,0 +1,90 @@
+package scheduler
+
+import (
+	"context"
+	"time"
+
+	"article-service/application"
+	"article-service/configloader"
+	"article-service/infrastructure/log"
+)
+
+const (
+	defaultOutboxInterval  = 5 * time.Second
+	defaultOutboxBatchSize = 100
+	defaultOutboxRetention = 7 * 24 * time.Hour
+)
+
+type OutboxRelay struct {
+	svc       application.IOutboxService
+	interval  time.Duration
+	batchSize int
+	retention time.Duration
+}
+
+func NewOutboxRelay(cfg configloader.SchedulerConfig) OutboxRelay {
+	relay := OutboxRelay{
+		svc:       application.GetOutboxService(),
+		interval:  cfg.OutboxInterval,
+		batchSize: cfg.OutboxBatchSize,
+		retention: cfg.OutboxRetention,
+	}
+	if relay.interval <= 0 {
+		relay.interval = defaultOutboxInterval
+	}
+	if relay.batchSize <= 0 {
+		relay.batchSize = defaultOutboxBatchSize
+	}
+	if relay.retention <= 0 {
+		relay.retention = defaultOutboxRetention
+	}
+
+	return relay
+}
+
+func (r OutboxRelay) Run(ctx context.Context) {
+	log.Infof(ctx, "[OutboxRelay] started, interval: %s, batch size: %d, retention: %s", r.interval, r.batchSize, r.retention)
+
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		r.relayDue(ctx)
+		r.pruneFinished(ctx)
+
+		select {
+		case <-ctx.Done():
+			log.Infof(ctx, "[OutboxRelay] stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (r OutboxRelay) relayDue(ctx context.Context) {
+	for ctx.Err() == nil {
+		relayed, err := r.svc.RelayEvents(context.WithoutCancel(ctx), r.batchSize)
+		if err != nil {
+			log.Errorf(ctx, err, "[OutboxRelay][relayDue] svc.RelayEvents is failed")
+			return
+		}
+
+		if relayed < r.batchSize {
+			return
+		}
+	}
+}
+
+func (r OutboxRelay) pruneFinished(ctx context.Context) {
+	for ctx.Err() == nil {
+		pruned, err := r.svc.PruneEvents(context.WithoutCancel(ctx), r.retention, r.batchSize)
+		if err != nil {
+			log.Errorf(ctx, err, "[OutboxRelay][pruneFinished] svc.PruneEvents is failed")
+			return
+		}
+
+		if pruned < r.batchSize {
+			return
+		}
+	}
+}
@@ -0,0 +1,74 @@
+package scheduler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"article-service/apperror"
+	"article-service/application/mock_application"
+	"article-service/configloader"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_NewOutboxRelay_UseDefaults_WhenConfigIsEmpty(t *testing.T) {
+	relay := NewOutboxRelay(configloader.SchedulerConfig{})
+
+	assert.Equal(t, defaultOutboxInterval, relay.interval)
+	assert.Equal(t, defaultOutboxBatchSize, relay.batchSize)
+	assert.Equal(t, defaultOutboxRetention, relay.retention)
+}
+
+func Test_OutboxRelay_Run_RelayUntilNoFullBatchLeft(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	svc := mock_application.NewMockIOutboxService(ctrl)
+	gomock.InOrder(
+		svc.EXPECT().RelayEvents(gomock.Any(), 2).Return(2, nil),
+		svc.EXPECT().RelayEvents(gomock.Any(), 2).DoAndReturn(func(_ context.Context, _ int) (int, error) {
+			cancel()
+			return 0, nil
+		}),
+	)
+
+	relay := OutboxRelay{svc: svc, interval: time.Hour, batchSize: 2}
+	relay.Run(ctx)
+}
+
+func Test_OutboxRelay_Run_WaitForNextTick_WhenRelayFailed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	svc := mock_application.NewMockIOutboxService(ctrl)
+	svc.EXPECT().RelayEvents(gomock.Any(), 2).DoAndReturn(func(_ context.Context, _ int) (int, error) {
+		cancel()
+		return 0, apperror.ErrStartTransactionFailed
+	})
+
+	relay := OutboxRelay{svc: svc, interval: time.Hour, batchSize: 2}
+	relay.Run(ctx)
+}
+
+func Test_OutboxRelay_Run_PruneUntilNoFullBatchLeft(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	svc := mock_application.NewMockIOutboxService(ctrl)
+	gomock.InOrder(
+		svc.EXPECT().RelayEvents(gomock.Any(), 2).Return(0, nil),
+		svc.EXPECT().PruneEvents(gomock.Any(), 24*time.Hour, 2).Return(2, nil),
+		svc.EXPECT().PruneEvents(gomock.Any(), 24*time.Hour, 2).DoAndReturn(func(_ context.Context, _ time.Duration, _ int) (int, error) {
+			cancel()
+			return 1, nil
+		}),
+	)
+
+	relay := OutboxRelay{svc: svc, interval: time.Hour, batchSize: 2, retention: 24 * time.Hour}
+	relay.Run(ctx)
+}
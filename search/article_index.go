@@ -0,0 +1,162 @@
+package search
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"article-service/apperror"
+	"article-service/infrastructure/elasticsearch"
+	"article-service/infrastructure/log"
+	"article-service/model"
+
+	"github.com/olivere/elastic/v7"
+)
+
+// ArticleIndexVersion is bumped whenever articleIndexBody changes
+const ArticleIndexVersion = 4
+
+// spell sub-fields keep the unstemmed words the term suggesters correct queries with
+const articleIndexBody = `{
+	"settings": {
+		"analysis": {
+			"filter": {
+				"indonesian_stop": {
+					"type": "stop",
+					"stopwords": "_indonesian_"
+				},
+				"indonesian_stemmer": {
+					"type": "stemmer",
+					"language": "indonesian"
+				}
+			},
+			"analyzer": {
+				"indonesian_text": {
+					"tokenizer": "standard",
+					"filter": ["lowercase", "asciifolding", "indonesian_stop", "indonesian_stemmer"]
+				},
+				"suggest_text": {
+					"tokenizer": "standard",
+					"filter": ["lowercase", "asciifolding"]
+				}
+			},
+			"normalizer": {
+				"lowercase_sort": {
+					"type": "custom",
+					"filter": ["lowercase", "asciifolding"]
+				}
+			}
+		}
+	},
+	"mappings": {
+		"dynamic": "strict",
+		"properties": {
+			"id": {"type": "keyword"},
+			"title": {
+				"type": "text",
+				"analyzer": "indonesian_text",
+				"fields": {
+					"keyword": {"type": "keyword", "normalizer": "lowercase_sort", "ignore_above": 256},
+					"suggest": {"type": "completion", "analyzer": "suggest_text"},
+					"spell": {"type": "text", "analyzer": "suggest_text"}
+				}
+			},
+			"body": {
+				"type": "text",
+				"analyzer": "indonesian_text",
+				"fields": {
+					"spell": {"type": "text", "analyzer": "suggest_text"}
+				}
+			},
+			"author_id": {"type": "keyword"},
+			"author_name": {
+				"type": "text",
+				"analyzer": "standard",
+				"fields": {
+					"keyword": {"type": "keyword", "normalizer": "lowercase_sort", "ignore_above": 256},
+					"suggest": {"type": "completion", "analyzer": "suggest_text"}
+				}
+			},
+			"category_id": {"type": "keyword"},
+			"tags": {"type": "keyword", "normalizer": "lowercase_sort"},
+			"created_at": {"type": "date"}
+		}
+	}
+}`
+
+func ArticleIndexName(version int) string {
+	return fmt.Sprintf("%s_v%d", model.ArticleIndex, version)
+}
+
+// NewArticleIndexName is unique per reindex, the alias keeps serving the old index meanwhile
+func NewArticleIndexName(now time.Time) string {
+	return fmt.Sprintf("%s_%s", ArticleIndexName(ArticleIndexVersion), now.UTC().Format("20060102150405"))
+}
+
+func isArticleIndexVersion(name string, version int) bool {
+	versioned := ArticleIndexName(version)
+	return name == versioned || strings.HasPrefix(name, versioned+"_")
+}
+
+// EnsureArticleIndex leaves an alias pointing at an older version alone, only reindexing moves it
+func EnsureArticleIndex(ctx context.Context) error {
+	client := elasticsearch.GetElasticInstance().Client
+	indexName := ArticleIndexName(ArticleIndexVersion)
+
+	aliases, err := client.Aliases().Do(ctx)
+	if err != nil {
+		log.Errorf(ctx, err, "[ArticleIndex][EnsureArticleIndex] Aliases is failed")
+		return apperror.ErrSetupElasticFailed
+	}
+
+	if current := aliases.IndicesByAlias(model.ArticleIndex); len(current) > 0 {
+		if !slices.ContainsFunc(current, func(name string) bool { return isArticleIndexVersion(name, ArticleIndexVersion) }) {
+			log.Errorf(ctx, apperror.ErrElasticIndexOutdated, "[ArticleIndex][EnsureArticleIndex] alias %s points at %v, reindex to move it to %s", model.ArticleIndex, current, indexName)
+			return apperror.ErrElasticIndexOutdated
+		}
+		return nil
+	}
+
+	// an articles index of the releases before aliases holds documents of an unknown mapping,
+	// reindexing rebuilds them from PostgreSQL and drops it when it moves the alias
+	legacyExists, err := client.IndexExists(model.ArticleIndex).Do(ctx)
+	if err != nil {
+		log.Errorf(ctx, err, "[ArticleIndex][EnsureArticleIndex] IndexExists is failed, index: %s", model.ArticleIndex)
+		return apperror.ErrSetupElasticFailed
+	}
+	if legacyExists {
+		log.Errorf(ctx, apperror.ErrElasticIndexOutdated, "[ArticleIndex][EnsureArticleIndex] index %s predates the alias, reindex to replace it with %s", model.ArticleIndex, indexName)
+		return apperror.ErrElasticIndexOutdated
+	}
+
+	exists, err := client.IndexExists(indexName).Do(ctx)
+	if err != nil {
+		log.Errorf(ctx, err, "[ArticleIndex][EnsureArticleIndex] IndexExists is failed, index: %s", indexName)
+		return apperror.ErrSetupElasticFailed
+	}
+	if !exists {
+		_, err = client.CreateIndex(indexName).BodyString(articleIndexBody).Do(ctx)
+		// another replica starting at the same time may have created it first
+		if err != nil && !isIndexAlreadyExists(err) {
+			log.Errorf(ctx, err, "[ArticleIndex][EnsureArticleIndex] CreateIndex is failed, index: %s", indexName)
+			return apperror.ErrSetupElasticFailed
+		}
+	}
+
+	_, err = client.Alias().Add(indexName, model.ArticleIndex).Do(ctx)
+	if err != nil {
+		log.Errorf(ctx, err, "[ArticleIndex][EnsureArticleIndex] Alias is failed, index: %s, alias: %s", indexName, model.ArticleIndex)
+		return apperror.ErrSetupElasticFailed
+	}
+
+	log.Infof(ctx, "[ArticleIndex][EnsureArticleIndex] created index %s behind alias %s", indexName, model.ArticleIndex)
+	return nil
+}
+
+func isIndexAlreadyExists(err error) bool {
+	var elasticErr *elastic.Error
+	return errors.As(err, &elasticErr) && elasticErr.Details != nil && elasticErr.Details.Type == "resource_already_exists_exception"
+}
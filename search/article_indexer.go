@@ -0,0 +1,13 @@
+package search
+
+import (
+	"article-service/model"
+	"context"
+)
+
+//go:generate mockgen -source=article_indexer.go -destination=./mock_search/article_indexer_mock.go
+type IArticleIndexer interface {
+	CreateIndex(ctx context.Context, name string) error
+	BulkIndex(ctx context.Context, name string, articles []*model.Article) error
+	SwapAlias(ctx context.Context, name string) error
+}
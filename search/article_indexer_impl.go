@@ -0,0 +1,149 @@
+package search
+
+import (
+	"article-service/apperror"
+	"article-service/infrastructure/elasticsearch"
+	"article-service/infrastructure/log"
+	"article-service/model"
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+
+	"github.com/olivere/elastic/v7"
+)
+
+const (
+	bulkWorkers = 2
+	bulkActions = 250
+)
+
+type ArticleIndexer struct {
+	Client *elastic.Client
+}
+
+func newElasticArticleIndexer() IArticleIndexer {
+	return ArticleIndexer{
+		Client: elasticsearch.GetElasticInstance().Client,
+	}
+}
+
+func (s ArticleIndexer) CreateIndex(ctx context.Context, name string) error {
+	exists, err := s.Client.IndexExists(name).Do(ctx)
+	if err != nil {
+		log.Errorf(ctx, err, "[ArticleIndexer][CreateIndex] IndexExists is failed, index: %s", name)
+		return apperror.ErrSetupElasticFailed
+	}
+	if exists {
+		return nil
+	}
+
+	_, err = s.Client.CreateIndex(name).BodyString(articleIndexBody).Do(ctx)
+	if err != nil {
+		log.Errorf(ctx, err, "[ArticleIndexer][CreateIndex] CreateIndex is failed, index: %s", name)
+		return apperror.ErrSetupElasticFailed
+	}
+
+	return nil
+}
+
+// BulkIndex returns once every request was committed, so the batch is safe to record as done
+func (s ArticleIndexer) BulkIndex(ctx context.Context, name string, articles []*model.Article) error {
+	var mu sync.Mutex
+	var failures []error
+	processor, err := s.Client.BulkProcessor().
+		Name("article-reindex").
+		Workers(bulkWorkers).
+		BulkActions(bulkActions).
+		After(func(_ int64, _ []elastic.BulkableRequest, res *elastic.BulkResponse, err error) {
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				failures = append(failures, err)
+				return
+			}
+			for _, item := range res.Failed() {
+				if item.Status == http.StatusNotFound {
+					continue
+				}
+				failures = append(failures, &elastic.Error{Status: item.Status, Details: item.Error})
+			}
+		}).
+		Do(ctx)
+	if err != nil {
+		log.Errorf(ctx, err, "[ArticleIndexer][BulkIndex] BulkProcessor is failed, index: %s", name)
+		return apperror.ErrIndexElasticFailed
+	}
+
+	for _, article := range articles {
+		id := article.ID.String()
+		if article.IsPublic() {
+			processor.Add(elastic.NewBulkIndexRequest().Index(name).Id(id).Doc(newArticleSearchDoc(*article)))
+		} else {
+			processor.Add(elastic.NewBulkDeleteRequest().Index(name).Id(id))
+		}
+	}
+
+	if err = processor.Close(); err != nil {
+		log.Errorf(ctx, err, "[ArticleIndexer][BulkIndex] Close is failed, index: %s", name)
+		return apperror.ErrIndexElasticFailed
+	}
+
+	if len(failures) > 0 {
+		log.Errorf(ctx, errors.Join(failures...), "[ArticleIndexer][BulkIndex] Bulk requests failed, index: %s", name)
+		return apperror.ErrIndexElasticFailed
+	}
+
+	return nil
+}
+
+// SwapAlias sends both alias changes in one request, so searches never see the alias missing
+func (s ArticleIndexer) SwapAlias(ctx context.Context, name string) error {
+	_, err := s.Client.Refresh(name).Do(ctx)
+	if err != nil {
+		log.Errorf(ctx, err, "[ArticleIndexer][SwapAlias] Refresh is failed, index: %s", name)
+		return apperror.ErrSetupElasticFailed
+	}
+
+	aliases, err := s.Client.Aliases().Do(ctx)
+	if err != nil {
+		log.Errorf(ctx, err, "[ArticleIndexer][SwapAlias] Aliases is failed")
+		return apperror.ErrSetupElasticFailed
+	}
+
+	var previous []string
+	service := s.Client.Alias().Add(name, model.ArticleIndex)
+	current := aliases.IndicesByAlias(model.ArticleIndex)
+	for _, index := range current {
+		if index != name {
+			previous = append(previous, index)
+			service = service.Remove(index, model.ArticleIndex)
+		}
+	}
+	if len(current) == 0 {
+		legacyExists, err := s.Client.IndexExists(model.ArticleIndex).Do(ctx)
+		if err != nil {
+			log.Errorf(ctx, err, "[ArticleIndexer][SwapAlias] IndexExists is failed, index: %s", model.ArticleIndex)
+			return apperror.ErrSetupElasticFailed
+		}
+		// the alias can only take the name of the legacy index by removing it in the same request
+		if legacyExists {
+			service = service.Action(elastic.NewAliasRemoveIndexAction(model.ArticleIndex))
+		}
+	}
+
+	_, err = service.Do(ctx)
+	if err != nil {
+		log.Errorf(ctx, err, "[ArticleIndexer][SwapAlias] Alias is failed, index: %s, alias: %s", name, model.ArticleIndex)
+		return apperror.ErrSetupElasticFailed
+	}
+
+	if len(previous) > 0 {
+		_, err = s.Client.DeleteIndex(previous...).Do(ctx)
+		if err != nil {
+			log.Errorf(ctx, err, "[ArticleIndexer][SwapAlias] DeleteIndex is failed, indices: %v", previous)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,35 @@
+package search
+
+import (
+	"context"
+
+	"article-service/model"
+)
+
+type ArticleIndexerInPlace struct {
+	search IArticleSearch
+}
+
+func (s ArticleIndexerInPlace) CreateIndex(ctx context.Context, name string) error {
+	return nil
+}
+
+func (s ArticleIndexerInPlace) BulkIndex(ctx context.Context, name string, articles []*model.Article) error {
+	for _, article := range articles {
+		var err error
+		if article.IsPublic() {
+			err = s.search.Index(ctx, *article)
+		} else {
+			err = s.search.Delete(ctx, article.ID)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s ArticleIndexerInPlace) SwapAlias(ctx context.Context, name string) error {
+	return nil
+}
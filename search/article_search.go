@@ -3,6 +3,7 @@ package search
 import (
 	"article-service/model"
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -10,5 +11,43 @@ import (
 //go:generate mockgen -source=article_search.go -destination=./mock_search/article_search_mock.go
 type IArticleSearch interface {
 	Index(ctx context.Context, article model.Article) error
-	Search(ctx context.Context, query string) ([]uuid.UUID, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+	Search(ctx context.Context, params SearchParams) (SearchResult, error)
+	Suggest(ctx context.Context, prefix string, size int) (model.ArticleSuggestions, error)
+	CorrectQuery(ctx context.Context, params SearchParams) (string, error)
+	Related(ctx context.Context, article model.Article, size int) ([]uuid.UUID, error)
+}
+
+var relatedFields = []string{"title", "body", "tags"}
+
+type SearchParams struct {
+	Query         string
+	IDs           []uuid.UUID
+	Filter        SearchFilter
+	Fuzzy         bool
+	SortBy        string
+	SortDirection string
+	Highlight     bool
+	Facets        []string
+	Limit         int
+	Offset        int
+}
+
+// SearchFilter mirrors repository.ArticleFilter, CategoryIDs includes the descendants of the category
+type SearchFilter struct {
+	CreatedFrom      time.Time
+	CreatedTo        time.Time
+	AuthorIDs        []uuid.UUID
+	ExcludeAuthorIDs []uuid.UUID
+	AuthorName       string
+	CategoryIDs      []uuid.UUID
+	Tags             []string
+	MatchAllTags     bool
+}
+
+type SearchResult struct {
+	IDs        []uuid.UUID
+	Total      int64
+	Highlights map[uuid.UUID]model.ArticleHighlight
+	Facets     model.ArticleFacets
 }
@@ -2,39 +2,68 @@ package search
 
 import (
 	"article-service/apperror"
+	"article-service/configloader"
 	"article-service/infrastructure/elasticsearch"
 	"article-service/infrastructure/log"
 	"article-service/model"
 	"context"
 	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/olivere/elastic/v7"
 )
 
+// MaxResultWindow is Elasticsearch's default index.max_result_window
+const MaxResultWindow = 10000
+
+var elasticSortFields = map[string]string{
+	"created_at":  "created_at",
+	"title":       "title.keyword",
+	"author_name": "author_name.keyword",
+}
+
 type ArticleSearch struct {
-	Client *elastic.Client
+	Client    *elastic.Client
+	Highlight configloader.HighlightConfig
 }
 
 type ArticleSearchDoc struct {
-	ID    uuid.UUID `json:"id"`
-	Title string    `json:"title"`
-	Body  string    `json:"body"`
+	ID         uuid.UUID  `json:"id"`
+	Title      string     `json:"title"`
+	Body       string     `json:"body"`
+	AuthorID   uuid.UUID  `json:"author_id"`
+	AuthorName string     `json:"author_name"`
+	CategoryID *uuid.UUID `json:"category_id"`
+	Tags       []string   `json:"tags"`
+	CreatedAt  time.Time  `json:"created_at"`
 }
 
-func GetArticleSearch() IArticleSearch {
+func newElasticArticleSearch() IArticleSearch {
 	instance := elasticsearch.GetElasticInstance()
 	return ArticleSearch{
-		Client: instance.Client,
+		Client:    instance.Client,
+		Highlight: highlightWithDefaults(instance.Highlight),
 	}
 }
 
-func (s ArticleSearch) Index(ctx context.Context, article model.Article) error {
-	doc := ArticleSearchDoc{
-		ID:    article.ID,
-		Title: article.Title,
-		Body:  article.Body,
+func newArticleSearchDoc(article model.Article) ArticleSearchDoc {
+	return ArticleSearchDoc{
+		ID:         article.ID,
+		Title:      article.Title,
+		Body:       article.Body,
+		AuthorID:   article.Author.ID,
+		AuthorName: article.Author.Name,
+		CategoryID: article.CategoryID(),
+		Tags:       article.Tags,
+		CreatedAt:  article.CreatedAt,
 	}
+}
+
+func (s ArticleSearch) Index(ctx context.Context, article model.Article) error {
+	doc := newArticleSearchDoc(article)
 	_, err := s.Client.Index().
 		Index(model.ArticleIndex).
 		Id(article.ID.String()).
@@ -49,24 +78,364 @@ func (s ArticleSearch) Index(ctx context.Context, article model.Article) error {
 	return nil
 }
 
-func (s ArticleSearch) Search(ctx context.Context, query string) ([]uuid.UUID, error) {
+func (s ArticleSearch) Delete(ctx context.Context, id uuid.UUID) error {
+	_, err := s.Client.Delete().
+		Index(model.ArticleIndex).
+		Id(id.String()).
+		Do(ctx)
+
+	if err != nil && !elastic.IsNotFound(err) {
+		log.Errorf(ctx, err, "[ArticleSearch][Delete] Delete is failed, index: %s, id: %s", model.ArticleIndex, id)
+		return apperror.ErrDeleteElasticFailed
+	}
+
+	return nil
+}
+
+func (s ArticleSearch) Search(ctx context.Context, params SearchParams) (SearchResult, error) {
+	// Elasticsearch rejects pages past its result window, those pages are empty
+	// but the search still runs to report the total
+	from, size := params.Offset, params.Limit
+	if from+size > MaxResultWindow {
+		size = max(MaxResultWindow-from, 0)
+	}
+	if size == 0 {
+		from = 0
+	}
+
+	query := buildQuery(ParseQuery(params.Query), params.Fuzzy)
+	if len(params.IDs) > 0 {
+		ids := make([]string, len(params.IDs))
+		for i, id := range params.IDs {
+			ids[i] = id.String()
+		}
+		query = query.Filter(elastic.NewIdsQuery().Ids(ids...))
+	}
+	query = filterQuery(query, params.Filter)
+
+	service := s.Client.Search().
+		Index(model.ArticleIndex).
+		Query(query).
+		From(from).
+		Size(size).
+		TrackTotalHits(true).
+		FetchSource(false)
+	if field, ok := elasticSortFields[params.SortBy]; ok {
+		service = service.Sort(field, params.SortDirection == "asc").Sort("id", true)
+	} else {
+		service = service.Sort("_score", false)
+	}
+	if params.Highlight {
+		service = service.Highlight(s.highlighter())
+	}
+	for _, facet := range params.Facets {
+		if aggregation := facetAggregation(facet); aggregation != nil {
+			service = service.Aggregation(facet, aggregation)
+		}
+	}
+
+	res, err := service.Do(ctx)
+	if err != nil {
+		log.Errorf(ctx, err, "[ArticleSearch][Search] Search is failed, query: %s", params.Query)
+		return SearchResult{}, apperror.ErrSearchElasticFailed
+	}
+
+	result := SearchResult{Total: res.TotalHits()}
+	if params.Highlight {
+		result.Highlights = map[uuid.UUID]model.ArticleHighlight{}
+	}
+	if len(params.Facets) > 0 {
+		result.Facets = facetBuckets(ctx, res.Aggregations, params.Facets)
+	}
+	for _, hit := range res.Hits.Hits {
+		id, err := uuid.Parse(hit.Id)
+		if err != nil {
+			log.Errorf(ctx, err, "[ArticleSearch][Search] Invalid document id, id: %s", hit.Id)
+			continue
+		}
+		result.IDs = append(result.IDs, id)
+
+		if params.Highlight {
+			result.Highlights[id] = model.ArticleHighlight{
+				Title: hit.Highlight["title"],
+				Body:  hit.Highlight["body"],
+			}
+		}
+	}
+
+	return result, nil
+}
+
+func (s ArticleSearch) Suggest(ctx context.Context, prefix string, size int) (model.ArticleSuggestions, error) {
+	fuzzy := elastic.NewFuzzyCompletionSuggesterOptions().EditDistance("AUTO")
+	titles := elastic.NewCompletionSuggester("titles").
+		Field("title.suggest").
+		Prefix(prefix).
+		FuzzyOptions(fuzzy).
+		Size(size)
+	authors := elastic.NewCompletionSuggester("authors").
+		Field("author_name.suggest").
+		Prefix(prefix).
+		FuzzyOptions(fuzzy).
+		SkipDuplicates(true).
+		Size(size)
+
 	res, err := s.Client.Search().
 		Index(model.ArticleIndex).
-		Query(elastic.NewMultiMatchQuery(query, "title", "body")).
-		Sort("_score", false).
+		Suggester(titles).
+		Suggester(authors).
+		FetchSource(false).
 		Do(ctx)
+	if err != nil {
+		log.Errorf(ctx, err, "[ArticleSearch][Suggest] Search is failed, prefix: %s", prefix)
+		return model.ArticleSuggestions{}, apperror.ErrSearchElasticFailed
+	}
+
+	suggestions := model.ArticleSuggestions{Titles: []model.TitleSuggestion{}, Authors: []string{}}
+	for _, suggestion := range res.Suggest["titles"] {
+		for _, option := range suggestion.Options {
+			id, err := uuid.Parse(option.Id)
+			if err != nil {
+				log.Errorf(ctx, err, "[ArticleSearch][Suggest] Invalid document id, id: %s", option.Id)
+				continue
+			}
+			suggestions.Titles = append(suggestions.Titles, model.TitleSuggestion{ArticleID: id, Title: option.Text})
+		}
+	}
+	for _, suggestion := range res.Suggest["authors"] {
+		for _, option := range suggestion.Options {
+			suggestions.Authors = append(suggestions.Authors, option.Text)
+		}
+	}
+
+	return suggestions, nil
+}
+
+// Related passes the article as an artificial document, so it does not need to be indexed
+func (s ArticleSearch) Related(ctx context.Context, article model.Article, size int) ([]uuid.UUID, error) {
+	doc := newArticleSearchDoc(article)
+	like := elastic.NewMoreLikeThisQueryItem().
+		Index(model.ArticleIndex).
+		Doc(map[string]interface{}{"title": doc.Title, "body": doc.Body, "tags": doc.Tags})
+
+	// every word counts, the defaults ignore words seen less than twice or in fewer than 5 articles
+	query := elastic.NewBoolQuery().
+		Must(elastic.NewMoreLikeThisQuery().
+			Field(relatedFields...).
+			LikeItems(like).
+			MinTermFreq(1).
+			MinDocFreq(1)).
+		MustNot(elastic.NewIdsQuery().Ids(article.ID.String()))
 
+	res, err := s.Client.Search().
+		Index(model.ArticleIndex).
+		Query(query).
+		Sort("_score", false).
+		Size(size).
+		FetchSource(false).
+		Do(ctx)
 	if err != nil {
-		log.Errorf(ctx, err, "[ArticleSearch][Search] Search is failed, query: %s", query)
+		log.Errorf(ctx, err, "[ArticleSearch][Related] Search is failed, id: %s", article.ID)
 		return nil, apperror.ErrSearchElasticFailed
 	}
 
 	var ids []uuid.UUID
 	for _, hit := range res.Hits.Hits {
-		var a model.Article
-		json.Unmarshal(hit.Source, &a)
-		ids = append(ids, a.ID)
+		id, err := uuid.Parse(hit.Id)
+		if err != nil {
+			log.Errorf(ctx, err, "[ArticleSearch][Related] Invalid document id, id: %s", hit.Id)
+			continue
+		}
+		ids = append(ids, id)
 	}
 
 	return ids, nil
 }
+
+var spellSuggesters = []struct{ name, field string }{
+	{name: "title", field: "title.spell"},
+	{name: "body", field: "body.spell"},
+	{name: "author_name", field: "author_name"},
+}
+
+func (s ArticleSearch) CorrectQuery(ctx context.Context, params SearchParams) (string, error) {
+	clauses := ParseQuery(params.Query)
+	words := queryWords(clauses)
+	if len(words) == 0 {
+		return "", nil
+	}
+
+	service := s.Client.Search().
+		Index(model.ArticleIndex).
+		Size(0).
+		FetchSource(false)
+	for _, suggester := range spellSuggesters {
+		service = service.Suggester(elastic.NewTermSuggester(suggester.name).
+			Text(strings.Join(words, " ")).
+			Field(suggester.field).
+			SuggestMode("missing").
+			Size(1))
+	}
+
+	res, err := service.Do(ctx)
+	if err != nil {
+		log.Errorf(ctx, err, "[ArticleSearch][CorrectQuery] Search is failed, query: %s", params.Query)
+		return "", apperror.ErrSearchElasticFailed
+	}
+
+	best := map[string]elastic.SearchSuggestionOption{}
+	for _, suggester := range spellSuggesters {
+		for _, suggestion := range res.Suggest[suggester.name] {
+			for _, option := range suggestion.Options {
+				current, found := best[suggestion.Text]
+				if !found || option.Score > current.Score || option.Score == current.Score && option.Freq > current.Freq {
+					best[suggestion.Text] = option
+				}
+			}
+		}
+	}
+
+	corrections := map[string]string{}
+	for word, option := range best {
+		corrections[word] = option.Text
+	}
+	return correctQuery(ctx, s, params, clauses, corrections)
+}
+
+func filterQuery(query *elastic.BoolQuery, filter SearchFilter) *elastic.BoolQuery {
+	if !filter.CreatedFrom.IsZero() || !filter.CreatedTo.IsZero() {
+		createdAt := elastic.NewRangeQuery("created_at")
+		if !filter.CreatedFrom.IsZero() {
+			createdAt = createdAt.Gte(filter.CreatedFrom.Format(time.RFC3339Nano))
+		}
+		if !filter.CreatedTo.IsZero() {
+			createdAt = createdAt.Lte(filter.CreatedTo.Format(time.RFC3339Nano))
+		}
+		query = query.Filter(createdAt)
+	}
+	if len(filter.AuthorIDs) > 0 {
+		query = query.Filter(elastic.NewTermsQuery("author_id", uuidValues(filter.AuthorIDs)...))
+	}
+	if len(filter.ExcludeAuthorIDs) > 0 {
+		query = query.MustNot(elastic.NewTermsQuery("author_id", uuidValues(filter.ExcludeAuthorIDs)...))
+	}
+	if filter.AuthorName != "" {
+		pattern := "*" + wildcardEscaper.Replace(filter.AuthorName) + "*"
+		query = query.Filter(elastic.NewWildcardQuery("author_name.keyword", pattern).CaseInsensitive(true))
+	}
+	if len(filter.CategoryIDs) > 0 {
+		query = query.Filter(elastic.NewTermsQuery("category_id", uuidValues(filter.CategoryIDs)...))
+	}
+	if len(filter.Tags) > 0 && filter.MatchAllTags {
+		for _, tag := range filter.Tags {
+			query = query.Filter(elastic.NewTermQuery("tags", tag))
+		}
+	} else if len(filter.Tags) > 0 {
+		tags := make([]interface{}, len(filter.Tags))
+		for i, tag := range filter.Tags {
+			tags[i] = tag
+		}
+		query = query.Filter(elastic.NewTermsQuery("tags", tags...))
+	}
+	return query
+}
+
+var wildcardEscaper = strings.NewReplacer(`\`, `\\`, "*", `\*`, "?", `\?`)
+
+func uuidValues(ids []uuid.UUID) []interface{} {
+	values := make([]interface{}, len(ids))
+	for i, id := range ids {
+		values[i] = id.String()
+	}
+	return values
+}
+
+func (s ArticleSearch) highlighter() *elastic.Highlight {
+	return elastic.NewHighlight().
+		PreTags(s.Highlight.PreTag).
+		PostTags(s.Highlight.PostTag).
+		Fields(
+			elastic.NewHighlighterField("title").NumOfFragments(0),
+			elastic.NewHighlighterField("body").
+				FragmentSize(s.Highlight.FragmentSize).
+				NumOfFragments(s.Highlight.NumberOfFragments),
+		)
+}
+
+func facetAggregation(facet string) elastic.Aggregation {
+	switch facet {
+	case model.FacetAuthor:
+		authorName := elastic.NewTopHitsAggregation().
+			Size(1).
+			FetchSourceContext(elastic.NewFetchSourceContext(true).Include("author_name"))
+		return elastic.NewTermsAggregation().
+			Field("author_id").
+			Size(model.FacetSize).
+			SubAggregation("author_name", authorName)
+	case model.FacetMonth:
+		return elastic.NewDateHistogramAggregation().
+			Field("created_at").
+			CalendarInterval("month").
+			Format("yyyy-MM").
+			TimeZone("UTC").
+			MinDocCount(1).
+			OrderByKeyDesc()
+	case model.FacetTag:
+		return elastic.NewTermsAggregation().
+			Field("tags").
+			Size(model.FacetSize)
+	default:
+		return nil
+	}
+}
+
+func facetBuckets(ctx context.Context, aggregations elastic.Aggregations, facets []string) model.ArticleFacets {
+	result := model.ArticleFacets{}
+	for _, facet := range facets {
+		buckets := []model.FacetBucket{}
+
+		switch facet {
+		case model.FacetAuthor, model.FacetTag:
+			terms, found := aggregations.Terms(facet)
+			if !found {
+				break
+			}
+			for _, termsBucket := range terms.Buckets {
+				bucket := model.FacetBucket{Value: fmt.Sprint(termsBucket.Key), Count: termsBucket.DocCount}
+				if facet == model.FacetAuthor {
+					bucket.Label = facetAuthorName(ctx, termsBucket)
+				}
+				buckets = append(buckets, bucket)
+			}
+		case model.FacetMonth:
+			histogram, found := aggregations.DateHistogram(facet)
+			if !found {
+				break
+			}
+			for _, histogramBucket := range histogram.Buckets {
+				if histogramBucket.KeyAsString == nil {
+					continue
+				}
+				buckets = append(buckets, model.FacetBucket{Value: *histogramBucket.KeyAsString, Count: histogramBucket.DocCount})
+			}
+		}
+
+		result[facet] = buckets
+	}
+
+	return result
+}
+
+func facetAuthorName(ctx context.Context, bucket *elastic.AggregationBucketKeyItem) string {
+	topHits, found := bucket.TopHits("author_name")
+	if !found || topHits.Hits == nil || len(topHits.Hits.Hits) == 0 {
+		return ""
+	}
+
+	var doc ArticleSearchDoc
+	if err := json.Unmarshal(topHits.Hits.Hits[0].Source, &doc); err != nil {
+		log.Errorf(ctx, err, "[ArticleSearch][facetAuthorName] Invalid author hit, author_id: %v", bucket.Key)
+		return ""
+	}
+	return doc.AuthorName
+}
@@ -0,0 +1,548 @@
+package search
+
+import (
+	"cmp"
+	"context"
+	"maps"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"article-service/configloader"
+	"article-service/db/repository"
+	"article-service/infrastructure/log"
+	"article-service/model"
+
+	"github.com/google/uuid"
+)
+
+// memoryRelatedMaxTerms is the default max_query_terms of more_like_this
+const memoryRelatedMaxTerms = 25
+
+const memorySeedBatchSize = 500
+
+// ArticleSearchMemory is lost on restart and every replica has its own, so it only suits tests and local runs
+type ArticleSearchMemory struct {
+	Highlight configloader.HighlightConfig
+
+	mu    sync.RWMutex
+	index memoryIndex
+}
+
+func NewArticleSearchMemory(ctx context.Context, highlight configloader.HighlightConfig, articleRepo repository.IArticleRepository) (*ArticleSearchMemory, error) {
+	s := newArticleSearchMemory(highlight)
+
+	afterID := uuid.Nil
+	for {
+		articles, err := articleRepo.ListForIndexing(ctx, afterID, time.Time{}, memorySeedBatchSize)
+		if err != nil {
+			log.Errorf(ctx, err, "[ArticleSearchMemory][NewArticleSearchMemory] articleRepo.ListForIndexing is failed, afterID: %s", afterID)
+			return nil, err
+		}
+
+		for _, article := range articles {
+			if article.IsPublic() {
+				s.Index(ctx, *article)
+			}
+		}
+
+		if len(articles) < memorySeedBatchSize {
+			return s, nil
+		}
+		afterID = articles[len(articles)-1].ID
+	}
+}
+
+func newArticleSearchMemory(highlight configloader.HighlightConfig) *ArticleSearchMemory {
+	return &ArticleSearchMemory{
+		Highlight: highlight,
+		index:     newMemoryIndex(),
+	}
+}
+
+func (s *ArticleSearchMemory) Index(ctx context.Context, article model.Article) error {
+	document := memoryDocument{
+		Title:      article.Title,
+		Body:       article.Body,
+		AuthorID:   article.Author.ID,
+		AuthorName: article.Author.Name,
+		Tags:       article.Tags,
+		CreatedAt:  article.CreatedAt,
+		Tokens: map[string][]memoryToken{
+			"title":       tokenize(article.Title, 0),
+			"body":        tokenize(article.Body, 0),
+			"author_name": tokenize(article.Author.Name, 0),
+		},
+	}
+	position := 0
+	for _, tag := range article.Tags {
+		tokens := tokenize(tag, position)
+		document.Tokens["tags"] = append(document.Tokens["tags"], tokens...)
+		position += len(tokens) + memoryTagPositionGap
+	}
+
+	if article.Category != nil {
+		document.CategoryID = article.Category.ID
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.index.add(article.ID, document)
+
+	return nil
+}
+
+func (s *ArticleSearchMemory) Delete(ctx context.Context, id uuid.UUID) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.index.remove(id)
+
+	return nil
+}
+
+func (s *ArticleSearchMemory) Search(ctx context.Context, params SearchParams) (SearchResult, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	clauses := ParseQuery(params.Query)
+	var expansions map[string][]string
+	if params.Fuzzy {
+		expansions = s.index.fuzzyTerms(fuzzyWords(clauses))
+	}
+	hits := s.match(clauses, expansions, params.IDs, params.Filter)
+	if params.SortBy != "" {
+		s.sortHitsBy(hits, params.SortBy, params.SortDirection)
+	}
+
+	result := SearchResult{Total: int64(len(hits))}
+	if params.Highlight {
+		result.Highlights = map[uuid.UUID]model.ArticleHighlight{}
+	}
+	if len(params.Facets) > 0 {
+		result.Facets = s.countFacets(hits, params.Facets)
+	}
+	if params.Limit <= 0 || params.Offset >= len(hits) {
+		return result, nil
+	}
+
+	highlightTerms := positiveTerms(clauses, expansions)
+	for _, hit := range hits[params.Offset:min(params.Offset+params.Limit, len(hits))] {
+		result.IDs = append(result.IDs, hit.id)
+
+		if params.Highlight {
+			result.Highlights[hit.id] = s.highlight(hit.id, highlightTerms)
+		}
+	}
+
+	return result, nil
+}
+
+func (s *ArticleSearchMemory) Suggest(ctx context.Context, prefix string, size int) (model.ArticleSuggestions, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	type completion struct {
+		id       uuid.UUID
+		text     string
+		distance int
+	}
+	var titles, authors []completion
+	seenAuthors := map[string]bool{}
+	for id, document := range s.index.documents {
+		if distance, ok := fuzzyPrefixDistance(prefix, document.Title); ok {
+			titles = append(titles, completion{id: id, text: document.Title, distance: distance})
+		}
+		if seenAuthors[document.AuthorName] {
+			continue
+		}
+		seenAuthors[document.AuthorName] = true
+		if distance, ok := fuzzyPrefixDistance(prefix, document.AuthorName); ok {
+			authors = append(authors, completion{text: document.AuthorName, distance: distance})
+		}
+	}
+
+	for _, completions := range [][]completion{titles, authors} {
+		sort.Slice(completions, func(i, j int) bool {
+			if completions[i].distance != completions[j].distance {
+				return completions[i].distance < completions[j].distance
+			}
+			if completions[i].text != completions[j].text {
+				return completions[i].text < completions[j].text
+			}
+			return completions[i].id.String() < completions[j].id.String()
+		})
+	}
+
+	suggestions := model.ArticleSuggestions{Titles: []model.TitleSuggestion{}, Authors: []string{}}
+	for _, title := range titles[:min(len(titles), size)] {
+		suggestions.Titles = append(suggestions.Titles, model.TitleSuggestion{ArticleID: title.id, Title: title.text})
+	}
+	for _, author := range authors[:min(len(authors), size)] {
+		suggestions.Authors = append(suggestions.Authors, author.text)
+	}
+
+	return suggestions, nil
+}
+
+func (s *ArticleSearchMemory) CorrectQuery(ctx context.Context, params SearchParams) (string, error) {
+	clauses := ParseQuery(params.Query)
+
+	s.mu.RLock()
+	corrections := map[string]string{}
+	for _, word := range queryWords(clauses) {
+		if term, ok := s.index.closestTerm(word); ok {
+			corrections[word] = term
+		}
+	}
+	s.mu.RUnlock()
+
+	return correctQuery(ctx, s, params, clauses, corrections)
+}
+
+// Related weighs the words of the article by tf-idf and searches the best of them, like more_like_this
+func (s *ArticleSearchMemory) Related(ctx context.Context, article model.Article, size int) ([]uuid.UUID, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	fieldTexts := map[string][]string{"title": {article.Title}, "body": {article.Body}, "tags": article.Tags}
+	weights := map[string]float64{}
+	for field, texts := range fieldTexts {
+		frequencies := map[string]int{}
+		for _, text := range texts {
+			for _, term := range terms(text) {
+				frequencies[term]++
+			}
+		}
+		for term, frequency := range frequencies {
+			if len(s.index.postings[field][term]) == 0 {
+				continue
+			}
+			weights[term] = max(weights[term], float64(frequency)*s.index.idf(field, term))
+		}
+	}
+
+	likeTerms := slices.SortedFunc(maps.Keys(weights), func(a, b string) int {
+		if weights[a] != weights[b] {
+			return cmp.Compare(weights[b], weights[a])
+		}
+		return strings.Compare(a, b)
+	})
+	likeTerms = likeTerms[:min(len(likeTerms), memoryRelatedMaxTerms)]
+
+	var hits []memoryHit
+	for id := range s.index.candidates(relatedFields, likeTerms) {
+		if id == article.ID {
+			continue
+		}
+		if score, ok := s.index.matchAny(id, relatedFields, likeTerms, nil, false, false); ok {
+			hits = append(hits, memoryHit{id: id, score: score})
+		}
+	}
+	s.sortHits(hits)
+
+	var ids []uuid.UUID
+	for _, hit := range hits[:min(len(hits), size)] {
+		ids = append(ids, hit.id)
+	}
+	return ids, nil
+}
+
+type memoryHit struct {
+	id    uuid.UUID
+	score float64
+}
+
+// match evaluates the clauses the same way buildQuery does for Elasticsearch
+func (s *ArticleSearchMemory) match(clauses []QueryClause, expansions map[string][]string, ids []uuid.UUID, filter SearchFilter) []memoryHit {
+	var words []string
+	var positives []QueryClause
+	var candidateTerms []string
+	for _, clause := range clauses {
+		switch {
+		case clause.Field == "" && !clause.Phrase && !clause.Negated:
+			words = append(words, terms(clause.Text)...)
+		case !clause.Negated:
+			positives = append(positives, clause)
+		}
+		if !clause.Negated {
+			for _, term := range terms(clause.Text) {
+				candidateTerms = append(candidateTerms, term)
+				candidateTerms = append(candidateTerms, expansions[term]...)
+			}
+		}
+	}
+
+	// a query that only excludes articles matches all the others
+	candidates := map[uuid.UUID]bool{}
+	if len(words) > 0 || len(positives) > 0 {
+		candidates = s.index.candidates(freeTextFields, candidateTerms)
+	} else {
+		for id := range s.index.documents {
+			candidates[id] = true
+		}
+	}
+	if len(ids) > 0 {
+		allowed := map[uuid.UUID]bool{}
+		for _, id := range ids {
+			allowed[id] = candidates[id]
+		}
+		candidates = allowed
+	}
+
+	var hits []memoryHit
+	for id, isCandidate := range candidates {
+		if !isCandidate || !keeps(filter, s.index.documents[id]) {
+			continue
+		}
+		if score, ok := s.score(id, clauses, words, expansions); ok {
+			hits = append(hits, memoryHit{id: id, score: score})
+		}
+	}
+
+	s.sortHits(hits)
+
+	return hits
+}
+
+func (s *ArticleSearchMemory) sortHits(hits []memoryHit) {
+	sort.Slice(hits, func(i, j int) bool {
+		if hits[i].score != hits[j].score {
+			return hits[i].score > hits[j].score
+		}
+		createdI, createdJ := s.index.documents[hits[i].id].CreatedAt, s.index.documents[hits[j].id].CreatedAt
+		if !createdI.Equal(createdJ) {
+			return createdI.After(createdJ)
+		}
+		return hits[i].id.String() < hits[j].id.String()
+	})
+}
+
+func (s *ArticleSearchMemory) sortHitsBy(hits []memoryHit, sortBy, sortDirection string) {
+	sort.Slice(hits, func(i, j int) bool {
+		a, b := s.index.documents[hits[i].id], s.index.documents[hits[j].id]
+
+		order := 0
+		switch sortBy {
+		case "created_at":
+			order = a.CreatedAt.Compare(b.CreatedAt)
+		case "title":
+			order = strings.Compare(strings.ToLower(a.Title), strings.ToLower(b.Title))
+		case "author_name":
+			order = strings.Compare(strings.ToLower(a.AuthorName), strings.ToLower(b.AuthorName))
+		}
+		if sortDirection != "asc" {
+			order = -order
+		}
+		if order != 0 {
+			return order < 0
+		}
+		return hits[i].id.String() < hits[j].id.String()
+	})
+}
+
+func keeps(filter SearchFilter, document memoryDocument) bool {
+	if !filter.CreatedFrom.IsZero() && document.CreatedAt.Before(filter.CreatedFrom) {
+		return false
+	}
+	if !filter.CreatedTo.IsZero() && document.CreatedAt.After(filter.CreatedTo) {
+		return false
+	}
+	if len(filter.AuthorIDs) > 0 && !slices.Contains(filter.AuthorIDs, document.AuthorID) {
+		return false
+	}
+	if slices.Contains(filter.ExcludeAuthorIDs, document.AuthorID) {
+		return false
+	}
+	if filter.AuthorName != "" && !strings.Contains(strings.ToLower(document.AuthorName), strings.ToLower(filter.AuthorName)) {
+		return false
+	}
+	if len(filter.CategoryIDs) > 0 && !slices.Contains(filter.CategoryIDs, document.CategoryID) {
+		return false
+	}
+	if len(filter.Tags) == 0 {
+		return true
+	}
+
+	matched := 0
+	for _, tag := range filter.Tags {
+		if slices.Contains(document.Tags, tag) {
+			matched++
+		}
+	}
+	if filter.MatchAllTags {
+		return matched == len(filter.Tags)
+	}
+	return matched > 0
+}
+
+func (s *ArticleSearchMemory) score(id uuid.UUID, clauses []QueryClause, words []string, expansions map[string][]string) (float64, bool) {
+	total := 0.0
+	for _, clause := range clauses {
+		if clause.Field == "" && !clause.Phrase && !clause.Negated {
+			continue
+		}
+
+		fields := []string{clause.Field}
+		if clause.Field == "" {
+			fields = freeTextFields
+		}
+		// like buildQuery, negated clauses never match with typos
+		clauseExpansions := expansions
+		if clause.Negated {
+			clauseExpansions = nil
+		}
+		score, ok := s.index.matchAny(id, fields, terms(clause.Text), clauseExpansions, clause.Phrase, true)
+
+		if clause.Negated {
+			if ok {
+				return 0, false
+			}
+			continue
+		}
+		if !ok {
+			return 0, false
+		}
+		total += score
+	}
+
+	if len(words) > 0 {
+		score, ok := s.index.matchAny(id, freeTextFields, words, expansions, false, false)
+		if !ok {
+			return 0, false
+		}
+		total += score
+	}
+
+	return total, true
+}
+
+func (s *ArticleSearchMemory) countFacets(hits []memoryHit, facets []string) model.ArticleFacets {
+	result := model.ArticleFacets{}
+	for _, facet := range facets {
+		counts := map[model.FacetBucket]int64{}
+		for _, hit := range hits {
+			document := s.index.documents[hit.id]
+			switch facet {
+			case model.FacetAuthor:
+				counts[model.FacetBucket{Value: document.AuthorID.String(), Label: document.AuthorName}]++
+			case model.FacetMonth:
+				counts[model.FacetBucket{Value: document.CreatedAt.UTC().Format(model.FacetMonthLayout)}]++
+			case model.FacetTag:
+				for _, tag := range document.Tags {
+					counts[model.FacetBucket{Value: tag}]++
+				}
+			}
+		}
+
+		buckets := []model.FacetBucket{}
+		for bucket, count := range counts {
+			bucket.Count = count
+			buckets = append(buckets, bucket)
+		}
+
+		if facet == model.FacetMonth {
+			sort.Slice(buckets, func(i, j int) bool { return buckets[i].Value > buckets[j].Value })
+		} else {
+			sort.Slice(buckets, func(i, j int) bool {
+				if buckets[i].Count != buckets[j].Count {
+					return buckets[i].Count > buckets[j].Count
+				}
+				return buckets[i].Label+buckets[i].Value < buckets[j].Label+buckets[j].Value
+			})
+			buckets = buckets[:min(len(buckets), model.FacetSize)]
+		}
+		result[facet] = buckets
+	}
+
+	return result
+}
+
+func (s *ArticleSearchMemory) highlight(id uuid.UUID, highlightTerms map[string]map[string]bool) model.ArticleHighlight {
+	document := s.index.documents[id]
+
+	var highlight model.ArticleHighlight
+	if title := s.tag(document.Title, document.Tokens["title"], highlightTerms["title"]); title != "" {
+		highlight.Title = []string{title}
+	}
+
+	var fragment []memoryToken
+	bodyTokens := document.Tokens["body"]
+	for i, token := range bodyTokens {
+		fragment = append(fragment, token)
+
+		isLast := i == len(bodyTokens)-1
+		if !isLast && bodyTokens[i+1].End-fragment[0].Start <= s.Highlight.FragmentSize {
+			continue
+		}
+
+		text := document.Body[fragment[0].Start:token.End]
+		if tagged := s.tag(text, shiftTokens(fragment, fragment[0].Start), highlightTerms["body"]); tagged != "" {
+			highlight.Body = append(highlight.Body, tagged)
+		}
+		if len(highlight.Body) == s.Highlight.NumberOfFragments {
+			break
+		}
+		fragment = nil
+	}
+
+	return highlight
+}
+
+func (s *ArticleSearchMemory) tag(text string, tokens []memoryToken, terms map[string]bool) string {
+	var tagged strings.Builder
+	last, found := 0, false
+	for _, token := range tokens {
+		if !terms[token.Term] {
+			continue
+		}
+		tagged.WriteString(text[last:token.Start])
+		tagged.WriteString(s.Highlight.PreTag)
+		tagged.WriteString(text[token.Start:token.End])
+		tagged.WriteString(s.Highlight.PostTag)
+		last, found = token.End, true
+	}
+	if !found {
+		return ""
+	}
+
+	tagged.WriteString(text[last:])
+	return tagged.String()
+}
+
+func positiveTerms(clauses []QueryClause, expansions map[string][]string) map[string]map[string]bool {
+	fieldTerms := map[string]map[string]bool{}
+	for _, clause := range clauses {
+		if clause.Negated {
+			continue
+		}
+
+		fields := []string{clause.Field}
+		if clause.Field == "" {
+			fields = freeTextFields
+		}
+		for _, field := range fields {
+			if fieldTerms[field] == nil {
+				fieldTerms[field] = map[string]bool{}
+			}
+			for _, term := range terms(clause.Text) {
+				fieldTerms[field][term] = true
+				for _, alternative := range expansions[term] {
+					fieldTerms[field][alternative] = true
+				}
+			}
+		}
+	}
+	return fieldTerms
+}
+
+func shiftTokens(tokens []memoryToken, offset int) []memoryToken {
+	shifted := make([]memoryToken, len(tokens))
+	for i, token := range tokens {
+		token.Start -= offset
+		token.End -= offset
+		shifted[i] = token
+	}
+	return shifted
+}
@@ -0,0 +1,395 @@
+package search
+
+import (
+	"article-service/apperror"
+	"article-service/configloader"
+	"article-service/db/repository/mock_repository"
+	"article-service/factory"
+	"article-service/model"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestArticleSearchMemory(articles ...model.Article) *ArticleSearchMemory {
+	search := newArticleSearchMemory(highlightWithDefaults(configloader.HighlightConfig{}))
+	for _, article := range articles {
+		search.Index(context.Background(), article)
+	}
+	return search
+}
+
+func Test_NewArticleSearchMemory_IndexPublicArticlesOfRepository(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	article1 := factory.SampleArticle1
+	article2 := factory.SampleArticle2
+	draft := factory.SampleArticle2
+	draft.ID = uuid.MustParse("0197db1c-c6c4-7140-bee3-8efd703f30ca")
+	draft.Status = model.ArticleStatusDraft
+
+	articleRepo := mock_repository.NewMockIArticleRepository(ctrl)
+	articleRepo.EXPECT().ListForIndexing(gomock.Any(), uuid.Nil, time.Time{}, memorySeedBatchSize).
+		Return([]*model.Article{&article1, &article2, &draft}, nil)
+
+	search, err := NewArticleSearchMemory(context.Background(), configloader.HighlightConfig{}, articleRepo)
+	assert.Nil(t, err)
+
+	result, err := search.Search(context.Background(), SearchParams{Query: "tiga", Limit: 10})
+
+	assert.Nil(t, err)
+	assert.Equal(t, []uuid.UUID{article2.ID}, result.IDs)
+}
+
+func Test_NewArticleSearchMemory_ReturnErr_WhenListForIndexingFailed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	articleRepo := mock_repository.NewMockIArticleRepository(ctrl)
+	articleRepo.EXPECT().ListForIndexing(gomock.Any(), uuid.Nil, time.Time{}, memorySeedBatchSize).
+		Return(nil, apperror.ErrGetRecordFailed)
+
+	search, err := NewArticleSearchMemory(context.Background(), configloader.HighlightConfig{}, articleRepo)
+
+	assert.Nil(t, search)
+	assert.Equal(t, apperror.ErrGetRecordFailed, err)
+}
+
+func Test_ArticleSearchMemory_Search_RankByBM25(t *testing.T) {
+	search := newTestArticleSearchMemory(factory.SampleArticle1, factory.SampleArticle2)
+
+	result, err := search.Search(context.Background(), SearchParams{Query: "satu", Limit: 10})
+
+	assert.Nil(t, err)
+	assert.Equal(t, int64(2), result.Total)
+	// "satu" is twice in the title of article 1 and once in the body of article 2
+	assert.Equal(t, []uuid.UUID{factory.SampleArticle1.ID, factory.SampleArticle2.ID}, result.IDs)
+}
+
+func Test_ArticleSearchMemory_Search_SortByField(t *testing.T) {
+	search := newTestArticleSearchMemory(factory.SampleArticle1, factory.SampleArticle2)
+	article1, article2 := factory.SampleArticle1.ID, factory.SampleArticle2.ID
+
+	tests := []struct {
+		sortBy, sortDirection string
+		ids                   []uuid.UUID
+	}{
+		{"created_at", "asc", []uuid.UUID{article1, article2}},
+		{"created_at", "desc", []uuid.UUID{article2, article1}},
+		{"created_at", "", []uuid.UUID{article2, article1}},
+		{"title", "asc", []uuid.UUID{article1, article2}},
+		{"author_name", "desc", []uuid.UUID{article2, article1}},
+	}
+	for _, test := range tests {
+		params := SearchParams{Query: "satu", SortBy: test.sortBy, SortDirection: test.sortDirection, Limit: 1, Offset: 1}
+		result, err := search.Search(context.Background(), params)
+
+		assert.Nil(t, err, test.sortBy)
+		assert.Equal(t, int64(2), result.Total, test.sortBy)
+		assert.Equal(t, test.ids[1:], result.IDs, test.sortBy)
+	}
+}
+
+func Test_ArticleSearchMemory_Search_MatchAnyWordAcrossFields(t *testing.T) {
+	search := newTestArticleSearchMemory(factory.SampleArticle1, factory.SampleArticle2)
+
+	result, err := search.Search(context.Background(), SearchParams{Query: "AYAH phang", Limit: 10})
+
+	assert.Nil(t, err)
+	assert.Equal(t, int64(2), result.Total)
+	assert.ElementsMatch(t, []uuid.UUID{factory.SampleArticle1.ID, factory.SampleArticle2.ID}, result.IDs)
+}
+
+func Test_ArticleSearchMemory_Search_ApplyFieldedPhraseAndNegatedClauses(t *testing.T) {
+	search := newTestArticleSearchMemory(factory.SampleArticle1, factory.SampleArticle2)
+
+	tests := map[string][]uuid.UUID{
+		`author:chandra`:      {factory.SampleArticle1.ID},
+		`tag:puisi`:           {factory.SampleArticle1.ID},
+		`"aku sayang"`:        {factory.SampleArticle1.ID},
+		`"sayang aku"`:        nil,
+		`title:"tiga sayang"`: {factory.SampleArticle2.ID},
+		`body:ibu`:            nil,
+		`sayang -ibu`:         {factory.SampleArticle2.ID},
+		`-author:phang`:       {factory.SampleArticle1.ID},
+		`"keluarga puisi"`:    nil,
+	}
+	for query, ids := range tests {
+		result, err := search.Search(context.Background(), SearchParams{Query: query, Limit: 10})
+
+		assert.Nil(t, err, query)
+		assert.Equal(t, ids, result.IDs, query)
+	}
+}
+
+func Test_ArticleSearchMemory_Search_ReturnNewestFirst_WhenNothingIsRanked(t *testing.T) {
+	search := newTestArticleSearchMemory(factory.SampleArticle1, factory.SampleArticle2)
+
+	result, err := search.Search(context.Background(), SearchParams{Limit: 10})
+
+	assert.Nil(t, err)
+	assert.Equal(t, []uuid.UUID{factory.SampleArticle2.ID, factory.SampleArticle1.ID}, result.IDs)
+}
+
+func Test_ArticleSearchMemory_Search_FilterIdsAndPage(t *testing.T) {
+	search := newTestArticleSearchMemory(factory.SampleArticle1, factory.SampleArticle2)
+
+	result, err := search.Search(context.Background(), SearchParams{Query: "sayang", IDs: []uuid.UUID{factory.SampleArticle2.ID}, Limit: 10})
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), result.Total)
+	assert.Equal(t, []uuid.UUID{factory.SampleArticle2.ID}, result.IDs)
+
+	result, err = search.Search(context.Background(), SearchParams{Query: "sayang", Limit: 1, Offset: 1})
+	assert.Nil(t, err)
+	assert.Equal(t, int64(2), result.Total)
+	assert.Equal(t, 1, len(result.IDs))
+
+	result, err = search.Search(context.Background(), SearchParams{Query: "sayang", Limit: 10, Offset: 2})
+	assert.Nil(t, err)
+	assert.Equal(t, int64(2), result.Total)
+	assert.Nil(t, result.IDs)
+}
+
+func Test_ArticleSearchMemory_Search_ReturnHighlights(t *testing.T) {
+	article := factory.SampleArticle1
+	article.Body = "Satu satu aku sayang ibu. Dua dua juga sayang ayah. Tiga tiga sayang adik kakak."
+	search := newTestArticleSearchMemory(article)
+	search.Highlight.FragmentSize = 26
+	search.Highlight.NumberOfFragments = 2
+
+	result, err := search.Search(context.Background(), SearchParams{Query: "ibu ayah", Highlight: true, Limit: 10})
+
+	assert.Nil(t, err)
+	assert.Equal(t, model.ArticleHighlight{
+		Title: []string{"Satu satu aku sayang <em>ibu</em>"},
+		Body:  []string{"Satu satu aku sayang <em>ibu</em>", "Dua dua juga sayang <em>ayah</em>"},
+	}, result.Highlights[article.ID])
+}
+
+func Test_ArticleSearchMemory_Index_ReplaceAndDelete(t *testing.T) {
+	search := newTestArticleSearchMemory(factory.SampleArticle1, factory.SampleArticle2)
+
+	article := factory.SampleArticle1
+	article.Title = "Judul baru"
+	search.Index(context.Background(), article)
+
+	result, _ := search.Search(context.Background(), SearchParams{Query: "title:ibu", Limit: 10})
+	assert.Equal(t, int64(0), result.Total)
+	result, _ = search.Search(context.Background(), SearchParams{Query: "judul", Limit: 10})
+	assert.Equal(t, []uuid.UUID{article.ID}, result.IDs)
+
+	search.Delete(context.Background(), article.ID)
+
+	result, _ = search.Search(context.Background(), SearchParams{Limit: 10})
+	assert.Equal(t, []uuid.UUID{factory.SampleArticle2.ID}, result.IDs)
+}
+
+func Test_Tokenize_SplitLowercasedWords(t *testing.T) {
+	tokens := tokenize("Satu, dua-TIGA!", 3)
+
+	assert.Equal(t, []memoryToken{
+		{Term: "satu", Position: 3, Start: 0, End: 4},
+		{Term: "dua", Position: 4, Start: 6, End: 9},
+		{Term: "tiga", Position: 5, Start: 10, End: 14},
+	}, tokens)
+}
+
+func Test_ArticleSearchMemory_Search_CountFacets(t *testing.T) {
+	article3 := factory.SampleArticle2
+	article3.ID = uuid.MustParse("0197db1c-c6c4-7140-bee3-8efd703f30ca")
+	article3.CreatedAt = article3.CreatedAt.AddDate(0, -1, 0)
+	article3.Tags = []string{"puisi"}
+	search := newTestArticleSearchMemory(factory.SampleArticle1, factory.SampleArticle2, article3)
+
+	result, err := search.Search(context.Background(), SearchParams{Query: "sayang", Facets: []string{model.FacetAuthor, model.FacetMonth, model.FacetTag}})
+
+	assert.Nil(t, err)
+	assert.Nil(t, result.IDs)
+	assert.Equal(t, model.ArticleFacets{
+		model.FacetAuthor: {
+			{Value: factory.SampleAuthorPhang.ID.String(), Label: "Phang", Count: 2},
+			{Value: factory.SampleAuthorChandra.ID.String(), Label: "Chandra", Count: 1},
+		},
+		model.FacetMonth: {{Value: "2025-07", Count: 2}, {Value: "2025-06", Count: 1}},
+		model.FacetTag:   {{Value: "keluarga", Count: 2}, {Value: "puisi", Count: 2}},
+	}, result.Facets)
+}
+
+func Test_ArticleSearchMemory_Suggest_CompleteTitlesAndAuthorsWithTypos(t *testing.T) {
+	search := newTestArticleSearchMemory(factory.SampleArticle1, factory.SampleArticle2)
+
+	suggestions, err := search.Suggest(context.Background(), "tiga tgia", 5)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []model.TitleSuggestion{{ArticleID: factory.SampleArticle2.ID, Title: factory.SampleArticle2.Title}}, suggestions.Titles)
+	assert.Equal(t, []string{}, suggestions.Authors)
+
+	suggestions, err = search.Suggest(context.Background(), "chnadra", 5)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []model.TitleSuggestion{}, suggestions.Titles)
+	assert.Equal(t, []string{"Chandra"}, suggestions.Authors)
+}
+
+func Test_ArticleSearchMemory_Suggest_RankFewerTyposFirstAndLimit(t *testing.T) {
+	article3 := factory.SampleArticle2
+	article3.ID = uuid.MustParse("0197db1c-c6c4-7140-bee3-8efd703f30ca")
+	article3.Title = "Sayur asem"
+	search := newTestArticleSearchMemory(factory.SampleArticle1, factory.SampleArticle2, article3)
+
+	suggestions, err := search.Suggest(context.Background(), "sayu", 1)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []model.TitleSuggestion{{ArticleID: article3.ID, Title: "Sayur asem"}}, suggestions.Titles)
+}
+
+func Test_FuzzyPrefixDistance(t *testing.T) {
+	tests := []struct {
+		prefix   string
+		text     string
+		distance int
+		ok       bool
+	}{
+		{"sa", "Satu satu", 0, true},
+		{"sx", "Satu satu", 1, false},
+		{"satu", "Satu satu", 0, true},
+		{"sTau", "Satu satu", 1, true},
+		{"stau sa", "Satu satu", 1, true},
+		{"stau sx", "Satu satu", 2, true},
+		{"xyz", "Satu satu", 3, false},
+	}
+	for _, test := range tests {
+		distance, ok := fuzzyPrefixDistance(test.prefix, test.text)
+
+		assert.Equal(t, test.distance, distance, test.prefix)
+		assert.Equal(t, test.ok, ok, test.prefix)
+	}
+}
+
+func Test_ArticleSearchMemory_Search_MatchWordsWithTypos_WhenFuzzy(t *testing.T) {
+	search := newTestArticleSearchMemory(factory.SampleArticle1, factory.SampleArticle2)
+
+	tests := []struct {
+		query string
+		fuzzy bool
+		ids   []uuid.UUID
+	}{
+		{"sayng", false, nil},
+		{"sayng", true, []uuid.UUID{factory.SampleArticle1.ID, factory.SampleArticle2.ID}},
+		{"title:tgia", true, []uuid.UUID{factory.SampleArticle2.ID}},
+		{"sayng -ibu", true, []uuid.UUID{factory.SampleArticle2.ID}},
+		// negated clauses and phrases never match with typos
+		{"sayang -ibi", true, []uuid.UUID{factory.SampleArticle1.ID, factory.SampleArticle2.ID}},
+		{`"aku sayng"`, true, nil},
+	}
+	for _, test := range tests {
+		result, err := search.Search(context.Background(), SearchParams{Query: test.query, Fuzzy: test.fuzzy, Limit: 10})
+
+		assert.Nil(t, err, test.query)
+		assert.ElementsMatch(t, test.ids, result.IDs, test.query)
+	}
+}
+
+func Test_ArticleSearchMemory_Search_HighlightFuzzyMatches(t *testing.T) {
+	search := newTestArticleSearchMemory(factory.SampleArticle1, factory.SampleArticle2)
+
+	result, err := search.Search(context.Background(), SearchParams{Query: "ayha", Fuzzy: true, Highlight: true, Limit: 10})
+
+	assert.Nil(t, err)
+	assert.Equal(t, []uuid.UUID{factory.SampleArticle1.ID}, result.IDs)
+	assert.Equal(t, []string{"Dua dua juga sayang <em>ayah</em>"}, result.Highlights[factory.SampleArticle1.ID].Body)
+}
+
+func Test_ArticleSearchMemory_CorrectQuery(t *testing.T) {
+	search := newTestArticleSearchMemory(factory.SampleArticle1, factory.SampleArticle2)
+
+	tests := map[string]string{
+		"sayng ibuu":   "sayang ibu",
+		"title:tgia":   "title:tiga",
+		`"aku sayng"`:  `"aku sayang"`,
+		"-ibuu ayha":   "-ibuu ayah",
+		"satu":         "",
+		"xyzzy":        "",
+		"body:ibuu":    "",
+		"chnadra puis": "chandra puisi",
+	}
+	for query, expected := range tests {
+		corrected, err := search.CorrectQuery(context.Background(), SearchParams{Query: query})
+
+		assert.Nil(t, err, query)
+		assert.Equal(t, expected, corrected, query)
+	}
+}
+
+func Test_ArticleSearchMemory_CorrectQuery_ReturnEmpty_WhenFilterLeavesNoArticle(t *testing.T) {
+	search := newTestArticleSearchMemory(factory.SampleArticle1, factory.SampleArticle2)
+
+	// only SampleArticle1 has "ibu", and it is written by Chandra
+	params := SearchParams{Query: "ibuu", Filter: SearchFilter{AuthorIDs: []uuid.UUID{factory.SampleAuthorChandra.ID}}}
+	corrected, err := search.CorrectQuery(context.Background(), params)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "ibu", corrected)
+
+	params.Filter = SearchFilter{ExcludeAuthorIDs: []uuid.UUID{factory.SampleAuthorChandra.ID}}
+	corrected, err = search.CorrectQuery(context.Background(), params)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "", corrected)
+}
+
+func Test_ArticleSearchMemory_Related_RankArticlesSharingWords(t *testing.T) {
+	article3 := factory.SampleArticle2
+	article3.ID = uuid.MustParse("0197db1c-c6c4-7140-bee3-8efd703f30ca")
+	article3.Title = "Sayur asem"
+	article3.Body = "Masak sayur asem"
+	article3.Tags = []string{"resep"}
+	article4 := article3
+	article4.ID = uuid.MustParse("0197db1c-c6c4-7140-bee3-8efd703f30cb")
+	article4.Title = "Sayur lodeh ibu"
+	search := newTestArticleSearchMemory(factory.SampleArticle1, factory.SampleArticle2, article3, article4)
+
+	ids, err := search.Related(context.Background(), article3, 5)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []uuid.UUID{article4.ID}, ids)
+
+	ids, err = search.Related(context.Background(), factory.SampleArticle1, 1)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []uuid.UUID{factory.SampleArticle2.ID}, ids)
+}
+
+func Test_ArticleSearchMemory_Search_ApplyFilter(t *testing.T) {
+	article3 := factory.SampleArticle2
+	article3.ID = uuid.MustParse("0197db1c-c6c4-7140-bee3-8efd703f30ca")
+	article3.CreatedAt = article3.CreatedAt.AddDate(0, -1, 0)
+	article3.Category = &factory.SampleCategoryLocal
+	search := newTestArticleSearchMemory(factory.SampleArticle1, factory.SampleArticle2, article3)
+
+	chandra, phang := factory.SampleAuthorChandra.ID, factory.SampleAuthorPhang.ID
+	tests := []struct {
+		filter SearchFilter
+		ids    []uuid.UUID
+	}{
+		{SearchFilter{AuthorIDs: []uuid.UUID{phang}}, []uuid.UUID{factory.SampleArticle2.ID, article3.ID}},
+		{SearchFilter{AuthorIDs: []uuid.UUID{chandra, phang}, ExcludeAuthorIDs: []uuid.UUID{phang}}, []uuid.UUID{factory.SampleArticle1.ID}},
+		{SearchFilter{CreatedFrom: factory.SampleArticle1.CreatedAt}, []uuid.UUID{factory.SampleArticle1.ID, factory.SampleArticle2.ID}},
+		{SearchFilter{CreatedTo: factory.SampleArticle1.CreatedAt}, []uuid.UUID{factory.SampleArticle1.ID, article3.ID}},
+		{SearchFilter{CreatedFrom: factory.SampleArticle2.CreatedAt, CreatedTo: factory.SampleArticle2.CreatedAt}, []uuid.UUID{factory.SampleArticle2.ID}},
+		{SearchFilter{AuthorName: "CHAN"}, []uuid.UUID{factory.SampleArticle1.ID}},
+		{SearchFilter{CategoryIDs: []uuid.UUID{factory.SampleCategoryNews.ID, factory.SampleCategoryLocal.ID}}, []uuid.UUID{article3.ID}},
+		{SearchFilter{Tags: []string{"puisi", "keluarga"}}, []uuid.UUID{factory.SampleArticle1.ID, factory.SampleArticle2.ID, article3.ID}},
+		{SearchFilter{Tags: []string{"puisi", "keluarga"}, MatchAllTags: true}, []uuid.UUID{factory.SampleArticle1.ID}},
+	}
+	for _, test := range tests {
+		result, err := search.Search(context.Background(), SearchParams{Query: "sayang", Filter: test.filter, Limit: 10})
+
+		assert.Nil(t, err, test.filter)
+		assert.ElementsMatch(t, test.ids, result.IDs, test.filter)
+		assert.Equal(t, int64(len(test.ids)), result.Total, test.filter)
+	}
+}
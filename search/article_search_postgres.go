@@ -0,0 +1,546 @@
+package search
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"article-service/apperror"
+	"article-service/configloader"
+	"article-service/db/db_client"
+	"article-service/db/transaction"
+	"article-service/infrastructure/log"
+	"article-service/model"
+	"article-service/utils"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// headlineFragmentDelimiter is a control character so it never shows up in an article
+const headlineFragmentDelimiter = "\x1e"
+
+const averageWordLength = 6
+
+var postgresSortColumns = map[string]string{
+	"created_at":  "article_search_documents.created_at",
+	"title":       "LOWER(article_search_documents.title)",
+	"author_name": "LOWER(article_search_documents.author_name)",
+}
+
+type ArticleSearchPostgres struct {
+	Highlight configloader.HighlightConfig
+}
+
+func (s ArticleSearchPostgres) Index(ctx context.Context, article model.Article) error {
+	conn := transaction.GetClientOrTxn(ctx, db_client.GetDB)
+
+	query := `
+		INSERT INTO article_search_documents
+			(article_id, title, body, author_name, tags, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (article_id) DO UPDATE SET
+			title = EXCLUDED.title,
+			body = EXCLUDED.body,
+			author_name = EXCLUDED.author_name,
+			tags = EXCLUDED.tags,
+			created_at = EXCLUDED.created_at
+	`
+
+	_, err := conn.Exec(
+		ctx,
+		query,
+		article.ID,
+		article.Title,
+		article.Body,
+		article.Author.Name,
+		strings.Join(article.Tags, " "),
+		article.CreatedAt,
+	)
+	if err != nil {
+		log.Errorf(ctx, err, "[ArticleSearchPostgres][Index] Exec failed, id: %s", article.ID)
+		return apperror.ErrCreateRecordFailed
+	}
+
+	return nil
+}
+
+func (s ArticleSearchPostgres) Delete(ctx context.Context, id uuid.UUID) error {
+	conn := transaction.GetClientOrTxn(ctx, db_client.GetDB)
+
+	query := `
+		DELETE FROM article_search_documents
+		WHERE article_id = $1
+	`
+
+	_, err := conn.Exec(ctx, query, id)
+	if err != nil {
+		log.Errorf(ctx, err, "[ArticleSearchPostgres][Delete] Exec failed, id: %s", id)
+		return apperror.ErrDeleteRecordFailed
+	}
+
+	return nil
+}
+
+func (s ArticleSearchPostgres) Search(ctx context.Context, params SearchParams) (SearchResult, error) {
+	conn := transaction.GetClientOrTxn(ctx, db_client.GetDB)
+
+	clauses := ParseQuery(params.Query)
+	if params.Fuzzy {
+		var err error
+		clauses, err = s.fuzzyClauses(ctx, clauses)
+		if err != nil {
+			return SearchResult{}, err
+		}
+	}
+
+	query := buildPostgresQuery(clauses, nil)
+	if len(params.IDs) > 0 {
+		query.Params = append(query.Params, pq.Array(utils.UUIDStrings(params.IDs)))
+		query.Conditions = append(query.Conditions, fmt.Sprintf("article_search_documents.article_id = ANY($%d::uuid[])", len(query.Params)))
+	}
+	query.filter(params.Filter)
+
+	whereFilters := ""
+	if len(query.Conditions) > 0 {
+		whereFilters = "WHERE " + strings.Join(query.Conditions, " AND ")
+	}
+
+	total, err := s.count(ctx, whereFilters, query.Params)
+	if err != nil {
+		return SearchResult{}, err
+	}
+
+	result := SearchResult{Total: total}
+	if params.Highlight {
+		result.Highlights = map[uuid.UUID]model.ArticleHighlight{}
+	}
+	if len(params.Facets) > 0 {
+		result.Facets, err = s.countFacets(ctx, whereFilters, query.Params, params.Facets)
+		if err != nil {
+			return SearchResult{}, err
+		}
+	}
+	if params.Limit <= 0 || total == 0 {
+		return result, nil
+	}
+
+	// a query that only excludes articles ranks all of them the same
+	orderBy := "article_search_documents.created_at DESC, article_search_documents.article_id ASC"
+	titleHeadline, bodyHeadline := "''", "''"
+	searchParams := query.Params
+	if column, ok := postgresSortColumns[params.SortBy]; ok {
+		direction := "DESC"
+		if params.SortDirection == "asc" {
+			direction = "ASC"
+		}
+		orderBy = fmt.Sprintf("%s %s, article_search_documents.article_id ASC", column, direction)
+	} else if query.Rank != "" {
+		orderBy = fmt.Sprintf("ts_rank_cd(article_search_documents.document, %s) DESC, %s", query.Rank, orderBy)
+	}
+	if query.Rank != "" {
+		if params.Highlight {
+			searchParams = append(searchParams, s.titleHeadlineOptions(), s.bodyHeadlineOptions())
+			titleHeadline = fmt.Sprintf("ts_headline('simple', article_search_documents.title, %s, $%d)", query.Rank, len(searchParams)-1)
+			bodyHeadline = fmt.Sprintf("ts_headline('simple', article_search_documents.body, %s, $%d)", query.Rank, len(searchParams))
+		}
+	}
+
+	searchQuery := `
+		SELECT
+			article_search_documents.article_id,
+			{{titleHeadline}} AS title_headline,
+			{{bodyHeadline}} AS body_headline
+		FROM article_search_documents
+		{{whereFilters}}
+		ORDER BY {{orderBy}}
+		{{limitAndOffset}}
+	`
+
+	searchParams = append(searchParams, params.Limit, params.Offset)
+	searchQuery = strings.NewReplacer(
+		"{{titleHeadline}}", titleHeadline,
+		"{{bodyHeadline}}", bodyHeadline,
+		"{{whereFilters}}", whereFilters,
+		"{{orderBy}}", orderBy,
+		"{{limitAndOffset}}", fmt.Sprintf("LIMIT $%d OFFSET $%d", len(searchParams)-1, len(searchParams)),
+	).Replace(searchQuery)
+
+	rows, err := conn.Query(ctx, searchQuery, searchParams...)
+	if err != nil {
+		log.Errorf(ctx, err, "[ArticleSearchPostgres][Search] Query failed, query: %s", params.Query)
+		return SearchResult{}, apperror.ErrGetRecordFailed
+	}
+
+	for rows.Next() {
+		var id uuid.UUID
+		var title, body string
+		err = rows.Scan(&id, &title, &body)
+		if err != nil {
+			log.Errorf(ctx, err, "[ArticleSearchPostgres][Search] Scan failed, query: %s", params.Query)
+			return SearchResult{}, apperror.ErrScanRecordFailed
+		}
+		result.IDs = append(result.IDs, id)
+
+		if params.Highlight {
+			result.Highlights[id] = model.ArticleHighlight{
+				Title: s.highlighted(title, ""),
+				Body:  s.highlighted(body, headlineFragmentDelimiter),
+			}
+		}
+	}
+
+	return result, nil
+}
+
+func (s ArticleSearchPostgres) count(ctx context.Context, whereFilters string, params []interface{}) (int64, error) {
+	conn := transaction.GetClientOrTxn(ctx, db_client.GetDB)
+	query := `
+		SELECT COUNT(*)
+		FROM article_search_documents
+		{{whereFilters}}
+	`
+	query = strings.ReplaceAll(query, "{{whereFilters}}", whereFilters)
+
+	rows, err := conn.Query(ctx, query, params...)
+	if err != nil {
+		log.Errorf(ctx, err, "[ArticleSearchPostgres][count] Query failed")
+		return 0, apperror.ErrGetRecordFailed
+	}
+
+	var total int64
+	for rows.Next() {
+		err = rows.Scan(&total)
+		if err != nil {
+			log.Errorf(ctx, err, "[ArticleSearchPostgres][count] Scan failed")
+			return 0, apperror.ErrScanRecordFailed
+		}
+	}
+
+	return total, nil
+}
+
+func (s ArticleSearchPostgres) Suggest(ctx context.Context, prefix string, size int) (model.ArticleSuggestions, error) {
+	conn := transaction.GetClientOrTxn(ctx, db_client.GetDB)
+	pattern := likeEscaper.Replace(prefix) + "%"
+
+	titleQuery := `
+		SELECT article_search_documents.article_id, article_search_documents.title
+		FROM article_search_documents
+		WHERE article_search_documents.title ILIKE $1 OR $2 <% article_search_documents.title
+		ORDER BY
+			article_search_documents.title ILIKE $1 DESC,
+			word_similarity($2, article_search_documents.title) DESC,
+			article_search_documents.title ASC
+		LIMIT $3
+	`
+
+	rows, err := conn.Query(ctx, titleQuery, pattern, prefix, size)
+	if err != nil {
+		log.Errorf(ctx, err, "[ArticleSearchPostgres][Suggest] Query titles failed, prefix: %s", prefix)
+		return model.ArticleSuggestions{}, apperror.ErrGetRecordFailed
+	}
+
+	suggestions := model.ArticleSuggestions{Titles: []model.TitleSuggestion{}, Authors: []string{}}
+	for rows.Next() {
+		var title model.TitleSuggestion
+		err = rows.Scan(&title.ArticleID, &title.Title)
+		if err != nil {
+			log.Errorf(ctx, err, "[ArticleSearchPostgres][Suggest] Scan title failed, prefix: %s", prefix)
+			return model.ArticleSuggestions{}, apperror.ErrScanRecordFailed
+		}
+		suggestions.Titles = append(suggestions.Titles, title)
+	}
+
+	authorQuery := `
+		SELECT article_search_documents.author_name
+		FROM article_search_documents
+		WHERE article_search_documents.author_name ILIKE $1 OR $2 <% article_search_documents.author_name
+		GROUP BY article_search_documents.author_name
+		ORDER BY
+			article_search_documents.author_name ILIKE $1 DESC,
+			word_similarity($2, article_search_documents.author_name) DESC,
+			article_search_documents.author_name ASC
+		LIMIT $3
+	`
+
+	rows, err = conn.Query(ctx, authorQuery, pattern, prefix, size)
+	if err != nil {
+		log.Errorf(ctx, err, "[ArticleSearchPostgres][Suggest] Query authors failed, prefix: %s", prefix)
+		return model.ArticleSuggestions{}, apperror.ErrGetRecordFailed
+	}
+
+	for rows.Next() {
+		var author string
+		err = rows.Scan(&author)
+		if err != nil {
+			log.Errorf(ctx, err, "[ArticleSearchPostgres][Suggest] Scan author failed, prefix: %s", prefix)
+			return model.ArticleSuggestions{}, apperror.ErrScanRecordFailed
+		}
+		suggestions.Authors = append(suggestions.Authors, author)
+	}
+
+	return suggestions, nil
+}
+
+func (s ArticleSearchPostgres) Related(ctx context.Context, article model.Article, size int) ([]uuid.UUID, error) {
+	conn := transaction.GetClientOrTxn(ctx, db_client.GetDB)
+
+	query := `
+		SELECT article_search_documents.article_id
+		FROM article_search_documents,
+			(
+				SELECT to_tsquery('simple', string_agg(quote_literal(words.lexeme), ' | ')) AS query
+				FROM unnest(tsvector_to_array(to_tsvector('simple', $1))) AS words(lexeme)
+			) AS related
+		WHERE article_search_documents.article_id <> $2
+			AND (
+				article_search_documents.title_vector @@ related.query
+				OR article_search_documents.body_vector @@ related.query
+				OR article_search_documents.tags_vector @@ related.query
+			)
+		ORDER BY
+			ts_rank_cd(article_search_documents.document, related.query) DESC,
+			article_search_documents.created_at DESC,
+			article_search_documents.article_id ASC
+		LIMIT $3
+	`
+
+	text := strings.Join(append([]string{article.Title, article.Body}, article.Tags...), "\n")
+	rows, err := conn.Query(ctx, query, text, article.ID, size)
+	if err != nil {
+		log.Errorf(ctx, err, "[ArticleSearchPostgres][Related] Query failed, id: %s", article.ID)
+		return nil, apperror.ErrGetRecordFailed
+	}
+
+	var ids []uuid.UUID
+	for rows.Next() {
+		var id uuid.UUID
+		err = rows.Scan(&id)
+		if err != nil {
+			log.Errorf(ctx, err, "[ArticleSearchPostgres][Related] Scan failed, id: %s", article.ID)
+			return nil, apperror.ErrScanRecordFailed
+		}
+		ids = append(ids, id)
+	}
+
+	return ids, nil
+}
+
+func (s ArticleSearchPostgres) CorrectQuery(ctx context.Context, params SearchParams) (string, error) {
+	clauses := ParseQuery(params.Query)
+	words := queryWords(clauses)
+	if len(words) == 0 {
+		return "", nil
+	}
+
+	similar, err := s.similarWords(ctx, words)
+	if err != nil {
+		return "", err
+	}
+
+	corrections := map[string]string{}
+	for _, word := range words {
+		if len(similar[word]) > 0 {
+			corrections[word] = similar[word][0]
+		}
+	}
+	return correctQuery(ctx, s, params, clauses, corrections)
+}
+
+func (s ArticleSearchPostgres) fuzzyClauses(ctx context.Context, clauses []QueryClause) ([]QueryClause, error) {
+	words := fuzzyWords(clauses)
+	if len(words) == 0 {
+		return clauses, nil
+	}
+
+	similar, err := s.similarWords(ctx, words)
+	if err != nil {
+		return nil, err
+	}
+
+	fuzzy := make([]QueryClause, len(clauses))
+	for i, clause := range clauses {
+		fuzzy[i] = clause
+		clauseWords := terms(clause.Text)
+		if clause.Negated || clause.Phrase || len(clauseWords) != 1 {
+			continue
+		}
+
+		alternatives := []string{clauseWords[0]}
+		for _, word := range similar[clauseWords[0]] {
+			if word != clauseWords[0] {
+				alternatives = append(alternatives, word)
+			}
+		}
+		fuzzy[i].Text = strings.Join(alternatives, " or ")
+	}
+
+	return fuzzy, nil
+}
+
+// similarWords sorts the similar words by fewest edits and then by most documents, so an indexed
+// word comes first in its own list
+func (s ArticleSearchPostgres) similarWords(ctx context.Context, words []string) (map[string][]string, error) {
+	conn := transaction.GetClientOrTxn(ctx, db_client.GetDB)
+
+	// joining each searched word lets the trigram index of article_search_words look it up
+	query := `
+		SELECT DISTINCT article_search_words.word, article_search_words.ndoc
+		FROM unnest($1::text[]) AS searched(word)
+		JOIN article_search_words ON article_search_words.word % searched.word
+	`
+
+	rows, err := conn.Query(ctx, query, pq.Array(words))
+	if err != nil {
+		log.Errorf(ctx, err, "[ArticleSearchPostgres][similarWords] Query failed, words: %v", words)
+		return nil, apperror.ErrGetRecordFailed
+	}
+
+	type similarWord struct {
+		word      string
+		documents int
+		distance  int
+	}
+	candidates := map[string][]similarWord{}
+	for rows.Next() {
+		var indexed similarWord
+		err = rows.Scan(&indexed.word, &indexed.documents)
+		if err != nil {
+			log.Errorf(ctx, err, "[ArticleSearchPostgres][similarWords] Scan failed, words: %v", words)
+			return nil, apperror.ErrScanRecordFailed
+		}
+
+		for _, word := range words {
+			if distance, ok := fuzzyDistance(word, indexed.word); ok {
+				indexed.distance = distance
+				candidates[word] = append(candidates[word], indexed)
+			}
+		}
+	}
+
+	similar := map[string][]string{}
+	for word, indexed := range candidates {
+		sort.Slice(indexed, func(i, j int) bool {
+			if indexed[i].distance != indexed[j].distance {
+				return indexed[i].distance < indexed[j].distance
+			}
+			if indexed[i].documents != indexed[j].documents {
+				return indexed[i].documents > indexed[j].documents
+			}
+			return indexed[i].word < indexed[j].word
+		})
+		for _, candidate := range indexed {
+			similar[word] = append(similar[word], candidate.word)
+		}
+	}
+
+	return similar, nil
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
+var postgresFacetQueries = map[string]string{
+	model.FacetAuthor: `
+		SELECT authors.id::text, authors.name, COUNT(*)
+		FROM article_search_documents
+		JOIN articles ON articles.id = article_search_documents.article_id
+		JOIN authors ON articles.author_id = authors.id
+		{{whereFilters}}
+		GROUP BY authors.id, authors.name
+		ORDER BY COUNT(*) DESC, authors.name ASC
+		{{limit}}
+	`,
+	model.FacetMonth: `
+		SELECT to_char(article_search_documents.created_at AT TIME ZONE 'UTC', 'YYYY-MM') AS month, '', COUNT(*)
+		FROM article_search_documents
+		{{whereFilters}}
+		GROUP BY month
+		ORDER BY month DESC
+	`,
+	model.FacetTag: `
+		SELECT tags.name, '', COUNT(*)
+		FROM article_search_documents
+		JOIN article_tags ON article_tags.article_id = article_search_documents.article_id
+		JOIN tags ON article_tags.tag_id = tags.id
+		{{whereFilters}}
+		GROUP BY tags.name
+		ORDER BY COUNT(*) DESC, tags.name ASC
+		{{limit}}
+	`,
+}
+
+func (s ArticleSearchPostgres) countFacets(ctx context.Context, whereFilters string, params []interface{}, facets []string) (model.ArticleFacets, error) {
+	conn := transaction.GetClientOrTxn(ctx, db_client.GetDB)
+
+	result := model.ArticleFacets{}
+	for _, facet := range facets {
+		query, ok := postgresFacetQueries[facet]
+		if !ok {
+			continue
+		}
+
+		facetParams := params
+		if strings.Contains(query, "{{limit}}") {
+			facetParams = append(slices.Clone(params), model.FacetSize)
+			query = strings.ReplaceAll(query, "{{limit}}", fmt.Sprintf("LIMIT $%d", len(facetParams)))
+		}
+		query = strings.ReplaceAll(query, "{{whereFilters}}", whereFilters)
+
+		rows, err := conn.Query(ctx, query, facetParams...)
+		if err != nil {
+			log.Errorf(ctx, err, "[ArticleSearchPostgres][countFacets] Query failed, facet: %s", facet)
+			return nil, apperror.ErrGetRecordFailed
+		}
+
+		buckets := []model.FacetBucket{}
+		for rows.Next() {
+			var bucket model.FacetBucket
+			err = rows.Scan(&bucket.Value, &bucket.Label, &bucket.Count)
+			if err != nil {
+				log.Errorf(ctx, err, "[ArticleSearchPostgres][countFacets] Scan failed, facet: %s", facet)
+				return nil, apperror.ErrScanRecordFailed
+			}
+			buckets = append(buckets, bucket)
+		}
+		result[facet] = buckets
+	}
+
+	return result, nil
+}
+
+func (s ArticleSearchPostgres) titleHeadlineOptions() string {
+	return fmt.Sprintf("StartSel=%s, StopSel=%s, HighlightAll=true",
+		quoteHeadlineOption(s.Highlight.PreTag), quoteHeadlineOption(s.Highlight.PostTag))
+}
+
+func (s ArticleSearchPostgres) bodyHeadlineOptions() string {
+	maxWords := max(s.Highlight.FragmentSize/averageWordLength, 2)
+	return fmt.Sprintf("StartSel=%s, StopSel=%s, MaxFragments=%d, MaxWords=%d, MinWords=%d, FragmentDelimiter=%s",
+		quoteHeadlineOption(s.Highlight.PreTag), quoteHeadlineOption(s.Highlight.PostTag),
+		s.Highlight.NumberOfFragments, maxWords, maxWords/2, quoteHeadlineOption(headlineFragmentDelimiter))
+}
+
+// highlighted drops the fragments without a match, ts_headline falls back to the start of the text
+// when nothing matched
+func (s ArticleSearchPostgres) highlighted(headline, delimiter string) []string {
+	fragments := []string{headline}
+	if delimiter != "" {
+		fragments = strings.Split(headline, delimiter)
+	}
+
+	var matched []string
+	for _, fragment := range fragments {
+		fragment = strings.TrimSpace(fragment)
+		if strings.Contains(fragment, s.Highlight.PreTag) {
+			matched = append(matched, fragment)
+		}
+	}
+	return matched
+}
+
+func quoteHeadlineOption(value string) string {
+	return `"` + strings.ReplaceAll(value, `"`, `""`) + `"`
+}
@@ -0,0 +1,410 @@
+package search
+
+import (
+	"article-service/apperror"
+	"article-service/configloader"
+	"article-service/db/db_client"
+	"article-service/factory"
+	"article-service/model"
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestArticleSearchPostgres() ArticleSearchPostgres {
+	return ArticleSearchPostgres{Highlight: highlightWithDefaults(configloader.HighlightConfig{})}
+}
+
+func Test_ArticleSearchPostgres_Index_Success(t *testing.T) {
+	mock := db_client.InitDatabaseMock()
+
+	article := factory.SampleArticle1
+	mock.ExpectExec(regexp.QuoteMeta(`
+		INSERT INTO article_search_documents
+			(article_id, title, body, author_name, tags, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (article_id) DO UPDATE SET
+	`)).WithArgs(article.ID, article.Title, article.Body, article.Author.Name, "puisi keluarga", article.CreatedAt).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	article.Tags = []string{"puisi", "keluarga"}
+	err := newTestArticleSearchPostgres().Index(context.Background(), article)
+
+	assert.Nil(t, err)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func Test_ArticleSearchPostgres_Delete_ReturnErr_WhenExecFailed(t *testing.T) {
+	mock := db_client.InitDatabaseMock()
+
+	mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM article_search_documents`)).WillReturnError(errors.New("db error"))
+
+	err := newTestArticleSearchPostgres().Delete(context.Background(), factory.SampleArticle1.ID)
+
+	assert.Equal(t, apperror.ErrDeleteRecordFailed, err)
+}
+
+func Test_ArticleSearchPostgres_Search_Success_WithHighlights(t *testing.T) {
+	mock := db_client.InitDatabaseMock()
+
+	id1, id2 := factory.SampleArticle1.ID, factory.SampleArticle2.ID
+	mock.ExpectQuery(regexp.QuoteMeta(`
+		SELECT COUNT(*)
+		FROM article_search_documents
+		WHERE article_search_documents.document @@ (websearch_to_tsquery('simple', $1))
+	`)).WithArgs("sayang").WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+
+	mock.ExpectQuery(regexp.QuoteMeta(`
+		SELECT
+			article_search_documents.article_id,
+			ts_headline('simple', article_search_documents.title, (websearch_to_tsquery('simple', $1)), $2) AS title_headline,
+			ts_headline('simple', article_search_documents.body, (websearch_to_tsquery('simple', $1)), $3) AS body_headline
+		FROM article_search_documents
+		WHERE article_search_documents.document @@ (websearch_to_tsquery('simple', $1))
+		ORDER BY ts_rank_cd(article_search_documents.document, (websearch_to_tsquery('simple', $1))) DESC, article_search_documents.created_at DESC, article_search_documents.article_id ASC
+		LIMIT $4 OFFSET $5
+	`)).WithArgs(
+		"sayang",
+		`StartSel="<em>", StopSel="</em>", HighlightAll=true`,
+		"StartSel=\"<em>\", StopSel=\"</em>\", MaxFragments=3, MaxWords=25, MinWords=12, FragmentDelimiter=\"\x1e\"",
+		10,
+		0,
+	).WillReturnRows(sqlmock.NewRows([]string{"article_id", "title_headline", "body_headline"}).
+		AddRow(id1, "Satu satu aku <em>sayang</em> ibu", "Dua dua juga <em>sayang</em> ayah").
+		AddRow(id2, "Tiga tiga adik kakak", "Satu dua tiga \x1e semuanya <em>sayang</em>"))
+
+	result, err := newTestArticleSearchPostgres().Search(context.Background(), SearchParams{Query: "sayang", Highlight: true, Limit: 10})
+
+	assert.Nil(t, err)
+	assert.Equal(t, int64(2), result.Total)
+	assert.Equal(t, []uuid.UUID{id1, id2}, result.IDs)
+	assert.Equal(t, model.ArticleHighlight{
+		Title: []string{"Satu satu aku <em>sayang</em> ibu"},
+		Body:  []string{"Dua dua juga <em>sayang</em> ayah"},
+	}, result.Highlights[id1])
+	assert.Equal(t, model.ArticleHighlight{
+		Body: []string{"semuanya <em>sayang</em>"},
+	}, result.Highlights[id2])
+}
+
+func Test_ArticleSearchPostgres_Search_Success_WithIdsAndWithoutRank(t *testing.T) {
+	mock := db_client.InitDatabaseMock()
+
+	id := factory.SampleArticle1.ID
+	mock.ExpectQuery(regexp.QuoteMeta(`
+		WHERE NOT article_search_documents.document @@ websearch_to_tsquery('simple', $1) AND article_search_documents.article_id = ANY($2::uuid[])
+	`)).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+
+	mock.ExpectQuery(regexp.QuoteMeta(`
+		ORDER BY article_search_documents.created_at DESC, article_search_documents.article_id ASC
+		LIMIT $3 OFFSET $4
+	`)).WillReturnRows(sqlmock.NewRows([]string{"article_id", "title_headline", "body_headline"}).AddRow(id, "", ""))
+
+	result, err := newTestArticleSearchPostgres().Search(context.Background(), SearchParams{Query: "-draft", IDs: []uuid.UUID{id}, Limit: 10})
+
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), result.Total)
+	assert.Equal(t, []uuid.UUID{id}, result.IDs)
+	assert.Nil(t, result.Highlights)
+}
+
+func Test_ArticleSearchPostgres_Search_ApplyFilter(t *testing.T) {
+	mock := db_client.InitDatabaseMock()
+
+	chandra, phang := factory.SampleAuthorChandra.ID, factory.SampleAuthorPhang.ID
+	createdTo := time.Date(2025, 7, 31, 23, 59, 59, 0, time.UTC)
+	mock.ExpectQuery(regexp.QuoteMeta(`
+		WHERE article_search_documents.document @@ (websearch_to_tsquery('simple', $1))
+			AND article_search_documents.created_at <= $2
+			AND article_search_documents.article_id IN (SELECT articles.id FROM articles WHERE articles.author_id = ANY($3::uuid[]))
+			AND article_search_documents.article_id NOT IN (SELECT articles.id FROM articles WHERE articles.author_id = ANY($4::uuid[]))
+	`)).WithArgs("sayang", createdTo, pq.Array([]string{chandra.String()}), pq.Array([]string{phang.String()})).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+
+	filter := SearchFilter{CreatedTo: createdTo, AuthorIDs: []uuid.UUID{chandra}, ExcludeAuthorIDs: []uuid.UUID{phang}}
+	result, err := newTestArticleSearchPostgres().Search(context.Background(), SearchParams{Query: "sayang", Filter: filter, Limit: 10})
+
+	assert.Nil(t, err)
+	assert.Equal(t, SearchResult{}, result)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func Test_ArticleSearchPostgres_Search_ApplyAuthorNameCategoryAndTagsFilter(t *testing.T) {
+	mock := db_client.InitDatabaseMock()
+
+	news, local := factory.SampleCategoryNews.ID, factory.SampleCategoryLocal.ID
+	mock.ExpectQuery(regexp.QuoteMeta(`
+		WHERE article_search_documents.document @@ (websearch_to_tsquery('simple', $1))
+			AND LOWER(article_search_documents.author_name) LIKE LOWER($2)
+			AND article_search_documents.article_id IN (SELECT articles.id FROM articles WHERE articles.category_id = ANY($3::uuid[]))
+			AND article_search_documents.article_id IN (SELECT article_tags.article_id
+			FROM article_tags
+			JOIN tags ON article_tags.tag_id = tags.id
+			WHERE tags.name = ANY($4)
+			GROUP BY article_tags.article_id
+			HAVING COUNT(*) = $5)
+	`)).WithArgs("sayang", "%chan%", pq.Array([]string{news.String(), local.String()}), pq.Array([]string{"puisi", "keluarga"}), 2).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+
+	filter := SearchFilter{
+		AuthorName:   "chan",
+		CategoryIDs:  []uuid.UUID{news, local},
+		Tags:         []string{"puisi", "keluarga"},
+		MatchAllTags: true,
+	}
+	result, err := newTestArticleSearchPostgres().Search(context.Background(), SearchParams{Query: "sayang", Filter: filter, Limit: 10})
+
+	assert.Nil(t, err)
+	assert.Equal(t, SearchResult{}, result)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func Test_ArticleSearchPostgres_Search_SortByField(t *testing.T) {
+	mock := db_client.InitDatabaseMock()
+
+	id := factory.SampleArticle1.ID
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT COUNT(*)`)).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(11))
+	mock.ExpectQuery(regexp.QuoteMeta(`
+		ORDER BY LOWER(article_search_documents.title) DESC, article_search_documents.article_id ASC
+		LIMIT $2 OFFSET $3
+	`)).WithArgs("sayang", 10, 10).
+		WillReturnRows(sqlmock.NewRows([]string{"article_id", "title_headline", "body_headline"}).AddRow(id, "", ""))
+
+	params := SearchParams{Query: "sayang", SortBy: "title", SortDirection: "desc", Limit: 10, Offset: 10}
+	result, err := newTestArticleSearchPostgres().Search(context.Background(), params)
+
+	assert.Nil(t, err)
+	assert.Equal(t, SearchResult{IDs: []uuid.UUID{id}, Total: 11}, result)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func Test_ArticleSearchPostgres_Search_SortDescending_WhenDirectionIsMissing(t *testing.T) {
+	mock := db_client.InitDatabaseMock()
+
+	id := factory.SampleArticle1.ID
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT COUNT(*)`)).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	mock.ExpectQuery(regexp.QuoteMeta(`
+		ORDER BY LOWER(article_search_documents.title) DESC, article_search_documents.article_id ASC
+	`)).WillReturnRows(sqlmock.NewRows([]string{"article_id", "title_headline", "body_headline"}).AddRow(id, "", ""))
+
+	params := SearchParams{Query: "sayang", SortBy: "title", Limit: 10}
+	result, err := newTestArticleSearchPostgres().Search(context.Background(), params)
+
+	assert.Nil(t, err)
+	assert.Equal(t, SearchResult{IDs: []uuid.UUID{id}, Total: 1}, result)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func Test_ArticleSearchPostgres_Search_ReturnTotalOnly_WhenPageIsEmpty(t *testing.T) {
+	mock := db_client.InitDatabaseMock()
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT COUNT(*)`)).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+
+	result, err := newTestArticleSearchPostgres().Search(context.Background(), SearchParams{Query: "sayang", Limit: 10})
+
+	assert.Nil(t, err)
+	assert.Equal(t, SearchResult{}, result)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func Test_ArticleSearchPostgres_Search_CountFacets(t *testing.T) {
+	mock := db_client.InitDatabaseMock()
+
+	author := factory.SampleAuthorChandra
+	where := "WHERE article_search_documents.document @@ (websearch_to_tsquery('simple', $1))"
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT COUNT(*)`)).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+	mock.ExpectQuery(regexp.QuoteMeta(`
+		SELECT authors.id::text, authors.name, COUNT(*)
+		FROM article_search_documents
+		JOIN articles ON articles.id = article_search_documents.article_id
+		JOIN authors ON articles.author_id = authors.id
+		`+where+`
+		GROUP BY authors.id, authors.name
+		ORDER BY COUNT(*) DESC, authors.name ASC
+		LIMIT $2
+	`)).WithArgs("sayang", model.FacetSize).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "count"}).AddRow(author.ID.String(), author.Name, 2))
+	mock.ExpectQuery(regexp.QuoteMeta(`
+		SELECT to_char(article_search_documents.created_at AT TIME ZONE 'UTC', 'YYYY-MM') AS month, '', COUNT(*)
+		FROM article_search_documents
+		` + where + `
+		GROUP BY month
+		ORDER BY month DESC
+	`)).WithArgs("sayang").
+		WillReturnRows(sqlmock.NewRows([]string{"month", "label", "count"}).AddRow("2025-07", "", 2))
+
+	result, err := newTestArticleSearchPostgres().Search(context.Background(), SearchParams{Query: "sayang", Facets: []string{model.FacetAuthor, model.FacetMonth}})
+
+	assert.Nil(t, err)
+	assert.Equal(t, int64(2), result.Total)
+	assert.Equal(t, model.ArticleFacets{
+		model.FacetAuthor: {{Value: author.ID.String(), Label: author.Name, Count: 2}},
+		model.FacetMonth:  {{Value: "2025-07", Count: 2}},
+	}, result.Facets)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func Test_ArticleSearchPostgres_Search_ReturnErr_WhenQueryFailed(t *testing.T) {
+	mock := db_client.InitDatabaseMock()
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT COUNT(*)`)).WillReturnError(errors.New("db error"))
+
+	result, err := newTestArticleSearchPostgres().Search(context.Background(), SearchParams{Query: "sayang", Limit: 10})
+
+	assert.Equal(t, SearchResult{}, result)
+	assert.Equal(t, apperror.ErrGetRecordFailed, err)
+}
+
+func Test_ArticleSearchPostgres_Suggest_Success(t *testing.T) {
+	mock := db_client.InitDatabaseMock()
+
+	article := factory.SampleArticle1
+	mock.ExpectQuery(regexp.QuoteMeta(`
+		SELECT article_search_documents.article_id, article_search_documents.title
+		FROM article_search_documents
+		WHERE article_search_documents.title ILIKE $1 OR $2 <% article_search_documents.title
+	`)).WithArgs(`50\%%`, "50%", 5).
+		WillReturnRows(sqlmock.NewRows([]string{"article_id", "title"}).AddRow(article.ID, article.Title))
+	mock.ExpectQuery(regexp.QuoteMeta(`
+		SELECT article_search_documents.author_name
+		FROM article_search_documents
+		WHERE article_search_documents.author_name ILIKE $1 OR $2 <% article_search_documents.author_name
+		GROUP BY article_search_documents.author_name
+	`)).WithArgs(`50\%%`, "50%", 5).
+		WillReturnRows(sqlmock.NewRows([]string{"author_name"}))
+
+	suggestions, err := newTestArticleSearchPostgres().Suggest(context.Background(), "50%", 5)
+
+	assert.Nil(t, err)
+	assert.Equal(t, model.ArticleSuggestions{
+		Titles:  []model.TitleSuggestion{{ArticleID: article.ID, Title: article.Title}},
+		Authors: []string{},
+	}, suggestions)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func Test_ArticleSearchPostgres_Suggest_ReturnErr_WhenQueryFailed(t *testing.T) {
+	mock := db_client.InitDatabaseMock()
+
+	mock.ExpectQuery(regexp.QuoteMeta(`ILIKE $1`)).WillReturnError(errors.New("db error"))
+
+	suggestions, err := newTestArticleSearchPostgres().Suggest(context.Background(), "sat", 5)
+
+	assert.Equal(t, model.ArticleSuggestions{}, suggestions)
+	assert.Equal(t, apperror.ErrGetRecordFailed, err)
+}
+
+func Test_ArticleSearchPostgres_Search_MatchSimilarWords_WhenFuzzy(t *testing.T) {
+	mock := db_client.InitDatabaseMock()
+
+	mock.ExpectQuery(regexp.QuoteMeta(`
+		SELECT DISTINCT article_search_words.word, article_search_words.ndoc
+		FROM unnest($1::text[]) AS searched(word)
+		JOIN article_search_words ON article_search_words.word % searched.word
+	`)).WithArgs(pq.Array([]string{"sayng"})).
+		WillReturnRows(sqlmock.NewRows([]string{"word", "ndoc"}).AddRow("sayur", 1).AddRow("sayang", 2).AddRow("layang", 3))
+	// sayur and layang are two edits away, too many for a word of 5 letters
+	mock.ExpectQuery(regexp.QuoteMeta(`
+		WHERE NOT article_search_documents.document @@ websearch_to_tsquery('simple', $1) AND article_search_documents.document @@ (websearch_to_tsquery('simple', $2))
+	`)).WithArgs("ibi", "sayng or sayang").WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+
+	result, err := newTestArticleSearchPostgres().Search(context.Background(), SearchParams{Query: "-ibi sayng", Fuzzy: true, Limit: 10})
+
+	assert.Nil(t, err)
+	assert.Equal(t, SearchResult{}, result)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func Test_ArticleSearchPostgres_CorrectQuery_Success(t *testing.T) {
+	mock := db_client.InitDatabaseMock()
+
+	mock.ExpectQuery(regexp.QuoteMeta(`JOIN article_search_words`)).WithArgs(pq.Array([]string{"sayng", "ibu"})).
+		WillReturnRows(sqlmock.NewRows([]string{"word", "ndoc"}).AddRow("sayang", 2).AddRow("ibu", 1).AddRow("abu", 5))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT COUNT(*)`)).WithArgs("sayang", "ibu").
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+
+	corrected, err := newTestArticleSearchPostgres().CorrectQuery(context.Background(), SearchParams{Query: "title:sayng ibu"})
+
+	assert.Nil(t, err)
+	assert.Equal(t, "title:sayang ibu", corrected)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func Test_ArticleSearchPostgres_CorrectQuery_ReturnEmpty_WhenCorrectionFindsNothing(t *testing.T) {
+	mock := db_client.InitDatabaseMock()
+
+	mock.ExpectQuery(regexp.QuoteMeta(`JOIN article_search_words`)).
+		WillReturnRows(sqlmock.NewRows([]string{"word", "ndoc"}).AddRow("sayang", 2))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT COUNT(*)`)).WithArgs("sayang").
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+
+	corrected, err := newTestArticleSearchPostgres().CorrectQuery(context.Background(), SearchParams{Query: "body:sayng"})
+
+	assert.Nil(t, err)
+	assert.Equal(t, "", corrected)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func Test_ArticleSearchPostgres_CorrectQuery_ReturnErr_WhenQueryFailed(t *testing.T) {
+	mock := db_client.InitDatabaseMock()
+
+	mock.ExpectQuery(regexp.QuoteMeta(`JOIN article_search_words`)).WillReturnError(errors.New("db error"))
+
+	corrected, err := newTestArticleSearchPostgres().CorrectQuery(context.Background(), SearchParams{Query: "sayng"})
+
+	assert.Equal(t, "", corrected)
+	assert.Equal(t, apperror.ErrGetRecordFailed, err)
+}
+
+func Test_ArticleSearchPostgres_Related_Success(t *testing.T) {
+	mock := db_client.InitDatabaseMock()
+
+	article := factory.SampleArticle1
+	mock.ExpectQuery(regexp.QuoteMeta(`
+				SELECT to_tsquery('simple', string_agg(quote_literal(words.lexeme), ' | ')) AS query
+				FROM unnest(tsvector_to_array(to_tsvector('simple', $1))) AS words(lexeme)
+			) AS related
+		WHERE article_search_documents.article_id <> $2
+	`)).WithArgs("Satu satu aku sayang ibu\nDua dua juga sayang ayah\nkeluarga\npuisi", article.ID, 5).
+		WillReturnRows(sqlmock.NewRows([]string{"article_id"}).AddRow(factory.SampleArticle2.ID))
+
+	ids, err := newTestArticleSearchPostgres().Related(context.Background(), article, 5)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []uuid.UUID{factory.SampleArticle2.ID}, ids)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func Test_ArticleSearchPostgres_Related_ReturnErr_WhenQueryFailed(t *testing.T) {
+	mock := db_client.InitDatabaseMock()
+
+	mock.ExpectQuery(regexp.QuoteMeta(`AS related`)).WillReturnError(errors.New("db error"))
+
+	ids, err := newTestArticleSearchPostgres().Related(context.Background(), factory.SampleArticle1, 5)
+
+	assert.Nil(t, ids)
+	assert.Equal(t, apperror.ErrGetRecordFailed, err)
+}
+
+func Test_InitArticleSearch_ReturnErr_WhenBackendIsUnknown(t *testing.T) {
+	err := InitArticleSearch(context.Background(), configloader.SearchConfig{Backend: "solr"}, configloader.HighlightConfig{})
+
+	assert.Equal(t, apperror.ErrUnknownSearchBackend, err)
+}
+
+func Test_InitArticleSearch_SelectPostgres(t *testing.T) {
+	defer InitArticleSearch(context.Background(), configloader.SearchConfig{}, configloader.HighlightConfig{})
+
+	err := InitArticleSearch(context.Background(), configloader.SearchConfig{Backend: BackendPostgres}, configloader.HighlightConfig{})
+
+	assert.Nil(t, err)
+	assert.Equal(t, BackendPostgres, Backend())
+	assert.Equal(t, ArticleSearchPostgres{Highlight: highlightWithDefaults(configloader.HighlightConfig{})}, GetArticleSearch())
+}
@@ -0,0 +1,89 @@
+package search
+
+import (
+	"context"
+
+	"article-service/apperror"
+	"article-service/configloader"
+	"article-service/db/repository"
+)
+
+const (
+	BackendElasticsearch = "elasticsearch"
+	BackendPostgres      = "postgres"
+	BackendMemory        = "memory"
+)
+
+const (
+	defaultHighlightPreTag            = "<em>"
+	defaultHighlightPostTag           = "</em>"
+	defaultHighlightFragmentSize      = 150
+	defaultHighlightNumberOfFragments = 3
+)
+
+var (
+	backend      = BackendElasticsearch
+	highlight    configloader.HighlightConfig
+	memorySearch *ArticleSearchMemory
+)
+
+func InitArticleSearch(ctx context.Context, cfg configloader.SearchConfig, highlightCfg configloader.HighlightConfig) error {
+	switch cfg.Backend {
+	case "", BackendElasticsearch:
+		backend = BackendElasticsearch
+	case BackendPostgres:
+		backend = BackendPostgres
+	case BackendMemory:
+		backend = BackendMemory
+	default:
+		return apperror.ErrUnknownSearchBackend
+	}
+
+	highlight = highlightWithDefaults(highlightCfg)
+	if backend == BackendMemory {
+		var err error
+		memorySearch, err = NewArticleSearchMemory(ctx, highlight, repository.GetArticleRepository())
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func Backend() string {
+	return backend
+}
+
+func GetArticleSearch() IArticleSearch {
+	switch backend {
+	case BackendPostgres:
+		return ArticleSearchPostgres{Highlight: highlight}
+	case BackendMemory:
+		return memorySearch
+	default:
+		return newElasticArticleSearch()
+	}
+}
+
+func GetArticleIndexer() IArticleIndexer {
+	switch backend {
+	case BackendPostgres, BackendMemory:
+		return ArticleIndexerInPlace{search: GetArticleSearch()}
+	default:
+		return newElasticArticleIndexer()
+	}
+}
+
+func highlightWithDefaults(cfg configloader.HighlightConfig) configloader.HighlightConfig {
+	if cfg.PreTag == "" && cfg.PostTag == "" {
+		cfg.PreTag = defaultHighlightPreTag
+		cfg.PostTag = defaultHighlightPostTag
+	}
+	if cfg.FragmentSize <= 0 {
+		cfg.FragmentSize = defaultHighlightFragmentSize
+	}
+	if cfg.NumberOfFragments <= 0 {
+		cfg.NumberOfFragments = defaultHighlightNumberOfFragments
+	}
+	return cfg
+}
@@ -0,0 +1,323 @@
+package search
+
+import (
+	"maps"
+	"math"
+	"slices"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/google/uuid"
+)
+
+// BM25 parameters, the defaults of Elasticsearch
+const (
+	bm25K1 = 1.2
+	bm25B  = 0.75
+)
+
+// memoryTagPositionGap keeps the words of different tags apart, so a phrase never matches across two tags
+const memoryTagPositionGap = 100
+
+type memoryToken struct {
+	Term     string
+	Position int
+	Start    int
+	End      int
+}
+
+type memoryDocument struct {
+	Title      string
+	Body       string
+	AuthorID   uuid.UUID
+	AuthorName string
+	CategoryID uuid.UUID
+	Tags       []string
+	CreatedAt  time.Time
+	Tokens     map[string][]memoryToken
+}
+
+// postings map a field and a term to the positions of the term in every document
+type memoryIndex struct {
+	documents   map[uuid.UUID]memoryDocument
+	postings    map[string]map[string]map[uuid.UUID][]int
+	totalLength map[string]int
+}
+
+func newMemoryIndex() memoryIndex {
+	return memoryIndex{
+		documents:   map[uuid.UUID]memoryDocument{},
+		postings:    map[string]map[string]map[uuid.UUID][]int{},
+		totalLength: map[string]int{},
+	}
+}
+
+func tokenize(text string, firstPosition int) []memoryToken {
+	var tokens []memoryToken
+
+	start := -1
+	for i, r := range text + " " {
+		isWordRune := unicode.IsLetter(r) || unicode.IsDigit(r)
+		if isWordRune && start < 0 {
+			start = i
+		}
+		if !isWordRune && start >= 0 {
+			tokens = append(tokens, memoryToken{
+				Term:     strings.ToLower(text[start:i]),
+				Position: firstPosition + len(tokens),
+				Start:    start,
+				End:      i,
+			})
+			start = -1
+		}
+	}
+
+	return tokens
+}
+
+func terms(text string) []string {
+	tokens := tokenize(text, 0)
+	terms := make([]string, len(tokens))
+	for i, token := range tokens {
+		terms[i] = token.Term
+	}
+	return terms
+}
+
+func (idx memoryIndex) add(id uuid.UUID, document memoryDocument) {
+	idx.remove(id)
+
+	idx.documents[id] = document
+	for field, tokens := range document.Tokens {
+		if idx.postings[field] == nil {
+			idx.postings[field] = map[string]map[uuid.UUID][]int{}
+		}
+		for _, token := range tokens {
+			if idx.postings[field][token.Term] == nil {
+				idx.postings[field][token.Term] = map[uuid.UUID][]int{}
+			}
+			idx.postings[field][token.Term][id] = append(idx.postings[field][token.Term][id], token.Position)
+		}
+		idx.totalLength[field] += len(tokens)
+	}
+}
+
+func (idx memoryIndex) remove(id uuid.UUID) {
+	document, ok := idx.documents[id]
+	if !ok {
+		return
+	}
+
+	for field, tokens := range document.Tokens {
+		for _, token := range tokens {
+			delete(idx.postings[field][token.Term], id)
+			if len(idx.postings[field][token.Term]) == 0 {
+				delete(idx.postings[field], token.Term)
+			}
+		}
+		idx.totalLength[field] -= len(tokens)
+	}
+	delete(idx.documents, id)
+}
+
+// match lets a term with expansions match through the best scoring of them, outside phrases
+func (idx memoryIndex) match(id uuid.UUID, field string, terms []string, expansions map[string][]string, phrase, requireAll bool) (float64, bool) {
+	if len(terms) == 0 {
+		return 0, false
+	}
+
+	if phrase {
+		frequency := idx.phraseFrequency(id, field, terms)
+		if frequency == 0 {
+			return 0, false
+		}
+
+		idf := 0.0
+		for _, term := range terms {
+			idf += idx.idf(field, term)
+		}
+		return idf * idx.tfNorm(id, field, frequency), true
+	}
+
+	score, matched := 0.0, 0
+	for _, term := range terms {
+		alternatives, ok := expansions[term]
+		if !ok {
+			alternatives = []string{term}
+		}
+
+		best, found := 0.0, false
+		for _, alternative := range alternatives {
+			frequency := len(idx.postings[field][alternative][id])
+			if frequency == 0 {
+				continue
+			}
+			best, found = max(best, idx.idf(field, alternative)*idx.tfNorm(id, field, frequency)), true
+		}
+		if found {
+			score += best
+			matched++
+		}
+	}
+
+	if matched == 0 || requireAll && matched < len(terms) {
+		return 0, false
+	}
+	return score, true
+}
+
+func (idx memoryIndex) matchAny(id uuid.UUID, fields []string, terms []string, expansions map[string][]string, phrase, requireAll bool) (float64, bool) {
+	best, found := 0.0, false
+	for _, field := range fields {
+		if score, ok := idx.match(id, field, terms, expansions, phrase, requireAll); ok {
+			best, found = max(best, score), true
+		}
+	}
+	return best, found
+}
+
+func (idx memoryIndex) phraseFrequency(id uuid.UUID, field string, terms []string) int {
+	frequency := 0
+	for _, position := range idx.postings[field][terms[0]][id] {
+		matched := true
+		for offset, term := range terms[1:] {
+			if !slices.Contains(idx.postings[field][term][id], position+offset+1) {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			frequency++
+		}
+	}
+	return frequency
+}
+
+func (idx memoryIndex) idf(field, term string) float64 {
+	count := float64(len(idx.documents))
+	frequency := float64(len(idx.postings[field][term]))
+	return math.Log(1 + (count-frequency+0.5)/(frequency+0.5))
+}
+
+func (idx memoryIndex) tfNorm(id uuid.UUID, field string, frequency int) float64 {
+	length := float64(len(idx.documents[id].Tokens[field]))
+	averageLength := float64(idx.totalLength[field]) / float64(len(idx.documents))
+	tf := float64(frequency)
+	return tf * (bm25K1 + 1) / (tf + bm25K1*(1-bm25B+bm25B*length/averageLength))
+}
+
+func (idx memoryIndex) candidates(fields []string, terms []string) map[uuid.UUID]bool {
+	candidates := map[uuid.UUID]bool{}
+	for _, field := range fields {
+		for _, term := range terms {
+			for id := range idx.postings[field][term] {
+				candidates[id] = true
+			}
+		}
+	}
+	return candidates
+}
+
+func (idx memoryIndex) fuzzyTerms(words []string) map[string][]string {
+	expansions := map[string][]string{}
+	for _, word := range words {
+		alternatives := map[string]bool{word: true}
+		for _, fieldPostings := range idx.postings {
+			for term := range fieldPostings {
+				if _, ok := fuzzyDistance(word, term); ok {
+					alternatives[term] = true
+				}
+			}
+		}
+
+		expansions[word] = slices.Sorted(maps.Keys(alternatives))
+	}
+	return expansions
+}
+
+// closestTerm prefers the fewest edits and then the most documents, an indexed word is its own closest term
+func (idx memoryIndex) closestTerm(word string) (string, bool) {
+	closest, closestDistance, closestDocuments := "", 0, 0
+	for _, fieldPostings := range idx.postings {
+		if _, ok := fieldPostings[word]; ok {
+			return word, true
+		}
+	}
+
+	documents := map[string]int{}
+	for _, fieldPostings := range idx.postings {
+		for term, postings := range fieldPostings {
+			documents[term] += len(postings)
+		}
+	}
+	for term, count := range documents {
+		distance, ok := fuzzyDistance(word, term)
+		if !ok {
+			continue
+		}
+
+		isCloser := closest == "" || distance < closestDistance ||
+			distance == closestDistance && (count > closestDocuments || count == closestDocuments && term < closest)
+		if isCloser {
+			closest, closestDistance, closestDocuments = term, distance, count
+		}
+	}
+
+	return closest, closest != ""
+}
+
+// autoFuzziness returns the typos Elasticsearch's AUTO fuzziness allows in a word of the given length
+func autoFuzziness(length int) int {
+	switch {
+	case length <= 2:
+		return 0
+	case length <= 5:
+		return 1
+	default:
+		return 2
+	}
+}
+
+func fuzzyDistance(word, term string) (int, bool) {
+	w := []rune(strings.ToLower(word))
+	distance := editDistances(w, []rune(strings.ToLower(term)))[len(w)]
+	return distance, distance <= autoFuzziness(len(w))
+}
+
+func fuzzyPrefixDistance(prefix, text string) (int, bool) {
+	p := []rune(strings.ToLower(prefix))
+	t := []rune(strings.ToLower(text))
+	maxEdits := autoFuzziness(len(p))
+	t = t[:min(len(t), len(p)+maxEdits)]
+
+	distance := slices.Min(editDistances(t, p))
+	return distance, distance <= maxEdits
+}
+
+// editDistances returns the distance between b and every prefix of a, a transposition is one edit like in Elasticsearch
+func editDistances(a, b []rune) []int {
+	// rows[i][j] is the edit distance between the first i runes of b and the first j runes of a
+	rows := make([][]int, len(b)+1)
+	for i := range rows {
+		rows[i] = make([]int, len(a)+1)
+		rows[i][0] = i
+	}
+	for j := range rows[0] {
+		rows[0][j] = j
+	}
+	for i := 1; i <= len(b); i++ {
+		for j := 1; j <= len(a); j++ {
+			cost := 1
+			if b[i-1] == a[j-1] {
+				cost = 0
+			}
+			rows[i][j] = min(rows[i-1][j]+1, rows[i][j-1]+1, rows[i-1][j-1]+cost)
+			if i > 1 && j > 1 && b[i-1] == a[j-2] && b[i-2] == a[j-1] {
+				rows[i][j] = min(rows[i][j], rows[i-2][j-2]+1)
+			}
+		}
+	}
+
+	return rows[len(b)]
+}
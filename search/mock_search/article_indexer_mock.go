@@ -0,0 +1,78 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: article_indexer.go
+
+// Package mock_search is a generated GoMock package.
+package mock_search
+
+import (
+	model "article-service/model"
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockIArticleIndexer is a mock of IArticleIndexer interface.
+type MockIArticleIndexer struct {
+	ctrl     *gomock.Controller
+	recorder *MockIArticleIndexerMockRecorder
+}
+
+// MockIArticleIndexerMockRecorder is the mock recorder for MockIArticleIndexer.
+type MockIArticleIndexerMockRecorder struct {
+	mock *MockIArticleIndexer
+}
+
+// NewMockIArticleIndexer creates a new mock instance.
+func NewMockIArticleIndexer(ctrl *gomock.Controller) *MockIArticleIndexer {
+	mock := &MockIArticleIndexer{ctrl: ctrl}
+	mock.recorder = &MockIArticleIndexerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIArticleIndexer) EXPECT() *MockIArticleIndexerMockRecorder {
+	return m.recorder
+}
+
+// BulkIndex mocks base method.
+func (m *MockIArticleIndexer) BulkIndex(ctx context.Context, name string, articles []*model.Article) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BulkIndex", ctx, name, articles)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BulkIndex indicates an expected call of BulkIndex.
+func (mr *MockIArticleIndexerMockRecorder) BulkIndex(ctx, name, articles interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BulkIndex", reflect.TypeOf((*MockIArticleIndexer)(nil).BulkIndex), ctx, name, articles)
+}
+
+// CreateIndex mocks base method.
+func (m *MockIArticleIndexer) CreateIndex(ctx context.Context, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateIndex", ctx, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateIndex indicates an expected call of CreateIndex.
+func (mr *MockIArticleIndexerMockRecorder) CreateIndex(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateIndex", reflect.TypeOf((*MockIArticleIndexer)(nil).CreateIndex), ctx, name)
+}
+
+// SwapAlias mocks base method.
+func (m *MockIArticleIndexer) SwapAlias(ctx context.Context, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SwapAlias", ctx, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SwapAlias indicates an expected call of SwapAlias.
+func (mr *MockIArticleIndexerMockRecorder) SwapAlias(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SwapAlias", reflect.TypeOf((*MockIArticleIndexer)(nil).SwapAlias), ctx, name)
+}
@@ -6,6 +6,7 @@ package mock_search
 
 import (
 	model "article-service/model"
+	search "article-service/search"
 	context "context"
 	reflect "reflect"
 
@@ -36,6 +37,35 @@ func (m *MockIArticleSearch) EXPECT() *MockIArticleSearchMockRecorder {
 	return m.recorder
 }
 
+// CorrectQuery mocks base method.
+func (m *MockIArticleSearch) CorrectQuery(ctx context.Context, params search.SearchParams) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CorrectQuery", ctx, params)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CorrectQuery indicates an expected call of CorrectQuery.
+func (mr *MockIArticleSearchMockRecorder) CorrectQuery(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CorrectQuery", reflect.TypeOf((*MockIArticleSearch)(nil).CorrectQuery), ctx, params)
+}
+
+// Delete mocks base method.
+func (m *MockIArticleSearch) Delete(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockIArticleSearchMockRecorder) Delete(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockIArticleSearch)(nil).Delete), ctx, id)
+}
+
 // Index mocks base method.
 func (m *MockIArticleSearch) Index(ctx context.Context, article model.Article) error {
 	m.ctrl.T.Helper()
@@ -50,17 +80,47 @@ func (mr *MockIArticleSearchMockRecorder) Index(ctx, article interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Index", reflect.TypeOf((*MockIArticleSearch)(nil).Index), ctx, article)
 }
 
-// Search mocks base method.
-func (m *MockIArticleSearch) Search(ctx context.Context, query string) ([]uuid.UUID, error) {
+// Related mocks base method.
+func (m *MockIArticleSearch) Related(ctx context.Context, article model.Article, size int) ([]uuid.UUID, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Search", ctx, query)
+	ret := m.ctrl.Call(m, "Related", ctx, article, size)
 	ret0, _ := ret[0].([]uuid.UUID)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
+// Related indicates an expected call of Related.
+func (mr *MockIArticleSearchMockRecorder) Related(ctx, article, size interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Related", reflect.TypeOf((*MockIArticleSearch)(nil).Related), ctx, article, size)
+}
+
+// Search mocks base method.
+func (m *MockIArticleSearch) Search(ctx context.Context, params search.SearchParams) (search.SearchResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", ctx, params)
+	ret0, _ := ret[0].(search.SearchResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
 // Search indicates an expected call of Search.
-func (mr *MockIArticleSearchMockRecorder) Search(ctx, query interface{}) *gomock.Call {
+func (mr *MockIArticleSearchMockRecorder) Search(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockIArticleSearch)(nil).Search), ctx, params)
+}
+
+// Suggest mocks base method.
+func (m *MockIArticleSearch) Suggest(ctx context.Context, prefix string, size int) (model.ArticleSuggestions, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Suggest", ctx, prefix, size)
+	ret0, _ := ret[0].(model.ArticleSuggestions)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Suggest indicates an expected call of Suggest.
+func (mr *MockIArticleSearchMockRecorder) Suggest(ctx, prefix, size interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockIArticleSearch)(nil).Search), ctx, query)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Suggest", reflect.TypeOf((*MockIArticleSearch)(nil).Suggest), ctx, prefix, size)
 }
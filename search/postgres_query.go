@@ -0,0 +1,112 @@
+package search
+
+import (
+	"fmt"
+	"strings"
+
+	"article-service/utils"
+
+	"github.com/lib/pq"
+)
+
+var postgresVectors = map[string]string{
+	"":            "article_search_documents.document",
+	"title":       "article_search_documents.title_vector",
+	"body":        "article_search_documents.body_vector",
+	"author_name": "article_search_documents.author_vector",
+	"tags":        "article_search_documents.tags_vector",
+}
+
+type postgresQuery struct {
+	Conditions []string
+	Rank       string
+	Params     []interface{}
+}
+
+// buildPostgresQuery numbers its placeholders after the given params
+func buildPostgresQuery(clauses []QueryClause, params []interface{}) postgresQuery {
+	query := postgresQuery{Params: params}
+	tsquery := func(text string) string {
+		query.Params = append(query.Params, text)
+		return fmt.Sprintf("websearch_to_tsquery('simple', $%d)", len(query.Params))
+	}
+
+	var words, positives []string
+	for _, clause := range clauses {
+		if clause.Field == "" && !clause.Phrase && !clause.Negated {
+			words = append(words, tsquery(clause.Text))
+			continue
+		}
+
+		// websearch_to_tsquery matches quoted text as a phrase and ANDs unquoted words
+		text := clause.Text
+		if clause.Phrase {
+			text = `"` + text + `"`
+		}
+		match := tsquery(text)
+		condition := fmt.Sprintf("%s @@ %s", postgresVectors[clause.Field], match)
+
+		if clause.Negated {
+			query.Conditions = append(query.Conditions, "NOT "+condition)
+		} else {
+			query.Conditions = append(query.Conditions, condition)
+			positives = append(positives, match)
+		}
+	}
+
+	if len(words) > 0 {
+		anyWord := "(" + strings.Join(words, " || ") + ")"
+		query.Conditions = append(query.Conditions, fmt.Sprintf("%s @@ %s", postgresVectors[""], anyWord))
+		positives = append(positives, anyWord)
+	}
+
+	if len(positives) > 0 {
+		query.Rank = strings.Join(positives, " || ")
+	}
+
+	return query
+}
+
+// documents only hold what is searched, so authors, categories and tags are matched through the articles
+func (query *postgresQuery) filter(filter SearchFilter) {
+	param := func(value interface{}) int {
+		query.Params = append(query.Params, value)
+		return len(query.Params)
+	}
+	inArticles := func(articles string) {
+		query.Conditions = append(query.Conditions, "article_search_documents.article_id IN ("+articles+")")
+	}
+	authorArticles := "SELECT articles.id FROM articles WHERE articles.author_id = ANY($%d::uuid[])"
+
+	if !filter.CreatedFrom.IsZero() {
+		query.Conditions = append(query.Conditions, fmt.Sprintf("article_search_documents.created_at >= $%d", param(filter.CreatedFrom)))
+	}
+	if !filter.CreatedTo.IsZero() {
+		query.Conditions = append(query.Conditions, fmt.Sprintf("article_search_documents.created_at <= $%d", param(filter.CreatedTo)))
+	}
+	if len(filter.AuthorIDs) > 0 {
+		inArticles(fmt.Sprintf(authorArticles, param(pq.Array(utils.UUIDStrings(filter.AuthorIDs)))))
+	}
+	if len(filter.ExcludeAuthorIDs) > 0 {
+		articles := fmt.Sprintf(authorArticles, param(pq.Array(utils.UUIDStrings(filter.ExcludeAuthorIDs))))
+		query.Conditions = append(query.Conditions, "article_search_documents.article_id NOT IN ("+articles+")")
+	}
+	if filter.AuthorName != "" {
+		query.Conditions = append(query.Conditions, fmt.Sprintf("LOWER(article_search_documents.author_name) LIKE LOWER($%d)", param("%"+filter.AuthorName+"%")))
+	}
+	if len(filter.CategoryIDs) > 0 {
+		inArticles(fmt.Sprintf("SELECT articles.id FROM articles WHERE articles.category_id = ANY($%d::uuid[])", param(pq.Array(utils.UUIDStrings(filter.CategoryIDs)))))
+	}
+	if len(filter.Tags) > 0 {
+		tagArticles := fmt.Sprintf(`SELECT article_tags.article_id
+			FROM article_tags
+			JOIN tags ON article_tags.tag_id = tags.id
+			WHERE tags.name = ANY($%d)`, param(pq.Array(filter.Tags)))
+		if filter.MatchAllTags {
+			tagArticles += fmt.Sprintf(`
+			GROUP BY article_tags.article_id
+			HAVING COUNT(*) = $%d`, param(len(filter.Tags)))
+		}
+		inArticles(tagArticles)
+	}
+}
@@ -0,0 +1,36 @@
+package search
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_BuildPostgresQuery_MatchAnyWord_WhenQueryHasNoSyntax(t *testing.T) {
+	query := buildPostgresQuery(ParseQuery("sayang ibu"), nil)
+
+	anyWord := "(websearch_to_tsquery('simple', $1) || websearch_to_tsquery('simple', $2))"
+	assert.Equal(t, []string{"article_search_documents.document @@ " + anyWord}, query.Conditions)
+	assert.Equal(t, anyWord, query.Rank)
+	assert.Equal(t, []interface{}{"sayang", "ibu"}, query.Params)
+}
+
+func Test_BuildPostgresQuery_RequireFieldedAndPhraseClauses_AndExcludeNegated(t *testing.T) {
+	query := buildPostgresQuery(ParseQuery(`title:"satu satu" author:chandra -tag:puisi ibu`), []interface{}{"taken"})
+
+	assert.Equal(t, []string{
+		"article_search_documents.title_vector @@ websearch_to_tsquery('simple', $2)",
+		"article_search_documents.author_vector @@ websearch_to_tsquery('simple', $3)",
+		"NOT article_search_documents.tags_vector @@ websearch_to_tsquery('simple', $4)",
+		"article_search_documents.document @@ (websearch_to_tsquery('simple', $5))",
+	}, query.Conditions)
+	assert.Equal(t, "websearch_to_tsquery('simple', $2) || websearch_to_tsquery('simple', $3) || (websearch_to_tsquery('simple', $5))", query.Rank)
+	assert.Equal(t, []interface{}{"taken", `"satu satu"`, "chandra", "puisi", "ibu"}, query.Params)
+}
+
+func Test_BuildPostgresQuery_HasNoRank_WhenEveryClauseIsNegated(t *testing.T) {
+	query := buildPostgresQuery(ParseQuery(`-draft`), nil)
+
+	assert.Equal(t, []string{"NOT article_search_documents.document @@ websearch_to_tsquery('simple', $1)"}, query.Conditions)
+	assert.Equal(t, "", query.Rank)
+}
@@ -0,0 +1,69 @@
+package search
+
+import (
+	"context"
+	"strings"
+)
+
+func queryWords(clauses []QueryClause) []string {
+	return distinctWords(clauses, func(clause QueryClause) bool { return !clause.Negated })
+}
+
+func fuzzyWords(clauses []QueryClause) []string {
+	return distinctWords(clauses, func(clause QueryClause) bool { return !clause.Negated && !clause.Phrase })
+}
+
+func distinctWords(clauses []QueryClause, keep func(QueryClause) bool) []string {
+	var words []string
+	seen := map[string]bool{}
+	for _, clause := range clauses {
+		if !keep(clause) {
+			continue
+		}
+		for _, word := range terms(clause.Text) {
+			if !seen[word] {
+				seen[word] = true
+				words = append(words, word)
+			}
+		}
+	}
+	return words
+}
+
+func correctQuery(ctx context.Context, search IArticleSearch, params SearchParams, clauses []QueryClause, corrections map[string]string) (string, error) {
+	corrected := make([]QueryClause, len(clauses))
+	changed := false
+	for i, clause := range clauses {
+		corrected[i] = clause
+		if clause.Negated {
+			continue
+		}
+
+		words := terms(clause.Text)
+		clauseChanged := false
+		for j, word := range words {
+			if correction, ok := corrections[word]; ok && correction != word {
+				words[j] = correction
+				clauseChanged = true
+			}
+		}
+		if clauseChanged {
+			corrected[i].Text = strings.Join(words, " ")
+			changed = true
+		}
+	}
+	if !changed {
+		return "", nil
+	}
+
+	query := FormatQuery(corrected)
+	result, err := search.Search(ctx, SearchParams{Query: query, Filter: params.Filter, Fuzzy: params.Fuzzy})
+	if err != nil {
+		return "", err
+	}
+	if result.Total == 0 {
+		return "", nil
+	}
+
+	return query, nil
+}
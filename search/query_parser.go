@@ -0,0 +1,141 @@
+package search
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/olivere/elastic/v7"
+)
+
+var queryFields = map[string]string{
+	"title":  "title",
+	"body":   "body",
+	"author": "author_name",
+	"tag":    "tags",
+}
+
+var freeTextFields = []string{"title", "body", "author_name", "tags"}
+
+type QueryClause struct {
+	Field   string
+	Text    string
+	Phrase  bool
+	Negated bool
+}
+
+func ParseQuery(query string) []QueryClause {
+	var clauses []QueryClause
+
+	runes := []rune(query)
+	for i := 0; i < len(runes); {
+		if unicode.IsSpace(runes[i]) {
+			i++
+			continue
+		}
+
+		var clause QueryClause
+		if runes[i] == '-' {
+			clause.Negated = true
+			i++
+		}
+
+		start := i
+		for i < len(runes) && !unicode.IsSpace(runes[i]) && runes[i] != ':' && runes[i] != '"' {
+			i++
+		}
+		if i < len(runes) && runes[i] == ':' {
+			if field, ok := queryFields[strings.ToLower(string(runes[start:i]))]; ok {
+				clause.Field = field
+				start = i + 1
+			}
+		}
+		i = start
+
+		if i < len(runes) && runes[i] == '"' {
+			end := i + 1
+			for end < len(runes) && runes[end] != '"' {
+				end++
+			}
+			clause.Text = string(runes[i+1 : end])
+			clause.Phrase = true
+			i = min(end+1, len(runes))
+		} else {
+			for i < len(runes) && !unicode.IsSpace(runes[i]) {
+				i++
+			}
+			clause.Text = string(runes[start:i])
+		}
+
+		clause.Text = strings.TrimSpace(clause.Text)
+		if clause.Text != "" {
+			clauses = append(clauses, clause)
+		}
+	}
+
+	return clauses
+}
+
+func FormatQuery(clauses []QueryClause) string {
+	formatted := make([]string, len(clauses))
+	for i, clause := range clauses {
+		var query strings.Builder
+		if clause.Negated {
+			query.WriteString("-")
+		}
+		for name, field := range queryFields {
+			if field == clause.Field {
+				query.WriteString(name + ":")
+			}
+		}
+		if clause.Phrase {
+			query.WriteString(`"` + clause.Text + `"`)
+		} else {
+			query.WriteString(clause.Text)
+		}
+		formatted[i] = query.String()
+	}
+
+	return strings.Join(formatted, " ")
+}
+
+func buildQuery(clauses []QueryClause, fuzzy bool) *elastic.BoolQuery {
+	query := elastic.NewBoolQuery()
+
+	var words []string
+	for _, clause := range clauses {
+		if clause.Field == "" && !clause.Phrase && !clause.Negated {
+			words = append(words, clause.Text)
+			continue
+		}
+
+		var match elastic.Query
+		switch {
+		case clause.Field == "":
+			match = elastic.NewMultiMatchQuery(clause.Text, freeTextFields...).Type("phrase")
+		case clause.Phrase:
+			match = elastic.NewMatchPhraseQuery(clause.Field, clause.Text)
+		default:
+			fieldMatch := elastic.NewMatchQuery(clause.Field, clause.Text).Operator("and")
+			if fuzzy && !clause.Negated {
+				fieldMatch = fieldMatch.Fuzziness("AUTO")
+			}
+			match = fieldMatch
+		}
+
+		if clause.Negated {
+			query = query.MustNot(match)
+		} else {
+			query = query.Must(match)
+		}
+	}
+
+	if len(words) > 0 {
+		wordsMatch := elastic.NewMultiMatchQuery(strings.Join(words, " "), freeTextFields...)
+		if fuzzy {
+			wordsMatch = wordsMatch.Fuzziness("AUTO")
+		}
+		query = query.Must(wordsMatch)
+	}
+
+	return query
+}
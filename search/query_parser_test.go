@@ -0,0 +1,140 @@
+package search
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/olivere/elastic/v7"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ParseQuery_ReturnWords_WhenQueryHasNoSyntax(t *testing.T) {
+	clauses := ParseQuery("  sayang   ibu ")
+
+	assert.Equal(t, []QueryClause{
+		{Text: "sayang"},
+		{Text: "ibu"},
+	}, clauses)
+}
+
+func Test_ParseQuery_ReturnFieldedPhraseAndNegatedClauses(t *testing.T) {
+	clauses := ParseQuery(`title:"satu satu" Author:chandra -draft -tag:puisi "aku sayang"`)
+
+	assert.Equal(t, []QueryClause{
+		{Field: "title", Text: "satu satu", Phrase: true},
+		{Field: "author_name", Text: "chandra"},
+		{Text: "draft", Negated: true},
+		{Field: "tags", Text: "puisi", Negated: true},
+		{Text: "aku sayang", Phrase: true},
+	}, clauses)
+}
+
+func Test_ParseQuery_SearchPlainText_WhenFieldIsUnknown(t *testing.T) {
+	clauses := ParseQuery("http://example.com status:draft")
+
+	assert.Equal(t, []QueryClause{
+		{Text: "http://example.com"},
+		{Text: "status:draft"},
+	}, clauses)
+}
+
+func Test_ParseQuery_SkipEmptyClauses_WhenQuoteIsUnclosed(t *testing.T) {
+	clauses := ParseQuery(`- title: body:"ibu`)
+
+	assert.Equal(t, []QueryClause{
+		{Field: "body", Text: "ibu", Phrase: true},
+	}, clauses)
+}
+
+func Test_BuildQuery_CombineWordsIntoOneMultiMatch(t *testing.T) {
+	source, _ := buildQuery(ParseQuery("sayang ibu -draft author:chandra"), false).Source()
+	queryJSON, _ := json.Marshal(source)
+
+	assert.JSONEq(t, `{"bool":{
+		"must":[
+			{"match":{"author_name":{"operator":"and","query":"chandra"}}},
+			{"multi_match":{"fields":["title","body","author_name","tags"],"query":"sayang ibu"}}
+		],
+		"must_not":{"multi_match":{"fields":["title","body","author_name","tags"],"query":"draft","type":"phrase"}}
+	}}`, string(queryJSON))
+}
+
+func Test_BuildQuery_MatchWordsWithTypos_WhenFuzzy(t *testing.T) {
+	source, _ := buildQuery(ParseQuery(`sayng title:ibu "aku sayang"`), true).Source()
+	queryJSON, _ := json.Marshal(source)
+
+	assert.JSONEq(t, `{"bool":{
+		"must":[
+			{"match":{"title":{"fuzziness":"AUTO","operator":"and","query":"ibu"}}},
+			{"multi_match":{"fields":["title","body","author_name","tags"],"query":"aku sayang","type":"phrase"}},
+			{"multi_match":{"fields":["title","body","author_name","tags"],"fuzziness":"AUTO","query":"sayng"}}
+		]
+	}}`, string(queryJSON))
+}
+
+func Test_FormatQuery_ReturnQueryParsedToTheSameClauses(t *testing.T) {
+	clauses := []QueryClause{
+		{Field: "title", Text: "satu satu", Phrase: true},
+		{Field: "author_name", Text: "chandra"},
+		{Text: "draft", Negated: true},
+		{Field: "tags", Text: "puisi", Negated: true},
+		{Text: "ibu"},
+	}
+
+	query := FormatQuery(clauses)
+
+	assert.Equal(t, `title:"satu satu" author:chandra -draft -tag:puisi ibu`, query)
+	assert.Equal(t, clauses, ParseQuery(query))
+}
+
+func Test_FilterQuery_AddCreatedRangeAndAuthorFilters(t *testing.T) {
+	chandra, phang := uuid.MustParse("0197db1c-c6c4-7140-bee3-8efd703f30c1"), uuid.MustParse("0197db1c-c6c4-7140-bee3-8efd703f30c2")
+	filter := SearchFilter{
+		CreatedFrom:      time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC),
+		AuthorIDs:        []uuid.UUID{chandra},
+		ExcludeAuthorIDs: []uuid.UUID{phang},
+	}
+
+	source, _ := filterQuery(buildQuery(ParseQuery("ibu"), false), filter).Source()
+	queryJSON, _ := json.Marshal(source)
+
+	assert.JSONEq(t, `{"bool":{
+		"filter":[
+			{"range":{"created_at":{"from":"2025-07-01T00:00:00Z","include_lower":true,"include_upper":true,"to":null}}},
+			{"terms":{"author_id":["0197db1c-c6c4-7140-bee3-8efd703f30c1"]}}
+		],
+		"must":{"multi_match":{"fields":["title","body","author_name","tags"],"query":"ibu"}},
+		"must_not":{"terms":{"author_id":["0197db1c-c6c4-7140-bee3-8efd703f30c2"]}}
+	}}`, string(queryJSON))
+}
+
+func Test_FilterQuery_AddAuthorNameCategoryAndTagsFilters(t *testing.T) {
+	news := uuid.MustParse("0197dd3b-2a4f-7b21-8d5e-3c8f6a9b2d01")
+	filter := SearchFilter{
+		AuthorName:   "cha*",
+		CategoryIDs:  []uuid.UUID{news},
+		Tags:         []string{"puisi", "keluarga"},
+		MatchAllTags: true,
+	}
+
+	source, _ := filterQuery(buildQuery(ParseQuery("ibu"), false), filter).Source()
+	queryJSON, _ := json.Marshal(source)
+
+	assert.JSONEq(t, `{"bool":{
+		"filter":[
+			{"wildcard":{"author_name.keyword":{"case_insensitive":true,"value":"*cha\\**"}}},
+			{"terms":{"category_id":["0197dd3b-2a4f-7b21-8d5e-3c8f6a9b2d01"]}},
+			{"term":{"tags":"puisi"}},
+			{"term":{"tags":"keluarga"}}
+		],
+		"must":{"multi_match":{"fields":["title","body","author_name","tags"],"query":"ibu"}}
+	}}`, string(queryJSON))
+
+	filter.MatchAllTags = false
+	source, _ = filterQuery(elastic.NewBoolQuery(), filter).Source()
+	queryJSON, _ = json.Marshal(source)
+
+	assert.Contains(t, string(queryJSON), `{"terms":{"tags":["puisi","keluarga"]}}`)
+}
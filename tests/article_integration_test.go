@@ -10,7 +10,6 @@ import (
 	"article-service/dto/response"
 	v1resp "article-service/dto/response/v1_resp"
 	"article-service/factory"
-	"article-service/infrastructure/elasticsearch"
 	"article-service/infrastructure/log"
 	"article-service/search"
 	"bytes"
@@ -18,6 +17,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	stdlog "log"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
@@ -38,15 +38,22 @@ func TestMain(m *testing.M) {
 
 	config = configloader.GetRootConfig()
 
-	elasticsearch.InitElasticSearch(context.Background(), config.ElasticConfig)
-
-	search.GetArticleSearch().Index(context.Background(), factory.SampleArticle1)
-	search.GetArticleSearch().Index(context.Background(), factory.SampleArticle2)
-
 	db_client.DropTestDB(ctx, config.DbConfig)
 	db_client.CreateTestDB(ctx, config.DbConfig)
 	defer db_client.DropTestDB(ctx, config.DbConfig)
 
+	// the in-process index loads the published articles on startup, so they are seeded before it
+	db_client.RunSeedTest(ctx, config.DbConfig)
+
+	// the tests search an index inside the process, so they do not need Elasticsearch
+	err := search.InitArticleSearch(ctx, configloader.SearchConfig{Backend: search.BackendMemory}, config.ElasticConfig.Highlight)
+	if err != nil {
+		stdlog.Fatalf("[IntegrationTest][TestMain] failed to init article search, err=%v", err)
+	}
+
+	// every test seeds the database itself again
+	db_client.TruncateTestDB(ctx, config.DbConfig)
+
 	m.Run()
 }
 
@@ -169,7 +176,8 @@ func TestListArticles_WithQuery_ReturnAllRecords(t *testing.T) {
 	assert.Equal(t, 2, len(result.Articles))
 	assert.Equal(t, int64(2), result.RecordsCount)
 
-	article := result.Articles[0]
+	// a query sorts by relevance, "Satu" is twice in the title of article 1 so it ranks above article 2
+	article := result.Articles[1]
 	expArticle2 := factory.SampleArticle2
 
 	assert.Equal(t, expArticle2.ID, article.ID)
@@ -179,7 +187,7 @@ func TestListArticles_WithQuery_ReturnAllRecords(t *testing.T) {
 	assert.Equal(t, expArticle2.Author.ID, article.Author.ID)
 	assert.Equal(t, expArticle2.Author.Name, article.Author.Name)
 
-	article2 := result.Articles[1]
+	article2 := result.Articles[0]
 	expArticle := factory.SampleArticle1
 
 	assert.Equal(t, expArticle.ID, article2.ID)
@@ -192,6 +200,45 @@ func TestListArticles_WithQuery_ReturnAllRecords(t *testing.T) {
 	db_client.TruncateTestDB(context.Background(), config.DbConfig)
 }
 
+func TestListArticles_WithQuerySortedByCreatedAt_ReturnNewestFirst(t *testing.T) {
+	db_client.RunSeedTest(context.Background(), config.DbConfig)
+
+	r := httptest.NewRequest(http.MethodPost, "/v1/articles", nil)
+	w := httptest.NewRecorder()
+
+	r.URL = &url.URL{}
+	query := r.URL.Query()
+	query.Add("query", "Satu")
+	query.Add("sortBy", "created_at")
+	query.Add("sortDirection", "desc")
+	query.Add("limit", "1")
+	r.URL.RawQuery = query.Encode()
+	r.Header.Add(apiconst.ContentTypeHeader, apiconst.ContentTypeJSON)
+
+	application.InitArticleService()
+	articleController := v1.InitArticleController()
+	articleController.ListArticles(w, r)
+
+	res := w.Result()
+	defer res.Body.Close()
+
+	respBody := response.SuccessResponse{}
+	respBytes, _ := io.ReadAll(w.Body)
+	json.Unmarshal(respBytes, &respBody)
+
+	result := v1resp.ListArticlesDTO{}
+	resultJSON, _ := json.Marshal(respBody.Result)
+	json.Unmarshal(resultJSON, &result)
+
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+	assert.Equal(t, true, respBody.Success)
+	assert.Equal(t, 1, len(result.Articles))
+	assert.Equal(t, int64(2), result.RecordsCount)
+	assert.Equal(t, factory.SampleArticle2.ID, result.Articles[0].ID)
+
+	db_client.TruncateTestDB(context.Background(), config.DbConfig)
+}
+
 func TestListArticles_WithQuery_ReturnOneRecord(t *testing.T) {
 	db_client.RunSeedTest(context.Background(), config.DbConfig)
 
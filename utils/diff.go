@@ -0,0 +1,52 @@
+package utils
+
+import (
+	"strings"
+
+	"article-service/model"
+)
+
+func DiffLines(a, b string) []model.DiffLine {
+	aLines := strings.Split(a, "\n")
+	bLines := strings.Split(b, "\n")
+
+	// lcs[i][j] is the length of the longest common subsequence of aLines[i:] and bLines[j:]
+	lcs := make([][]int, len(aLines)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(bLines)+1)
+	}
+	for i := len(aLines) - 1; i >= 0; i-- {
+		for j := len(bLines) - 1; j >= 0; j-- {
+			if aLines[i] == bLines[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	var diff []model.DiffLine
+	i, j := 0, 0
+	for i < len(aLines) && j < len(bLines) {
+		switch {
+		case aLines[i] == bLines[j]:
+			diff = append(diff, model.DiffLine{Op: model.DiffOpEqual, Text: aLines[i]})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			diff = append(diff, model.DiffLine{Op: model.DiffOpDelete, Text: aLines[i]})
+			i++
+		default:
+			diff = append(diff, model.DiffLine{Op: model.DiffOpInsert, Text: bLines[j]})
+			j++
+		}
+	}
+	for ; i < len(aLines); i++ {
+		diff = append(diff, model.DiffLine{Op: model.DiffOpDelete, Text: aLines[i]})
+	}
+	for ; j < len(bLines); j++ {
+		diff = append(diff, model.DiffLine{Op: model.DiffOpInsert, Text: bLines[j]})
+	}
+
+	return diff
+}
@@ -0,0 +1,30 @@
+package utils
+
+import (
+	"testing"
+
+	"article-service/model"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_DiffLines_ReturnEqualLines_WhenTextsAreSame(t *testing.T) {
+	diff := DiffLines("a\nb", "a\nb")
+
+	assert.Equal(t, []model.DiffLine{
+		{Op: model.DiffOpEqual, Text: "a"},
+		{Op: model.DiffOpEqual, Text: "b"},
+	}, diff)
+}
+
+func Test_DiffLines_ReturnInsertAndDelete_WhenLinesChanged(t *testing.T) {
+	diff := DiffLines("a\nb\nc", "a\nx\nc\nd")
+
+	assert.Equal(t, []model.DiffLine{
+		{Op: model.DiffOpEqual, Text: "a"},
+		{Op: model.DiffOpDelete, Text: "b"},
+		{Op: model.DiffOpInsert, Text: "x"},
+		{Op: model.DiffOpEqual, Text: "c"},
+		{Op: model.DiffOpInsert, Text: "d"},
+	}, diff)
+}
@@ -0,0 +1,76 @@
+package utils
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+const SlugMaxLength = 100
+
+var slugTransliterations = map[rune]string{
+	'ß': "ss",
+	'æ': "ae",
+	'Æ': "ae",
+	'œ': "oe",
+	'Œ': "oe",
+	'ø': "o",
+	'Ø': "o",
+	'ł': "l",
+	'Ł': "l",
+	'đ': "d",
+	'Đ': "d",
+	'ð': "d",
+	'Ð': "d",
+	'þ': "th",
+	'Þ': "th",
+	'ı': "i",
+}
+
+// Slugify returns "" when nothing in s can be transliterated
+func Slugify(s string) string {
+	var b strings.Builder
+	separate := false
+	for _, r := range norm.NFKD.String(s) {
+		if unicode.Is(unicode.Mn, r) {
+			continue
+		}
+
+		text, ok := slugTransliterations[r]
+		if !ok {
+			text = string(unicode.ToLower(r))
+		}
+		for _, c := range text {
+			if c > unicode.MaxASCII || !(unicode.IsLetter(c) || unicode.IsDigit(c)) {
+				separate = true
+				continue
+			}
+			if separate && b.Len() > 0 {
+				b.WriteByte('-')
+			}
+			separate = false
+			b.WriteRune(c)
+		}
+	}
+
+	slug := b.String()
+	if len(slug) > SlugMaxLength {
+		slug = strings.TrimRight(slug[:SlugMaxLength], "-")
+	}
+	return slug
+}
+
+func UniqueSlug(base string, taken []string) string {
+	takenSet := make(map[string]bool, len(taken))
+	for _, slug := range taken {
+		takenSet[slug] = true
+	}
+
+	slug := base
+	for n := 2; takenSet[slug]; n++ {
+		slug = fmt.Sprintf("%s-%d", base, n)
+	}
+	return slug
+}
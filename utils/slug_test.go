@@ -0,0 +1,38 @@
+package utils
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Slugify_ReturnLowercaseWords(t *testing.T) {
+	assert.Equal(t, "satu-satu-aku-sayang-ibu", Slugify("Satu satu aku sayang ibu"))
+	assert.Equal(t, "hello-world-2025", Slugify("  Hello, World!! -- 2025 "))
+}
+
+func Test_Slugify_TransliterateNonASCII(t *testing.T) {
+	assert.Equal(t, "cafe-creme-brulee", Slugify("Café Crème Brûlée"))
+	assert.Equal(t, "strasse-smorrebrod-lodz", Slugify("Straße Smørrebrød Łódź"))
+	assert.Equal(t, "fi-12", Slugify("ﬁ １２"))
+}
+
+func Test_Slugify_ReturnEmpty_WhenNothingTransliterates(t *testing.T) {
+	assert.Equal(t, "", Slugify("日本語 !!"))
+}
+
+func Test_Slugify_TruncateLongTitles(t *testing.T) {
+	slug := Slugify(strings.Repeat("ab ", 60))
+
+	assert.LessOrEqual(t, len(slug), SlugMaxLength)
+	assert.False(t, strings.HasSuffix(slug, "-"))
+}
+
+func Test_UniqueSlug_ReturnBase_WhenNotTaken(t *testing.T) {
+	assert.Equal(t, "ibu", UniqueSlug("ibu", []string{"ibu-2"}))
+}
+
+func Test_UniqueSlug_ReturnLowestFreeSuffix_WhenTaken(t *testing.T) {
+	assert.Equal(t, "ibu-3", UniqueSlug("ibu", []string{"ibu", "ibu-2", "ibu-4"}))
+}
@@ -12,3 +12,22 @@ func GenerateUUID() uuid.UUID {
 func GenerateUuidV4() uuid.UUID {
 	return uuid.New()
 }
+
+func ParseUUIDs(values []string) []uuid.UUID {
+	var ids []uuid.UUID
+	for _, value := range values {
+		if id, err := uuid.Parse(value); err == nil {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+// lib/pq has no array type for uuids
+func UUIDStrings(ids []uuid.UUID) []string {
+	values := make([]string, len(ids))
+	for i, id := range ids {
+		values[i] = id.String()
+	}
+	return values
+}